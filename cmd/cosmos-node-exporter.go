@@ -1,10 +1,15 @@
 package main
 
 import (
+	"context"
 	"main/pkg"
+	"main/pkg/clients/config_files"
 	"main/pkg/config"
+	"main/pkg/config_lint"
 	"main/pkg/fs"
 	"main/pkg/logger"
+	"main/pkg/preparer"
+	"main/pkg/tracing"
 
 	"github.com/spf13/cobra"
 )
@@ -35,8 +40,87 @@ func ExecuteValidateConfig(configPath string) {
 	logger.GetDefaultLogger().Info().Msg("Provided config is valid.")
 }
 
+func ExecutePrepareUpgrade(configPath string, nodeName string, dryRun bool) {
+	filesystem := &fs.OsFS{}
+
+	appConfig, err := config.GetConfig(filesystem, configPath)
+	if err != nil {
+		logger.GetDefaultLogger().Panic().Err(err).Msg("Could not load config!")
+	}
+
+	if err = appConfig.Validate(); err != nil {
+		logger.GetDefaultLogger().Panic().Err(err).Msg("Provided config is invalid!")
+	}
+
+	nodeConfig, found := appConfig.GetNodeConfig(nodeName)
+	if !found {
+		logger.GetDefaultLogger().Panic().Str("node", nodeName).Msg("Node is not found in config!")
+	}
+
+	log := logger.GetLogger(appConfig.LogConfig)
+	upgradePreparer := preparer.NewUpgradePreparer(nodeConfig, *log, tracing.InitNoopTracer(), dryRun)
+
+	if err := upgradePreparer.Prepare(context.Background()); err != nil {
+		log.Panic().Err(err).Msg("Could not prepare upgrade")
+	}
+}
+
+func ExecuteLintNodeConfig(configPath string, nodeName string) {
+	filesystem := &fs.OsFS{}
+
+	appConfig, err := config.GetConfig(filesystem, configPath)
+	if err != nil {
+		logger.GetDefaultLogger().Panic().Err(err).Msg("Could not load config!")
+	}
+
+	if err = appConfig.Validate(); err != nil {
+		logger.GetDefaultLogger().Panic().Err(err).Msg("Provided config is invalid!")
+	}
+
+	nodeConfig, found := appConfig.GetNodeConfig(nodeName)
+	if !found {
+		logger.GetDefaultLogger().Panic().Str("node", nodeName).Msg("Node is not found in config!")
+	}
+
+	log := logger.GetLogger(appConfig.LogConfig)
+
+	chainFolder := nodeConfig.GetChainFolder()
+	if chainFolder == "" {
+		log.Panic().Msg("Node home folder is not set, set either cosmovisor.chain-folder or binary.home!")
+	}
+
+	client := config_files.NewClient(chainFolder, *log, tracing.InitNoopTracer())
+	results, err := config_lint.LintNode(context.Background(), client, nodeConfig.Role)
+	if err != nil {
+		log.Panic().Err(err).Msg("Could not read node config files")
+	}
+
+	violations := 0
+
+	for _, result := range results {
+		if !result.Violated {
+			log.Debug().Str("rule", result.Rule).Msg("Rule passed")
+			continue
+		}
+
+		violations++
+		log.Warn().
+			Str("rule", result.Rule).
+			Str("severity", result.Severity).
+			Msg(result.Description)
+	}
+
+	if violations > 0 {
+		log.Panic().Int("violations", violations).Msg("Node config has risky settings!")
+	}
+
+	log.Info().Msg("Node config has no risky settings.")
+}
+
 func main() {
 	var ConfigPath string
+	var NodeName string
+	var DryRun bool
 
 	rootCmd := &cobra.Command{
 		Use:     "cosmos-node-exporter --config [config path]",
@@ -56,13 +140,44 @@ func main() {
 		},
 	}
 
+	prepareUpgradeCmd := &cobra.Command{
+		Use:     "prepare-upgrade --config [config path] --node [node name]",
+		Long:    "Download, verify and install the binary for the upcoming upgrade into the Cosmovisor folder.",
+		Version: version,
+		Run: func(cmd *cobra.Command, args []string) {
+			ExecutePrepareUpgrade(ConfigPath, NodeName, DryRun)
+		},
+	}
+
+	lintNodeConfigCmd := &cobra.Command{
+		Use:     "lint-node-config --config [config path] --node [node name]",
+		Long:    "Check the node config.toml and app.toml for risky settings.",
+		Version: version,
+		Run: func(cmd *cobra.Command, args []string) {
+			ExecuteLintNodeConfig(ConfigPath, NodeName)
+		},
+	}
+
 	rootCmd.PersistentFlags().StringVar(&ConfigPath, "config", "", "Config file path")
 	_ = rootCmd.MarkPersistentFlagRequired("config")
 
 	validateConfigCmd.PersistentFlags().StringVar(&ConfigPath, "config", "", "Config file path")
 	_ = validateConfigCmd.MarkPersistentFlagRequired("config")
 
+	prepareUpgradeCmd.PersistentFlags().StringVar(&ConfigPath, "config", "", "Config file path")
+	prepareUpgradeCmd.PersistentFlags().StringVar(&NodeName, "node", "", "Node name from config")
+	prepareUpgradeCmd.PersistentFlags().BoolVar(&DryRun, "dry-run", false, "Only show what would be done")
+	_ = prepareUpgradeCmd.MarkPersistentFlagRequired("config")
+	_ = prepareUpgradeCmd.MarkPersistentFlagRequired("node")
+
+	lintNodeConfigCmd.PersistentFlags().StringVar(&ConfigPath, "config", "", "Config file path")
+	lintNodeConfigCmd.PersistentFlags().StringVar(&NodeName, "node", "", "Node name from config")
+	_ = lintNodeConfigCmd.MarkPersistentFlagRequired("config")
+	_ = lintNodeConfigCmd.MarkPersistentFlagRequired("node")
+
 	rootCmd.AddCommand(validateConfigCmd)
+	rootCmd.AddCommand(prepareUpgradeCmd)
+	rootCmd.AddCommand(lintNodeConfigCmd)
 
 	if err := rootCmd.Execute(); err != nil {
 		logger.GetDefaultLogger().Panic().Err(err).Msg("Could not start application")
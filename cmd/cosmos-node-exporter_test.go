@@ -72,3 +72,155 @@ func TestStartConfigProvided(t *testing.T) {
 	os.Args = []string{"cmd", "--config", "../assets/config-invalid.toml"}
 	main()
 }
+
+//nolint:paralleltest // disabled
+func TestPrepareUpgradeNoNodeProvided(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			require.Fail(t, "Expected to have a panic here!")
+		}
+	}()
+
+	os.Args = []string{"cmd", "prepare-upgrade", "--config", "../assets/config-valid.toml"}
+	main()
+}
+
+//nolint:paralleltest // disabled
+func TestPrepareUpgradeFailedToLoad(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			require.Fail(t, "Expected to have a panic here!")
+		}
+	}()
+
+	os.Args = []string{"cmd", "prepare-upgrade", "--config", "../assets/config-not-found.toml", "--node", "cosmos"}
+	main()
+}
+
+//nolint:paralleltest // disabled
+func TestPrepareUpgradeInvalid(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			require.Fail(t, "Expected to have a panic here!")
+		}
+	}()
+
+	os.Args = []string{"cmd", "prepare-upgrade", "--config", "../assets/config-invalid.toml", "--node", "cosmos"}
+	main()
+}
+
+//nolint:paralleltest // disabled
+func TestPrepareUpgradeNodeNotFound(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			require.Fail(t, "Expected to have a panic here!")
+		}
+	}()
+
+	os.Args = []string{"cmd", "prepare-upgrade", "--config", "../assets/config-valid.toml", "--node", "not-found"}
+	main()
+}
+
+//nolint:paralleltest // disabled
+func TestPrepareUpgradeFailed(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			require.Fail(t, "Expected to have a panic here!")
+		}
+	}()
+
+	// cosmovisor is disabled in this config, so there's no upgrades folder to put the binary to
+	os.Args = []string{"cmd", "prepare-upgrade", "--config", "../assets/config-valid.toml", "--node", "cosmos", "--dry-run"}
+	main()
+}
+
+//nolint:paralleltest // disabled
+func TestLintNodeConfigNoNodeProvided(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			require.Fail(t, "Expected to have a panic here!")
+		}
+	}()
+
+	os.Args = []string{"cmd", "lint-node-config", "--config", "../assets/config-lint.toml"}
+	main()
+}
+
+//nolint:paralleltest // disabled
+func TestLintNodeConfigFailedToLoad(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			require.Fail(t, "Expected to have a panic here!")
+		}
+	}()
+
+	os.Args = []string{"cmd", "lint-node-config", "--config", "../assets/config-not-found.toml", "--node", "cosmos"}
+	main()
+}
+
+//nolint:paralleltest // disabled
+func TestLintNodeConfigInvalid(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			require.Fail(t, "Expected to have a panic here!")
+		}
+	}()
+
+	os.Args = []string{"cmd", "lint-node-config", "--config", "../assets/config-invalid.toml", "--node", "cosmos"}
+	main()
+}
+
+//nolint:paralleltest // disabled
+func TestLintNodeConfigNodeNotFound(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			require.Fail(t, "Expected to have a panic here!")
+		}
+	}()
+
+	os.Args = []string{"cmd", "lint-node-config", "--config", "../assets/config-lint.toml", "--node", "not-found"}
+	main()
+}
+
+//nolint:paralleltest // disabled
+func TestLintNodeConfigNoChainFolder(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			require.Fail(t, "Expected to have a panic here!")
+		}
+	}()
+
+	os.Args = []string{"cmd", "lint-node-config", "--config", "../assets/config-lint.toml", "--node", "no-home"}
+	main()
+}
+
+//nolint:paralleltest // disabled
+func TestLintNodeConfigNoFiles(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			require.Fail(t, "Expected to have a panic here!")
+		}
+	}()
+
+	os.Args = []string{"cmd", "lint-node-config", "--config", "../assets/config-lint.toml", "--node", "no-files"}
+	main()
+}
+
+//nolint:paralleltest // disabled
+func TestLintNodeConfigViolations(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			require.Fail(t, "Expected to have a panic here!")
+		}
+	}()
+
+	// double_sign_check_height is 0 in the node config.toml
+	os.Args = []string{"cmd", "lint-node-config", "--config", "../assets/config-lint.toml", "--node", "validator"}
+	main()
+}
+
+//nolint:paralleltest // disabled
+func TestLintNodeConfigOk(_ *testing.T) {
+	os.Args = []string{"cmd", "lint-node-config", "--config", "../assets/config-lint.toml", "--node", "fullnode"}
+	main()
+}
@@ -103,6 +103,11 @@ func (a *App) Start() {
 
 func (a *App) Stop() {
 	a.Logger.Info().Str("addr", a.Config.ListenAddress).Msg("Shutting down server...")
+
+	for _, nodeHandler := range a.NodeHandlers {
+		nodeHandler.Stop()
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 	_ = a.Server.Shutdown(ctx)
@@ -0,0 +1,85 @@
+package binary
+
+import (
+	"context"
+	"main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/exec"
+	"main/pkg/query_info"
+	"main/pkg/types"
+	"main/pkg/utils"
+	"os"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/rs/zerolog"
+)
+
+// Binary runs the chain binary directly, for nodes that are not managed by Cosmovisor.
+type Binary struct {
+	Logger          zerolog.Logger
+	Config          config.BinaryConfig
+	Tracer          trace.Tracer
+	CommandExecutor exec.CommandExecutor
+}
+
+func NewBinary(
+	config config.BinaryConfig,
+	logger zerolog.Logger,
+	tracer trace.Tracer,
+) *Binary {
+	return &Binary{
+		Logger:          logger.With().Str("component", "binary").Logger(),
+		Config:          config,
+		Tracer:          tracer,
+		CommandExecutor: &exec.NativeCommandExecutor{},
+	}
+}
+
+func (b *Binary) GetVersion(ctx context.Context) (types.VersionInfo, query_info.QueryInfo, error) {
+	_, span := b.Tracer.Start(
+		ctx,
+		"Fetching binary app version",
+		trace.WithAttributes(attribute.String("path", b.Config.Path)),
+	)
+	defer span.End()
+
+	queryInfo := query_info.QueryInfo{
+		Module:  constants.ModuleBinary,
+		Action:  constants.ActionBinaryGetVersion,
+		Success: false,
+	}
+
+	args := []string{"version", "--long", "--output", "json"}
+	if b.Config.Home != "" {
+		args = append(args, "--home", b.Config.Home)
+	}
+
+	out, err := b.CommandExecutor.RunWithEnv(
+		b.Config.Path,
+		args,
+		append(os.Environ(), b.Config.Env...),
+	)
+	if err != nil {
+		b.Logger.Error().
+			Err(err).
+			Str("output", utils.DecolorifyString(string(out))).
+			Msg("Could not get app version")
+		span.RecordError(err)
+		return types.VersionInfo{}, queryInfo, err
+	}
+
+	versionInfo, jsonOutput, err := types.ParseVersionInfo(out)
+	if err != nil {
+		b.Logger.Error().
+			Err(err).
+			Str("output", jsonOutput).
+			Msg("Could not unmarshall app version")
+		span.RecordError(err)
+		return versionInfo, queryInfo, err
+	}
+
+	queryInfo.Success = true
+	return versionInfo, queryInfo, nil
+}
@@ -0,0 +1,68 @@
+package binary
+
+import (
+	"context"
+	"main/assets"
+	configPkg "main/pkg/config"
+	"main/pkg/exec"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/tracing"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBinaryGetVersionFail(t *testing.T) {
+	t.Parallel()
+
+	config := configPkg.BinaryConfig{Path: "/usr/bin/gaiad"}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewBinary(config, *logger, tracer)
+	client.CommandExecutor = &exec.TestCommandExecutor{Fail: true}
+
+	version, queryInfo, err := client.GetVersion(context.Background())
+	require.Error(t, err)
+	assert.False(t, queryInfo.Success)
+	assert.Empty(t, version)
+}
+
+func TestBinaryGetVersionInvalid(t *testing.T) {
+	t.Parallel()
+
+	config := configPkg.BinaryConfig{Path: "/usr/bin/gaiad"}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewBinary(config, *logger, tracer)
+
+	content := assets.GetBytesOrPanic("invalid.toml")
+	client.CommandExecutor = &exec.TestCommandExecutor{Expected: content}
+
+	version, queryInfo, err := client.GetVersion(context.Background())
+	require.Error(t, err)
+	require.ErrorContains(t, err, "invalid character")
+	assert.False(t, queryInfo.Success)
+	assert.Empty(t, version)
+}
+
+func TestBinaryGetVersionValid(t *testing.T) {
+	t.Parallel()
+
+	config := configPkg.BinaryConfig{
+		Path: "/usr/bin/gaiad",
+		Home: "/home/validator/.gaia",
+		Env:  []string{"LD_LIBRARY_PATH=/usr/local/lib"},
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewBinary(config, *logger, tracer)
+
+	content := assets.GetBytesOrPanic("cosmovisor-app-version-ok.txt")
+	client.CommandExecutor = &exec.TestCommandExecutor{Expected: content}
+
+	version, queryInfo, err := client.GetVersion(context.Background())
+	require.NoError(t, err)
+	assert.True(t, queryInfo.Success)
+	assert.Equal(t, "1.6.4", version.Version)
+}
@@ -0,0 +1,157 @@
+package chain_registry
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	configPkg "main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/fs"
+	"main/pkg/query_info"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/rs/zerolog"
+)
+
+type ChainInfo struct {
+	ChainName string    `json:"chain_name"`
+	Codebase  *Codebase `json:"codebase"`
+}
+
+type Codebase struct {
+	RecommendedVersion string         `json:"recommended_version"`
+	CompatibleVersions []string       `json:"compatible_versions"`
+	Versions           []ChainVersion `json:"versions"`
+}
+
+type ChainVersion struct {
+	Name               string   `json:"name"`
+	Height             int64    `json:"height"`
+	RecommendedVersion string   `json:"recommended_version"`
+	CompatibleVersions []string `json:"compatible_versions"`
+}
+
+type Client struct {
+	Config     configPkg.ChainRegistryConfig
+	Filesystem fs.FS
+	HTTPClient *http.Client
+	Logger     zerolog.Logger
+	Tracer     trace.Tracer
+
+	// remote chain-registry files are cached, so they are not downloaded on every scrape
+	LastResult     *ChainInfo
+	LastResultTime time.Time
+	Mutex          sync.Mutex
+}
+
+func NewClient(
+	config configPkg.ChainRegistryConfig,
+	logger zerolog.Logger,
+	tracer trace.Tracer,
+) *Client {
+	return &Client{
+		Config:     config,
+		Filesystem: &fs.OsFS{},
+		Logger:     logger.With().Str("component", "chain_registry").Logger(),
+		Tracer:     tracer,
+		HTTPClient: &http.Client{
+			Timeout:   10 * time.Second,
+			Transport: otelhttp.NewTransport(http.DefaultTransport),
+		},
+	}
+}
+
+func (c *Client) HasCachedResult() bool {
+	if c.LastResult == nil {
+		return false
+	}
+
+	return c.LastResultTime.Add(constants.UncachedChainRegistryQueryTime).Sub(time.Now()) > 0
+}
+
+func (c *Client) GetChainInfo(ctx context.Context) (*ChainInfo, query_info.QueryInfo, error) {
+	childCtx, span := c.Tracer.Start(
+		ctx,
+		"Fetching chain-registry info",
+		trace.WithAttributes(attribute.String("path", c.Config.Path)),
+	)
+	defer span.End()
+
+	queryInfo := query_info.QueryInfo{
+		Module:  constants.ModuleChainRegistry,
+		Action:  constants.ActionChainRegistryGetChainInfo,
+		Success: false,
+	}
+
+	var bytes []byte
+	var err error
+
+	if c.Config.IsRemote() {
+		c.Mutex.Lock()
+		defer c.Mutex.Unlock()
+
+		if c.HasCachedResult() {
+			c.Logger.Trace().
+				Str("time-since-latest", time.Since(c.LastResultTime).String()).
+				Msg("Use chain-registry response from cache")
+			queryInfo.Success = true
+			return c.LastResult, queryInfo, nil
+		}
+
+		bytes, err = c.fetchRemote(childCtx)
+	} else {
+		c.Logger.Trace().Str("path", c.Config.Path).Msg("Reading chain-registry file")
+		bytes, err = c.Filesystem.ReadFile(c.Config.Path)
+	}
+
+	if err != nil {
+		return nil, queryInfo, err
+	}
+
+	chainInfo := &ChainInfo{}
+	if err := json.Unmarshal(bytes, chainInfo); err != nil {
+		return nil, queryInfo, err
+	}
+
+	if chainInfo.Codebase == nil {
+		return nil, queryInfo, errors.New("chain-registry file has no codebase")
+	}
+
+	if c.Config.IsRemote() {
+		c.LastResult = chainInfo
+		c.LastResultTime = time.Now()
+	}
+
+	queryInfo.Success = true
+
+	return chainInfo, queryInfo, nil
+}
+
+func (c *Client) fetchRemote(ctx context.Context) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.Config.Path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Logger.Trace().Str("url", c.Config.Path).Msg("Querying chain-registry")
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("chain-registry responded with status %d", res.StatusCode)
+	}
+
+	return io.ReadAll(res.Body)
+}
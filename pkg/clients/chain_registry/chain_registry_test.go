@@ -0,0 +1,209 @@
+package chain_registry
+
+import (
+	"context"
+	"errors"
+	"main/assets"
+	configPkg "main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/fs"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/tracing"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const chainRegistryUrl = "https://raw.githubusercontent.com/cosmos/chain-registry/master/cosmoshub/chain.json"
+
+func TestChainRegistryLocalFileNotFound(t *testing.T) {
+	t.Parallel()
+
+	config := configPkg.ChainRegistryConfig{Path: "not-found.json"}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewClient(config, *logger, tracer)
+	client.Filesystem = &fs.TestFS{}
+
+	info, queryInfo, err := client.GetChainInfo(context.Background())
+	require.Error(t, err)
+	assert.False(t, queryInfo.Success)
+	assert.Nil(t, info)
+}
+
+func TestChainRegistryLocalFileInvalid(t *testing.T) {
+	t.Parallel()
+
+	config := configPkg.ChainRegistryConfig{Path: "invalid.toml"}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewClient(config, *logger, tracer)
+	client.Filesystem = &fs.TestFS{}
+
+	info, queryInfo, err := client.GetChainInfo(context.Background())
+	require.Error(t, err)
+	assert.False(t, queryInfo.Success)
+	assert.Nil(t, info)
+}
+
+func TestChainRegistryLocalFileNoCodebase(t *testing.T) {
+	t.Parallel()
+
+	config := configPkg.ChainRegistryConfig{Path: "chain-registry-no-codebase.json"}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewClient(config, *logger, tracer)
+	client.Filesystem = &fs.TestFS{}
+
+	info, queryInfo, err := client.GetChainInfo(context.Background())
+	require.Error(t, err)
+	require.ErrorContains(t, err, "chain-registry file has no codebase")
+	assert.False(t, queryInfo.Success)
+	assert.Nil(t, info)
+}
+
+func TestChainRegistryLocalFileOk(t *testing.T) {
+	t.Parallel()
+
+	config := configPkg.ChainRegistryConfig{Path: "chain-registry.json"}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewClient(config, *logger, tracer)
+	client.Filesystem = &fs.TestFS{}
+
+	info, queryInfo, err := client.GetChainInfo(context.Background())
+	require.NoError(t, err)
+	assert.True(t, queryInfo.Success)
+	require.NotNil(t, info)
+	assert.Equal(t, "cosmoshub", info.ChainName)
+	assert.Equal(t, "v17.2.0", info.Codebase.RecommendedVersion)
+	assert.Equal(t, []string{"v17.1.0", "v17.2.0"}, info.Codebase.CompatibleVersions)
+	require.Len(t, info.Codebase.Versions, 3)
+	assert.Equal(t, "v17", info.Codebase.Versions[1].Name)
+	assert.Equal(t, int64(20739800), info.Codebase.Versions[1].Height)
+	assert.Zero(t, info.Codebase.Versions[2].Height)
+}
+
+func TestChainRegistryRemoteFailToBuildQuery(t *testing.T) {
+	t.Parallel()
+
+	config := configPkg.ChainRegistryConfig{Path: "https://exa mple.com/chain.json"}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewClient(config, *logger, tracer)
+
+	info, queryInfo, err := client.GetChainInfo(context.Background())
+	require.Error(t, err)
+	assert.False(t, queryInfo.Success)
+	assert.Nil(t, info)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestChainRegistryRemoteQueryError(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		chainRegistryUrl,
+		httpmock.NewErrorResponder(errors.New("custom error")),
+	)
+
+	config := configPkg.ChainRegistryConfig{Path: chainRegistryUrl}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewClient(config, *logger, tracer)
+
+	info, queryInfo, err := client.GetChainInfo(context.Background())
+	require.Error(t, err)
+	require.ErrorContains(t, err, "custom error")
+	assert.False(t, queryInfo.Success)
+	assert.Nil(t, info)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestChainRegistryRemoteBadStatus(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		chainRegistryUrl,
+		httpmock.NewStringResponder(404, "404: Not Found"),
+	)
+
+	config := configPkg.ChainRegistryConfig{Path: chainRegistryUrl}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewClient(config, *logger, tracer)
+
+	info, queryInfo, err := client.GetChainInfo(context.Background())
+	require.Error(t, err)
+	require.ErrorContains(t, err, "status 404")
+	assert.False(t, queryInfo.Success)
+	assert.Nil(t, info)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestChainRegistryRemoteOk(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		chainRegistryUrl,
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("chain-registry.json")),
+	)
+
+	config := configPkg.ChainRegistryConfig{Path: chainRegistryUrl}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewClient(config, *logger, tracer)
+
+	info, queryInfo, err := client.GetChainInfo(context.Background())
+	require.NoError(t, err)
+	assert.True(t, queryInfo.Success)
+	require.NotNil(t, info)
+	assert.Equal(t, "v17.2.0", info.Codebase.RecommendedVersion)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestChainRegistryRemoteCached(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		chainRegistryUrl,
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("chain-registry.json")),
+	)
+
+	config := configPkg.ChainRegistryConfig{Path: chainRegistryUrl}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewClient(config, *logger, tracer)
+
+	info, queryInfo, err := client.GetChainInfo(context.Background())
+	require.NoError(t, err)
+	assert.True(t, queryInfo.Success)
+	require.NotNil(t, info)
+
+	// served from cache
+	info, queryInfo, err = client.GetChainInfo(context.Background())
+	require.NoError(t, err)
+	assert.True(t, queryInfo.Success)
+	require.NotNil(t, info)
+	assert.Equal(t, "v17.2.0", info.Codebase.RecommendedVersion)
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+
+	// cache expired
+	client.LastResultTime = time.Now().Add(-constants.UncachedChainRegistryQueryTime)
+
+	_, queryInfo, err = client.GetChainInfo(context.Background())
+	require.NoError(t, err)
+	assert.True(t, queryInfo.Success)
+	assert.Equal(t, 2, httpmock.GetTotalCallCount())
+}
@@ -0,0 +1,92 @@
+package config_files
+
+import (
+	"context"
+	"main/pkg/constants"
+	"main/pkg/fs"
+	"main/pkg/query_info"
+	"path"
+
+	"github.com/BurntSushi/toml"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/rs/zerolog"
+)
+
+// Client reads the node's own config files from <chain-folder>/config,
+// so the node settings are known even if gRPC is disabled.
+type Client struct {
+	ChainFolder string
+	Filesystem  fs.FS
+	Logger      zerolog.Logger
+	Tracer      trace.Tracer
+}
+
+func NewClient(chainFolder string, logger zerolog.Logger, tracer trace.Tracer) *Client {
+	return &Client{
+		ChainFolder: chainFolder,
+		Filesystem:  &fs.OsFS{},
+		Logger:      logger.With().Str("component", "config_files").Logger(),
+		Tracer:      tracer,
+	}
+}
+
+func (c *Client) GetConfigToml(ctx context.Context) (*ConfigToml, query_info.QueryInfo, error) {
+	queryInfo := query_info.QueryInfo{
+		Module:  constants.ModuleConfigFiles,
+		Action:  constants.ActionConfigFilesGetConfigToml,
+		Success: false,
+	}
+
+	configToml := &ConfigToml{}
+	if err := c.readFile(ctx, "config.toml", configToml); err != nil {
+		return nil, queryInfo, err
+	}
+
+	queryInfo.Success = true
+	return configToml, queryInfo, nil
+}
+
+func (c *Client) GetAppToml(ctx context.Context) (*AppToml, query_info.QueryInfo, error) {
+	queryInfo := query_info.QueryInfo{
+		Module:  constants.ModuleConfigFiles,
+		Action:  constants.ActionConfigFilesGetAppToml,
+		Success: false,
+	}
+
+	appToml := &AppToml{}
+	if err := c.readFile(ctx, "app.toml", appToml); err != nil {
+		return nil, queryInfo, err
+	}
+
+	queryInfo.Success = true
+	return appToml, queryInfo, nil
+}
+
+func (c *Client) readFile(ctx context.Context, name string, target interface{}) error {
+	filePath := path.Join(c.ChainFolder, "config", name)
+
+	_, span := c.Tracer.Start(
+		ctx,
+		"Reading node config file",
+		trace.WithAttributes(attribute.String("path", filePath)),
+	)
+	defer span.End()
+
+	content, err := c.Filesystem.ReadFile(filePath)
+	if err != nil {
+		c.Logger.Error().Err(err).Str("path", filePath).Msg("Could not read node config file")
+		span.RecordError(err)
+		return err
+	}
+
+	if _, err := toml.Decode(string(content), target); err != nil {
+		c.Logger.Error().Err(err).Str("path", filePath).Msg("Could not parse node config file")
+		span.RecordError(err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package config_files
+
+import (
+	"context"
+	"main/pkg/fs"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/tracing"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigFilesGetConfigTomlNotFound(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewClient(t.TempDir(), *logger, tracer)
+
+	configToml, queryInfo, err := client.GetConfigToml(context.Background())
+	require.Error(t, err)
+	assert.False(t, queryInfo.Success)
+	assert.Nil(t, configToml)
+}
+
+func TestConfigFilesGetConfigTomlInvalid(t *testing.T) {
+	t.Parallel()
+
+	chainFolder := t.TempDir()
+	require.NoError(t, os.MkdirAll(chainFolder+"/config", 0o755))
+	require.NoError(t, os.WriteFile(chainFolder+"/config/config.toml", []byte("invalid"), 0o600))
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewClient(chainFolder, *logger, tracer)
+
+	configToml, queryInfo, err := client.GetConfigToml(context.Background())
+	require.Error(t, err)
+	assert.False(t, queryInfo.Success)
+	assert.Nil(t, configToml)
+}
+
+func TestConfigFilesGetConfigTomlOk(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewClient("node-home", *logger, tracer)
+	client.Filesystem = &fs.TestFS{}
+
+	configToml, queryInfo, err := client.GetConfigToml(context.Background())
+	require.NoError(t, err)
+	assert.True(t, queryInfo.Success)
+	assert.Equal(t, &ConfigToml{
+		RPC: RPCConfig{
+			ListenAddress:      "tcp://127.0.0.1:26657",
+			CORSAllowedOrigins: []string{},
+		},
+		Mempool:   MempoolConfig{Size: 5000, MaxTxsBytes: 1073741824, CacheSize: 10000},
+		StateSync: StateSyncConfig{Enable: false},
+		TxIndex:   TxIndexConfig{Indexer: "kv"},
+		Consensus: ConsensusConfig{DoubleSignCheckHeight: 0},
+	}, configToml)
+}
+
+func TestConfigFilesGetAppTomlInvalidInt(t *testing.T) {
+	t.Parallel()
+
+	for _, content := range []string{
+		"pruning-keep-recent = \"recent\"",
+		"pruning-keep-recent = true",
+	} {
+		chainFolder := t.TempDir()
+		require.NoError(t, os.MkdirAll(chainFolder+"/config", 0o755))
+		require.NoError(t, os.WriteFile(chainFolder+"/config/app.toml", []byte(content), 0o600))
+
+		logger := loggerPkg.GetNopLogger()
+		tracer := tracing.InitNoopTracer()
+		client := NewClient(chainFolder, *logger, tracer)
+
+		appToml, queryInfo, err := client.GetAppToml(context.Background())
+		require.Error(t, err, content)
+		assert.False(t, queryInfo.Success)
+		assert.Nil(t, appToml)
+	}
+}
+
+func TestConfigFilesGetAppTomlOk(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewClient("node-home", *logger, tracer)
+	client.Filesystem = &fs.TestFS{}
+
+	appToml, queryInfo, err := client.GetAppToml(context.Background())
+	require.NoError(t, err)
+	assert.True(t, queryInfo.Success)
+	assert.Equal(t, &AppToml{
+		MinimumGasPrices:  "0.0025uatom",
+		Pruning:           "custom",
+		PruningKeepRecent: 100,
+		PruningInterval:   10,
+		MinRetainBlocks:   0,
+		API:               ServerConfig{Enable: true, Address: "tcp://0.0.0.0:1317"},
+		Grpc:              ServerConfig{Enable: true, Address: "localhost:9090"},
+		GrpcWeb:           ServerConfig{Enable: false},
+		StateSync:         SnapshotsConfig{SnapshotInterval: 1000, SnapshotKeepRecent: 2},
+		Mempool:           AppMempool{MaxTxs: 5000},
+	}, appToml)
+}
@@ -0,0 +1,95 @@
+package config_files
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Int is an integer that can be written either as a number or as a string,
+// as the Cosmos SDK app.toml template has some integers quoted, like pruning-keep-recent = "100".
+type Int int64
+
+func (i *Int) UnmarshalTOML(value interface{}) error {
+	switch typed := value.(type) {
+	case int64:
+		*i = Int(typed)
+	case string:
+		parsed, err := strconv.ParseInt(typed, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		*i = Int(parsed)
+	default:
+		return fmt.Errorf("expected integer, got %T", value)
+	}
+
+	return nil
+}
+
+// ConfigToml is the subset of the CometBFT config.toml the exporter cares about.
+type ConfigToml struct {
+	RPC       RPCConfig       `toml:"rpc"`
+	Mempool   MempoolConfig   `toml:"mempool"`
+	StateSync StateSyncConfig `toml:"statesync"`
+	TxIndex   TxIndexConfig   `toml:"tx_index"`
+	Consensus ConsensusConfig `toml:"consensus"`
+}
+
+type RPCConfig struct {
+	ListenAddress      string   `toml:"laddr"`
+	CORSAllowedOrigins []string `toml:"cors_allowed_origins"`
+}
+
+type ConsensusConfig struct {
+	DoubleSignCheckHeight Int `toml:"double_sign_check_height"`
+}
+
+type MempoolConfig struct {
+	Size        Int `toml:"size"`
+	MaxTxsBytes Int `toml:"max_txs_bytes"`
+	CacheSize   Int `toml:"cache_size"`
+}
+
+type StateSyncConfig struct {
+	Enable bool `toml:"enable"`
+}
+
+type TxIndexConfig struct {
+	Indexer string `toml:"indexer"`
+}
+
+// AppToml is the subset of the Cosmos SDK app.toml the exporter cares about.
+type AppToml struct {
+	MinimumGasPrices  string          `toml:"minimum-gas-prices"`
+	Pruning           string          `toml:"pruning"`
+	PruningKeepRecent Int             `toml:"pruning-keep-recent"`
+	PruningInterval   Int             `toml:"pruning-interval"`
+	MinRetainBlocks   Int             `toml:"min-retain-blocks"`
+	API               ServerConfig    `toml:"api"`
+	Grpc              ServerConfig    `toml:"grpc"`
+	GrpcWeb           ServerConfig    `toml:"grpc-web"`
+	StateSync         SnapshotsConfig `toml:"state-sync"`
+	Mempool           AppMempool      `toml:"mempool"`
+}
+
+type ServerConfig struct {
+	Enable  bool   `toml:"enable"`
+	Address string `toml:"address"`
+}
+
+type SnapshotsConfig struct {
+	SnapshotInterval   Int `toml:"snapshot-interval"`
+	SnapshotKeepRecent Int `toml:"snapshot-keep-recent"`
+}
+
+type AppMempool struct {
+	MaxTxs Int `toml:"max-txs"`
+}
+
+// NodeConfigFiles holds both of the node config files, each of them is nil
+// if it could not be read.
+type NodeConfigFiles struct {
+	Config *ConfigToml
+	App    *AppToml
+}
@@ -2,9 +2,11 @@ package cosmovisor
 
 import (
 	"context"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"main/pkg/config"
 	"main/pkg/constants"
 	"main/pkg/exec"
@@ -13,10 +15,16 @@ import (
 	"main/pkg/types"
 	"main/pkg/utils"
 	"os"
+	"path"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	upgradeTypes "cosmossdk.io/x/upgrade/types"
 
+	"github.com/BurntSushi/toml"
+
 	"go.opentelemetry.io/otel/trace"
 
 	"github.com/rs/zerolog"
@@ -29,6 +37,32 @@ type Cosmovisor struct {
 	CommandExecutor      exec.CommandExecutor
 	Filesystem           fs.FS
 	UpgradeSubfolderPath string
+	// timeouts and failures to start a binary might be transient, so these are retried
+	// after this interval instead of being cached until the binary changes
+	SmokeTestRetryInterval time.Duration
+
+	checksumsCache      map[string]cachedChecksum
+	checksumsCacheMutex sync.Mutex
+
+	smokeTestsCache      map[string]cachedSmokeTest
+	smokeTestsCacheMutex sync.Mutex
+}
+
+// upgrade binaries are large and rarely change, so their checksums are only
+// recalculated when the file size or modification time changes
+type cachedChecksum struct {
+	Size     int64
+	ModTime  time.Time
+	Checksum string
+}
+
+// same for smoke tests, there's no need to run the same binary on every scrape
+type cachedSmokeTest struct {
+	Size      int64
+	ModTime   time.Time
+	Result    types.UpgradeSmokeTestInfo
+	Transient bool
+	TestedAt  time.Time
 }
 
 func NewCosmovisor(
@@ -37,29 +71,16 @@ func NewCosmovisor(
 	tracer trace.Tracer,
 ) *Cosmovisor {
 	return &Cosmovisor{
-		Logger:               logger.With().Str("component", "cosmovisor").Logger(),
-		Config:               config,
-		Tracer:               tracer,
-		CommandExecutor:      &exec.NativeCommandExecutor{},
-		Filesystem:           &fs.OsFS{},
-		UpgradeSubfolderPath: "/cosmovisor/upgrades",
-	}
-}
-
-// a helper to get the first string in a multiline string starting with { and ending with }
-// it's a workaround for cosmovisor as it adds some extra output, causing
-// it to not be valid JSON.
-func getJsonString(input string) string {
-	split := strings.Split(input, "\n")
-	for _, line := range split {
-		trimmed := strings.TrimSpace(line)
-		if strings.HasPrefix(trimmed, "{") && strings.HasSuffix(trimmed, "}") {
-			return trimmed
-		}
+		Logger:                 logger.With().Str("component", "cosmovisor").Logger(),
+		Config:                 config,
+		Tracer:                 tracer,
+		CommandExecutor:        &exec.NativeCommandExecutor{},
+		Filesystem:             &fs.OsFS{},
+		UpgradeSubfolderPath:   "/cosmovisor/upgrades",
+		SmokeTestRetryInterval: constants.UpgradeSmokeTestRetryInterval,
+		checksumsCache:         map[string]cachedChecksum{},
+		smokeTestsCache:        map[string]cachedSmokeTest{},
 	}
-
-	// return the whole line, there's no valid JSON there
-	return input
 }
 
 func (c *Cosmovisor) GetVersion(ctx context.Context) (types.VersionInfo, query_info.QueryInfo, error) {
@@ -95,10 +116,8 @@ func (c *Cosmovisor) GetVersion(ctx context.Context) (types.VersionInfo, query_i
 		return types.VersionInfo{}, queryInfo, err
 	}
 
-	jsonOutput := getJsonString(string(out))
-
-	var versionInfo types.VersionInfo
-	if err := json.Unmarshal([]byte(jsonOutput), &versionInfo); err != nil {
+	versionInfo, jsonOutput, err := types.ParseVersionInfo(out)
+	if err != nil {
 		c.Logger.Error().
 			Err(err).
 			Str("output", jsonOutput).
@@ -237,7 +256,7 @@ func (c *Cosmovisor) GetUpgradeInfo(ctx context.Context) (*upgradeTypes.Plan, qu
 		return nil, queryInfo, nil
 	}
 
-	jsonOutput := getJsonString(string(out))
+	jsonOutput := utils.GetJsonString(string(out))
 
 	var upgradePlan *upgradeTypes.Plan
 	if unmarshalErr := json.Unmarshal([]byte(jsonOutput), &upgradePlan); unmarshalErr != nil {
@@ -252,3 +271,382 @@ func (c *Cosmovisor) GetUpgradeInfo(ctx context.Context) (*upgradeTypes.Plan, qu
 	queryInfo.Success = true
 	return upgradePlan, queryInfo, nil
 }
+
+func (c *Cosmovisor) GetUpgradeBinaryChecksum(
+	ctx context.Context,
+	upgradeName string,
+	algorithm string,
+) (string, query_info.QueryInfo, error) {
+	_, span := c.Tracer.Start(
+		ctx,
+		"Calculating cosmovisor upgrade binary checksum",
+	)
+	defer span.End()
+
+	queryInfo := query_info.QueryInfo{
+		Module:  constants.ModuleCosmovisor,
+		Action:  constants.ActionCosmovisorGetUpgradeBinaryChecksum,
+		Success: false,
+	}
+
+	hasher, err := utils.NewHasher(algorithm)
+	if err != nil {
+		return "", queryInfo, err
+	}
+
+	upgradeBinaryPath := fmt.Sprintf(
+		"%s%s/%s/bin/%s",
+		c.Config.ChainFolder,
+		c.UpgradeSubfolderPath,
+		utils.NormalizeUpgradeName(upgradeName),
+		c.Config.ChainBinaryName,
+	)
+	cacheKey := algorithm + ":" + upgradeBinaryPath
+
+	stat, err := c.Filesystem.Stat(upgradeBinaryPath)
+	if err != nil {
+		span.RecordError(err)
+		return "", queryInfo, err
+	}
+
+	fileInfo, isFileInfo := stat.(os.FileInfo)
+
+	c.checksumsCacheMutex.Lock()
+	defer c.checksumsCacheMutex.Unlock()
+
+	if cached, ok := c.checksumsCache[cacheKey]; ok && isFileInfo &&
+		cached.Size == fileInfo.Size() && cached.ModTime.Equal(fileInfo.ModTime()) {
+		c.Logger.Trace().Str("path", upgradeBinaryPath).Msg("Using cached upgrade binary checksum")
+		queryInfo.Success = true
+		return cached.Checksum, queryInfo, nil
+	}
+
+	// upgrade binaries are large, so these are streamed into the hasher instead of being read into memory
+	file, err := c.Filesystem.Open(upgradeBinaryPath)
+	if err != nil {
+		c.Logger.Error().Err(err).Str("path", upgradeBinaryPath).Msg("Could not open upgrade binary")
+		span.RecordError(err)
+		return "", queryInfo, err
+	}
+	defer file.Close()
+
+	if _, err := io.Copy(hasher, file); err != nil {
+		c.Logger.Error().Err(err).Str("path", upgradeBinaryPath).Msg("Could not read upgrade binary")
+		span.RecordError(err)
+		return "", queryInfo, err
+	}
+
+	checksum := hex.EncodeToString(hasher.Sum(nil))
+
+	if isFileInfo {
+		c.checksumsCache[cacheKey] = cachedChecksum{
+			Size:     fileInfo.Size(),
+			ModTime:  fileInfo.ModTime(),
+			Checksum: checksum,
+		}
+	}
+
+	queryInfo.Success = true
+	return checksum, queryInfo, nil
+}
+
+// SmokeTestUpgradeBinary runs `<binary> version --long --output json` for the binary
+// in the upgrade folder to check that it can actually be started on this host.
+// A binary that fails to run is not a query error, it is reported as not runnable.
+// Results are cached per binary size and modification time. If the run timed out
+// or the binary could not be started, the result is only cached for SmokeTestRetryInterval,
+// so a hanging binary does not block every scrape.
+func (c *Cosmovisor) SmokeTestUpgradeBinary(
+	ctx context.Context,
+	upgradeName string,
+) (types.UpgradeSmokeTestInfo, query_info.QueryInfo, error) {
+	_, span := c.Tracer.Start(
+		ctx,
+		"Smoke-testing cosmovisor upgrade binary",
+	)
+	defer span.End()
+
+	queryInfo := query_info.QueryInfo{
+		Module:  constants.ModuleCosmovisor,
+		Action:  constants.ActionCosmovisorSmokeTestUpgradeBinary,
+		Success: false,
+	}
+
+	result := types.UpgradeSmokeTestInfo{Name: upgradeName}
+
+	upgradeBinaryPath := fmt.Sprintf(
+		"%s%s/%s/bin/%s",
+		c.Config.ChainFolder,
+		c.UpgradeSubfolderPath,
+		utils.NormalizeUpgradeName(upgradeName),
+		c.Config.ChainBinaryName,
+	)
+
+	stat, err := c.Filesystem.Stat(upgradeBinaryPath)
+	if err != nil {
+		span.RecordError(err)
+		return result, queryInfo, err
+	}
+
+	fileInfo, isFileInfo := stat.(os.FileInfo)
+
+	c.smokeTestsCacheMutex.Lock()
+	cached, ok := c.smokeTestsCache[upgradeBinaryPath]
+	c.smokeTestsCacheMutex.Unlock()
+
+	if ok &&
+		isFileInfo &&
+		cached.Size == fileInfo.Size() &&
+		cached.ModTime.Equal(fileInfo.ModTime()) &&
+		(!cached.Transient || time.Since(cached.TestedAt) < c.SmokeTestRetryInterval) {
+		c.Logger.Trace().Str("path", upgradeBinaryPath).Msg("Using cached upgrade binary smoke test result")
+		queryInfo.Success = true
+		return cached.Result, queryInfo, nil
+	}
+
+	out, err := c.CommandExecutor.RunWithTimeout(
+		upgradeBinaryPath,
+		[]string{"version", "--long", "--output", "json"},
+		os.Environ(),
+		constants.UpgradeBinarySmokeTestTimeout,
+	)
+	if err != nil {
+		c.Logger.Warn().
+			Err(err).
+			Str("path", upgradeBinaryPath).
+			Str("output", utils.DecolorifyString(string(out))).
+			Msg("Upgrade binary is not runnable")
+	} else if versionInfo, jsonOutput, parseErr := types.ParseVersionInfo(out); parseErr != nil {
+		c.Logger.Warn().
+			Err(parseErr).
+			Str("path", upgradeBinaryPath).
+			Str("output", jsonOutput).
+			Msg("Could not unmarshall upgrade binary version")
+		result.Runnable = true
+	} else {
+		result.Runnable = true
+		result.Version = versionInfo.Version
+	}
+
+	if isFileInfo {
+		c.smokeTestsCacheMutex.Lock()
+		c.smokeTestsCache[upgradeBinaryPath] = cachedSmokeTest{
+			Size:      fileInfo.Size(),
+			ModTime:   fileInfo.ModTime(),
+			Result:    result,
+			Transient: err != nil && !exec.IsExitError(err),
+			TestedAt:  time.Now(),
+		}
+		c.smokeTestsCacheMutex.Unlock()
+	}
+
+	queryInfo.Success = true
+	return result, queryInfo, nil
+}
+
+// GetState resolves what the cosmovisor/current symlink points to, checks the genesis
+// binary and lists the upgrade folders with their binaries.
+func (c *Cosmovisor) GetState(ctx context.Context) (*types.CosmovisorState, query_info.QueryInfo, error) {
+	_, span := c.Tracer.Start(
+		ctx,
+		"Fetching cosmovisor state",
+	)
+	defer span.End()
+
+	queryInfo := query_info.QueryInfo{
+		Module:  constants.ModuleCosmovisor,
+		Action:  constants.ActionCosmovisorGetState,
+		Success: false,
+	}
+
+	upgradesFolder := c.Config.ChainFolder + c.UpgradeSubfolderPath
+	cosmovisorFolder := path.Dir(upgradesFolder)
+
+	state := types.CosmovisorState{}
+
+	currentTarget, err := c.Filesystem.Readlink(cosmovisorFolder + "/current")
+	if err != nil {
+		// cosmovisor creates the symlink on the first run, so it might be missing
+		c.Logger.Warn().Err(err).Msg("Could not resolve Cosmovisor current symlink")
+	} else {
+		state.CurrentUpgrade = c.getCurrentUpgradeName(currentTarget)
+	}
+
+	genesisBinaryPresent, _, err := c.statBinary(cosmovisorFolder + "/" + constants.CosmovisorGenesisName)
+	if err != nil {
+		span.RecordError(err)
+		c.Logger.Error().Err(err).Msg("Could not check Cosmovisor genesis binary")
+		return nil, queryInfo, err
+	}
+
+	state.GenesisBinaryPresent = genesisBinaryPresent
+
+	upgradesFolderContent, err := c.Filesystem.ReadDir(upgradesFolder)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		span.RecordError(err)
+		c.Logger.Error().Err(err).Msg("Could not fetch Cosmovisor upgrades folder content")
+		return nil, queryInfo, err
+	}
+
+	state.Upgrades = make([]types.CosmovisorUpgradeFolder, 0, len(upgradesFolderContent))
+
+	for _, upgradeFolder := range upgradesFolderContent {
+		if !upgradeFolder.IsDir() {
+			continue
+		}
+
+		binaryPresent, binaryInfo, err := c.statBinary(upgradesFolder + "/" + upgradeFolder.Name())
+		if err != nil {
+			span.RecordError(err)
+			c.Logger.Error().Err(err).Msg("Could not check Cosmovisor upgrade binary")
+			return nil, queryInfo, err
+		}
+
+		folder := types.CosmovisorUpgradeFolder{
+			Name:          types.UpgradeNameFromFolder(upgradeFolder.Name()),
+			BinaryPresent: binaryPresent,
+		}
+
+		if binaryInfo != nil {
+			folder.BinaryModTime = binaryInfo.ModTime()
+			folder.HasBinaryModTime = true
+		}
+
+		state.Upgrades = append(state.Upgrades, folder)
+	}
+
+	queryInfo.Success = true
+	return &state, queryInfo, nil
+}
+
+// GetSettings parses cosmovisor/config.toml, which Cosmovisor v1.6+ reads its settings from
+// instead of the environment. If there's no such file, Cosmovisor is configured via env
+// variables of its own process, which the exporter cannot see, so nil is returned.
+func (c *Cosmovisor) GetSettings(ctx context.Context) (*types.CosmovisorSettings, query_info.QueryInfo, error) {
+	_, span := c.Tracer.Start(
+		ctx,
+		"Fetching cosmovisor settings",
+	)
+	defer span.End()
+
+	queryInfo := query_info.QueryInfo{
+		Module:  constants.ModuleCosmovisor,
+		Action:  constants.ActionCosmovisorGetSettings,
+		Success: false,
+	}
+
+	configPath := path.Dir(c.Config.ChainFolder+c.UpgradeSubfolderPath) + "/" + constants.CosmovisorConfigName
+
+	content, err := c.Filesystem.ReadFile(configPath)
+	if errors.Is(err, os.ErrNotExist) {
+		c.Logger.Trace().Str("path", configPath).Msg("Cosmovisor config file is not present")
+		queryInfo.Success = true
+		return nil, queryInfo, nil
+	} else if err != nil {
+		c.Logger.Error().Err(err).Str("path", configPath).Msg("Could not read Cosmovisor config")
+		span.RecordError(err)
+		return nil, queryInfo, err
+	}
+
+	var rawSettings map[string]interface{}
+	if _, err := toml.Decode(string(content), &rawSettings); err != nil {
+		c.Logger.Error().Err(err).Str("path", configPath).Msg("Could not parse Cosmovisor config")
+		span.RecordError(err)
+		return nil, queryInfo, err
+	}
+
+	settings := types.CosmovisorSettings{
+		RestartAfterUpgrade: true,
+		PollInterval:        constants.CosmovisorDefaultPollInterval,
+		Values:              make(map[string]string, len(rawSettings)),
+	}
+
+	for key, rawValue := range rawSettings {
+		name := strings.ToUpper(key)
+		value := fmt.Sprint(rawValue)
+
+		switch name {
+		case "DAEMON_ALLOW_DOWNLOAD_BINARIES":
+			settings.AllowDownloadBinaries, err = parseSettingBool(rawValue)
+		case "DAEMON_RESTART_AFTER_UPGRADE":
+			settings.RestartAfterUpgrade, err = parseSettingBool(rawValue)
+		case "UNSAFE_SKIP_BACKUP":
+			settings.UnsafeSkipBackup, err = parseSettingBool(rawValue)
+		case "DAEMON_POLL_INTERVAL":
+			settings.PollInterval, err = parseSettingDuration(rawValue)
+			value = settings.PollInterval.String()
+		case "DAEMON_RESTART_DELAY":
+			settings.RestartDelay, err = parseSettingDuration(rawValue)
+			value = settings.RestartDelay.String()
+		case "DAEMON_SHUTDOWN_GRACE":
+			var shutdownGrace time.Duration
+			shutdownGrace, err = parseSettingDuration(rawValue)
+			value = shutdownGrace.String()
+		}
+
+		if err != nil {
+			c.Logger.Error().
+				Err(err).
+				Str("name", name).
+				Str("value", fmt.Sprint(rawValue)).
+				Msg("Could not parse Cosmovisor setting")
+			span.RecordError(err)
+			return nil, queryInfo, err
+		}
+
+		settings.Values[name] = value
+	}
+
+	queryInfo.Success = true
+	return &settings, queryInfo, nil
+}
+
+func parseSettingBool(value interface{}) (bool, error) {
+	switch typed := value.(type) {
+	case bool:
+		return typed, nil
+	case string:
+		return strconv.ParseBool(typed)
+	default:
+		return false, fmt.Errorf("expected bool, got %T", value)
+	}
+}
+
+// durations are written to config.toml either as nanoseconds or as Go duration strings,
+// Cosmovisor accepts both.
+func parseSettingDuration(value interface{}) (time.Duration, error) {
+	switch typed := value.(type) {
+	case int64:
+		return time.Duration(typed), nil
+	case string:
+		return time.ParseDuration(typed)
+	default:
+		return 0, fmt.Errorf("expected duration, got %T", value)
+	}
+}
+
+// statBinary checks whether <folder>/bin/<binary> exists, returning its file info
+// if the filesystem provides it.
+func (c *Cosmovisor) statBinary(folder string) (bool, os.FileInfo, error) {
+	stat, err := c.Filesystem.Stat(folder + "/bin/" + c.Config.ChainBinaryName)
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil, nil
+	} else if err != nil {
+		return false, nil, err
+	}
+
+	fileInfo, _ := stat.(os.FileInfo)
+	return true, fileInfo, nil
+}
+
+// getCurrentUpgradeName converts the current symlink target, which is either
+// <cosmovisor>/genesis or <cosmovisor>/upgrades/<name>, to the upgrade name.
+func (c *Cosmovisor) getCurrentUpgradeName(target string) string {
+	target = path.Clean(target)
+
+	if path.Base(target) == constants.CosmovisorGenesisName && path.Base(path.Dir(target)) != "upgrades" {
+		return constants.CosmovisorGenesisName
+	}
+
+	return types.UpgradeNameFromFolder(path.Base(target))
+}
@@ -8,7 +8,9 @@ import (
 	"main/pkg/fs"
 	loggerPkg "main/pkg/logger"
 	"main/pkg/tracing"
+	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -335,3 +337,627 @@ func TestCosmovisorGetUpgradeInfoOk(t *testing.T) {
 	assert.NotEmpty(t, upgradeInfo)
 	assert.Equal(t, int64(999), upgradeInfo.Height)
 }
+
+func TestCosmovisorGetUpgradeBinaryChecksumUnsupportedAlgorithm(t *testing.T) {
+	t.Parallel()
+
+	config := configPkg.CosmovisorConfig{
+		Enabled:         null.BoolFrom(true),
+		ChainBinaryName: "gaiad",
+		ChainFolder:     "",
+		CosmovisorPath:  "/home/validator/go/bin/cosmovisor",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewCosmovisor(config, *logger, tracer)
+	client.Filesystem = &fs.TestFS{}
+	client.UpgradeSubfolderPath = "cosmovisor-binaries"
+
+	checksum, queryInfo, err := client.GetUpgradeBinaryChecksum(context.Background(), "v15", "md4")
+	require.Error(t, err)
+	require.ErrorContains(t, err, "unsupported checksum algorithm")
+	assert.False(t, queryInfo.Success)
+	assert.Empty(t, checksum)
+}
+
+func TestCosmovisorGetUpgradeBinaryChecksumStatError(t *testing.T) {
+	t.Parallel()
+
+	config := configPkg.CosmovisorConfig{
+		Enabled:         null.BoolFrom(true),
+		ChainBinaryName: "gaiad",
+		ChainFolder:     "",
+		CosmovisorPath:  "/home/validator/go/bin/cosmovisor",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewCosmovisor(config, *logger, tracer)
+	client.Filesystem = &fs.TestFS{FileNotFound: true}
+	client.UpgradeSubfolderPath = "cosmovisor-binaries"
+
+	checksum, queryInfo, err := client.GetUpgradeBinaryChecksum(context.Background(), "v15", "sha256")
+	require.Error(t, err)
+	assert.False(t, queryInfo.Success)
+	assert.Empty(t, checksum)
+}
+
+func TestCosmovisorGetUpgradeBinaryChecksumReadError(t *testing.T) {
+	t.Parallel()
+
+	config := configPkg.CosmovisorConfig{
+		Enabled:         null.BoolFrom(true),
+		ChainBinaryName: "gaiad",
+		ChainFolder:     "",
+		CosmovisorPath:  "/home/validator/go/bin/cosmovisor",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewCosmovisor(config, *logger, tracer)
+	client.Filesystem = &fs.TestFS{}
+	client.UpgradeSubfolderPath = "cosmovisor-binaries"
+
+	checksum, queryInfo, err := client.GetUpgradeBinaryChecksum(context.Background(), "v16", "sha256")
+	require.Error(t, err)
+	assert.False(t, queryInfo.Success)
+	assert.Empty(t, checksum)
+}
+
+func TestCosmovisorGetUpgradeBinaryChecksumOk(t *testing.T) {
+	t.Parallel()
+
+	config := configPkg.CosmovisorConfig{
+		Enabled:         null.BoolFrom(true),
+		ChainBinaryName: "gaiad",
+		ChainFolder:     "",
+		CosmovisorPath:  "/home/validator/go/bin/cosmovisor",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewCosmovisor(config, *logger, tracer)
+	client.Filesystem = &fs.TestFS{}
+	client.UpgradeSubfolderPath = "cosmovisor-binaries"
+
+	checksum, queryInfo, err := client.GetUpgradeBinaryChecksum(context.Background(), "V15", "sha256")
+	require.NoError(t, err)
+	assert.True(t, queryInfo.Success)
+	assert.Equal(t, "447681e38a81da27bf0ed433ac72e62742f53506b8f16264552041f5e8a6e94e", checksum)
+
+	checksum, queryInfo, err = client.GetUpgradeBinaryChecksum(context.Background(), "v15", "sha512")
+	require.NoError(t, err)
+	assert.True(t, queryInfo.Success)
+	assert.Len(t, checksum, 128)
+}
+
+func TestCosmovisorGetUpgradeBinaryChecksumCached(t *testing.T) {
+	t.Parallel()
+
+	chainFolder := t.TempDir()
+	binaryFolder := chainFolder + "/upgrades/v15/bin"
+	require.NoError(t, os.MkdirAll(binaryFolder, 0o755))
+	require.NoError(t, os.WriteFile(binaryFolder+"/gaiad", []byte("first"), 0o600))
+
+	config := configPkg.CosmovisorConfig{
+		Enabled:         null.BoolFrom(true),
+		ChainBinaryName: "gaiad",
+		ChainFolder:     chainFolder,
+		CosmovisorPath:  "/home/validator/go/bin/cosmovisor",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewCosmovisor(config, *logger, tracer)
+	client.UpgradeSubfolderPath = "/upgrades"
+
+	first, _, err := client.GetUpgradeBinaryChecksum(context.Background(), "v15", "sha256")
+	require.NoError(t, err)
+
+	cached, _, err := client.GetUpgradeBinaryChecksum(context.Background(), "v15", "sha256")
+	require.NoError(t, err)
+	assert.Equal(t, first, cached)
+
+	// a different size invalidates the cache
+	require.NoError(t, os.WriteFile(binaryFolder+"/gaiad", []byte("second"), 0o600))
+
+	second, _, err := client.GetUpgradeBinaryChecksum(context.Background(), "v15", "sha256")
+	require.NoError(t, err)
+	assert.NotEqual(t, first, second)
+}
+
+func TestCosmovisorSmokeTestUpgradeBinaryStatError(t *testing.T) {
+	t.Parallel()
+
+	config := configPkg.CosmovisorConfig{
+		Enabled:         null.BoolFrom(true),
+		ChainBinaryName: "gaiad",
+		ChainFolder:     "",
+		CosmovisorPath:  "/home/validator/go/bin/cosmovisor",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewCosmovisor(config, *logger, tracer)
+	client.Filesystem = &fs.TestFS{FileError: true}
+
+	result, queryInfo, err := client.SmokeTestUpgradeBinary(context.Background(), "v15")
+	require.Error(t, err)
+	assert.False(t, queryInfo.Success)
+	assert.False(t, result.Runnable)
+}
+
+func TestCosmovisorSmokeTestUpgradeBinaryNotRunnable(t *testing.T) {
+	t.Parallel()
+
+	config := configPkg.CosmovisorConfig{
+		Enabled:         null.BoolFrom(true),
+		ChainBinaryName: "gaiad",
+		ChainFolder:     "",
+		CosmovisorPath:  "/home/validator/go/bin/cosmovisor",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewCosmovisor(config, *logger, tracer)
+	client.Filesystem = &fs.TestFS{}
+	client.CommandExecutor = &exec.TestCommandExecutor{Fail: true}
+
+	result, queryInfo, err := client.SmokeTestUpgradeBinary(context.Background(), "v15")
+	require.NoError(t, err)
+	assert.True(t, queryInfo.Success)
+	assert.Equal(t, "v15", result.Name)
+	assert.False(t, result.Runnable)
+	assert.Empty(t, result.Version)
+}
+
+func TestCosmovisorSmokeTestUpgradeBinaryInvalidOutput(t *testing.T) {
+	t.Parallel()
+
+	config := configPkg.CosmovisorConfig{
+		Enabled:         null.BoolFrom(true),
+		ChainBinaryName: "gaiad",
+		ChainFolder:     "",
+		CosmovisorPath:  "/home/validator/go/bin/cosmovisor",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewCosmovisor(config, *logger, tracer)
+	client.Filesystem = &fs.TestFS{}
+
+	client.CommandExecutor = &exec.TestCommandExecutor{Expected: []byte("gaiad v15.0.0")}
+
+	result, queryInfo, err := client.SmokeTestUpgradeBinary(context.Background(), "v15")
+	require.NoError(t, err)
+	assert.True(t, queryInfo.Success)
+	assert.True(t, result.Runnable)
+	assert.Empty(t, result.Version)
+}
+
+func TestCosmovisorSmokeTestUpgradeBinaryOk(t *testing.T) {
+	t.Parallel()
+
+	config := configPkg.CosmovisorConfig{
+		Enabled:         null.BoolFrom(true),
+		ChainBinaryName: "gaiad",
+		ChainFolder:     "",
+		CosmovisorPath:  "/home/validator/go/bin/cosmovisor",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewCosmovisor(config, *logger, tracer)
+	client.Filesystem = &fs.TestFS{}
+
+	content := assets.GetBytesOrPanic("cosmovisor-app-version-ok.txt")
+	client.CommandExecutor = &exec.TestCommandExecutor{Expected: content}
+
+	result, queryInfo, err := client.SmokeTestUpgradeBinary(context.Background(), "v15")
+	require.NoError(t, err)
+	assert.True(t, queryInfo.Success)
+	assert.True(t, result.Runnable)
+	assert.Equal(t, "1.6.4", result.Version)
+}
+
+func TestCosmovisorSmokeTestUpgradeBinaryCached(t *testing.T) {
+	t.Parallel()
+
+	chainFolder := t.TempDir()
+	binaryFolder := chainFolder + "/upgrades/v15/bin"
+	require.NoError(t, os.MkdirAll(binaryFolder, 0o755))
+	require.NoError(t, os.WriteFile(binaryFolder+"/gaiad", []byte("first"), 0o600))
+
+	config := configPkg.CosmovisorConfig{
+		Enabled:         null.BoolFrom(true),
+		ChainBinaryName: "gaiad",
+		ChainFolder:     chainFolder,
+		CosmovisorPath:  "/home/validator/go/bin/cosmovisor",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewCosmovisor(config, *logger, tracer)
+	client.UpgradeSubfolderPath = "/upgrades"
+
+	content := assets.GetBytesOrPanic("cosmovisor-app-version-ok.txt")
+	executor := &exec.TestCommandExecutor{Expected: content}
+	client.CommandExecutor = executor
+
+	first, _, err := client.SmokeTestUpgradeBinary(context.Background(), "v15")
+	require.NoError(t, err)
+	assert.True(t, first.Runnable)
+
+	// the binary was not changed, so it is not run again
+	executor.Fail = true
+
+	cached, _, err := client.SmokeTestUpgradeBinary(context.Background(), "v15")
+	require.NoError(t, err)
+	assert.Equal(t, first, cached)
+
+	// a different size invalidates the cache
+	require.NoError(t, os.WriteFile(binaryFolder+"/gaiad", []byte("second"), 0o600))
+
+	second, _, err := client.SmokeTestUpgradeBinary(context.Background(), "v15")
+	require.NoError(t, err)
+	assert.False(t, second.Runnable)
+}
+
+func TestCosmovisorSmokeTestUpgradeBinaryTransientFailureRetried(t *testing.T) {
+	t.Parallel()
+
+	chainFolder := t.TempDir()
+	binaryFolder := chainFolder + "/upgrades/v15/bin"
+	require.NoError(t, os.MkdirAll(binaryFolder, 0o755))
+	require.NoError(t, os.WriteFile(binaryFolder+"/gaiad", []byte("binary"), 0o600))
+
+	config := configPkg.CosmovisorConfig{
+		Enabled:         null.BoolFrom(true),
+		ChainBinaryName: "gaiad",
+		ChainFolder:     chainFolder,
+		CosmovisorPath:  "/home/validator/go/bin/cosmovisor",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewCosmovisor(config, *logger, tracer)
+	client.UpgradeSubfolderPath = "/upgrades"
+
+	content := assets.GetBytesOrPanic("cosmovisor-app-version-ok.txt")
+	executor := &exec.TestCommandExecutor{Fail: true, Expected: content}
+	client.CommandExecutor = executor
+
+	// the test executor error is not an exit error, so it is treated as transient
+	first, _, err := client.SmokeTestUpgradeBinary(context.Background(), "v15")
+	require.NoError(t, err)
+	assert.False(t, first.Runnable)
+
+	executor.Fail = false
+
+	// the retry interval has not passed yet, so the binary is not run again
+	cached, _, err := client.SmokeTestUpgradeBinary(context.Background(), "v15")
+	require.NoError(t, err)
+	assert.Equal(t, first, cached)
+
+	client.SmokeTestRetryInterval = 0
+
+	second, _, err := client.SmokeTestUpgradeBinary(context.Background(), "v15")
+	require.NoError(t, err)
+	assert.True(t, second.Runnable)
+}
+
+func getTestCosmovisorFolder(t *testing.T) string {
+	t.Helper()
+
+	chainFolder := t.TempDir()
+	for _, folder := range []string{"genesis/bin", "upgrades/v16/bin", "upgrades/v17"} {
+		require.NoError(t, os.MkdirAll(chainFolder+"/cosmovisor/"+folder, 0o755))
+	}
+
+	for _, binary := range []string{"genesis/bin/gaiad", "upgrades/v16/bin/gaiad", "upgrades/notes.txt"} {
+		require.NoError(t, os.WriteFile(chainFolder+"/cosmovisor/"+binary, []byte("binary"), 0o600))
+	}
+
+	return chainFolder
+}
+
+func TestCosmovisorGetStateUpgrade(t *testing.T) {
+	t.Parallel()
+
+	chainFolder := getTestCosmovisorFolder(t)
+	require.NoError(t, os.Symlink(chainFolder+"/cosmovisor/upgrades/v16", chainFolder+"/cosmovisor/current"))
+
+	config := configPkg.CosmovisorConfig{
+		Enabled:         null.BoolFrom(true),
+		ChainBinaryName: "gaiad",
+		ChainFolder:     chainFolder,
+		CosmovisorPath:  "/home/validator/go/bin/cosmovisor",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewCosmovisor(config, *logger, tracer)
+
+	state, queryInfo, err := client.GetState(context.Background())
+	require.NoError(t, err)
+	assert.True(t, queryInfo.Success)
+	require.NotNil(t, state)
+	assert.Equal(t, "v16", state.CurrentUpgrade)
+	assert.True(t, state.GenesisBinaryPresent)
+	require.Len(t, state.Upgrades, 2)
+
+	assert.Equal(t, "v16", state.Upgrades[0].Name)
+	assert.True(t, state.Upgrades[0].BinaryPresent)
+	assert.True(t, state.Upgrades[0].HasBinaryModTime)
+	assert.False(t, state.Upgrades[0].BinaryModTime.IsZero())
+
+	assert.Equal(t, "v17", state.Upgrades[1].Name)
+	assert.False(t, state.Upgrades[1].BinaryPresent)
+	assert.False(t, state.Upgrades[1].HasBinaryModTime)
+}
+
+func TestCosmovisorGetStateGenesis(t *testing.T) {
+	t.Parallel()
+
+	chainFolder := getTestCosmovisorFolder(t)
+	require.NoError(t, os.Symlink("genesis", chainFolder+"/cosmovisor/current"))
+
+	config := configPkg.CosmovisorConfig{
+		Enabled:         null.BoolFrom(true),
+		ChainBinaryName: "gaiad",
+		ChainFolder:     chainFolder,
+		CosmovisorPath:  "/home/validator/go/bin/cosmovisor",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewCosmovisor(config, *logger, tracer)
+
+	state, queryInfo, err := client.GetState(context.Background())
+	require.NoError(t, err)
+	assert.True(t, queryInfo.Success)
+	assert.Equal(t, "genesis", state.CurrentUpgrade)
+}
+
+func TestCosmovisorGetStateEmpty(t *testing.T) {
+	t.Parallel()
+
+	config := configPkg.CosmovisorConfig{
+		Enabled:         null.BoolFrom(true),
+		ChainBinaryName: "gaiad",
+		ChainFolder:     t.TempDir(),
+		CosmovisorPath:  "/home/validator/go/bin/cosmovisor",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewCosmovisor(config, *logger, tracer)
+
+	state, queryInfo, err := client.GetState(context.Background())
+	require.NoError(t, err)
+	assert.True(t, queryInfo.Success)
+	assert.Empty(t, state.CurrentUpgrade)
+	assert.False(t, state.GenesisBinaryPresent)
+	assert.Empty(t, state.Upgrades)
+}
+
+func TestCosmovisorGetStateStatError(t *testing.T) {
+	t.Parallel()
+
+	config := configPkg.CosmovisorConfig{
+		Enabled:         null.BoolFrom(true),
+		ChainBinaryName: "gaiad",
+		ChainFolder:     "",
+		CosmovisorPath:  "/home/validator/go/bin/cosmovisor",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewCosmovisor(config, *logger, tracer)
+	client.Filesystem = &fs.TestFS{FileError: true}
+	client.UpgradeSubfolderPath = "cosmovisor/upgrades"
+
+	state, queryInfo, err := client.GetState(context.Background())
+	require.Error(t, err)
+	assert.False(t, queryInfo.Success)
+	assert.Nil(t, state)
+}
+
+func TestCosmovisorGetStateNoModTime(t *testing.T) {
+	t.Parallel()
+
+	config := configPkg.CosmovisorConfig{
+		Enabled:         null.BoolFrom(true),
+		ChainBinaryName: "gaiad",
+		ChainFolder:     "",
+		CosmovisorPath:  "/home/validator/go/bin/cosmovisor",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewCosmovisor(config, *logger, tracer)
+	client.Filesystem = &fs.TestFS{}
+	client.UpgradeSubfolderPath = "cosmovisor/upgrades"
+
+	// TestFS does not return file info, so there's no modification time
+	state, queryInfo, err := client.GetState(context.Background())
+	require.NoError(t, err)
+	assert.True(t, queryInfo.Success)
+	assert.True(t, state.GenesisBinaryPresent)
+	require.Len(t, state.Upgrades, 1)
+	assert.Equal(t, "v15", state.Upgrades[0].Name)
+	assert.True(t, state.Upgrades[0].BinaryPresent)
+	assert.False(t, state.Upgrades[0].HasBinaryModTime)
+}
+
+func TestCosmovisorGetStateReadDirError(t *testing.T) {
+	t.Parallel()
+
+	chainFolder := t.TempDir()
+	require.NoError(t, os.MkdirAll(chainFolder+"/cosmovisor", 0o755))
+	require.NoError(t, os.WriteFile(chainFolder+"/cosmovisor/upgrades", []byte("not a folder"), 0o600))
+
+	config := configPkg.CosmovisorConfig{
+		Enabled:         null.BoolFrom(true),
+		ChainBinaryName: "gaiad",
+		ChainFolder:     chainFolder,
+		CosmovisorPath:  "/home/validator/go/bin/cosmovisor",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewCosmovisor(config, *logger, tracer)
+
+	state, queryInfo, err := client.GetState(context.Background())
+	require.Error(t, err)
+	assert.False(t, queryInfo.Success)
+	assert.Nil(t, state)
+}
+
+func TestCosmovisorGetSettingsNotPresent(t *testing.T) {
+	t.Parallel()
+
+	config := configPkg.CosmovisorConfig{
+		Enabled:         null.BoolFrom(true),
+		ChainBinaryName: "gaiad",
+		ChainFolder:     t.TempDir(),
+		CosmovisorPath:  "/home/validator/go/bin/cosmovisor",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewCosmovisor(config, *logger, tracer)
+
+	settings, queryInfo, err := client.GetSettings(context.Background())
+	require.NoError(t, err)
+	assert.True(t, queryInfo.Success)
+	assert.Nil(t, settings)
+}
+
+func TestCosmovisorGetSettingsReadError(t *testing.T) {
+	t.Parallel()
+
+	chainFolder := t.TempDir()
+	require.NoError(t, os.MkdirAll(chainFolder+"/cosmovisor/config.toml", 0o755))
+
+	config := configPkg.CosmovisorConfig{
+		Enabled:         null.BoolFrom(true),
+		ChainBinaryName: "gaiad",
+		ChainFolder:     chainFolder,
+		CosmovisorPath:  "/home/validator/go/bin/cosmovisor",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewCosmovisor(config, *logger, tracer)
+
+	settings, queryInfo, err := client.GetSettings(context.Background())
+	require.Error(t, err)
+	assert.False(t, queryInfo.Success)
+	assert.Nil(t, settings)
+}
+
+func TestCosmovisorGetSettingsInvalidToml(t *testing.T) {
+	t.Parallel()
+
+	chainFolder := t.TempDir()
+	require.NoError(t, os.MkdirAll(chainFolder+"/cosmovisor", 0o755))
+	require.NoError(t, os.WriteFile(chainFolder+"/cosmovisor/config.toml", []byte("invalid"), 0o600))
+
+	config := configPkg.CosmovisorConfig{
+		Enabled:         null.BoolFrom(true),
+		ChainBinaryName: "gaiad",
+		ChainFolder:     chainFolder,
+		CosmovisorPath:  "/home/validator/go/bin/cosmovisor",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewCosmovisor(config, *logger, tracer)
+
+	settings, queryInfo, err := client.GetSettings(context.Background())
+	require.Error(t, err)
+	assert.False(t, queryInfo.Success)
+	assert.Nil(t, settings)
+}
+
+func TestCosmovisorGetSettingsInvalidValue(t *testing.T) {
+	t.Parallel()
+
+	for _, content := range []string{
+		"daemon_restart_after_upgrade = 'maybe'",
+		"daemon_restart_after_upgrade = 1",
+		"daemon_poll_interval = 'often'",
+		"daemon_shutdown_grace = true",
+	} {
+		chainFolder := t.TempDir()
+		require.NoError(t, os.MkdirAll(chainFolder+"/cosmovisor", 0o755))
+		require.NoError(t, os.WriteFile(chainFolder+"/cosmovisor/config.toml", []byte(content), 0o600))
+
+		config := configPkg.CosmovisorConfig{
+			Enabled:         null.BoolFrom(true),
+			ChainBinaryName: "gaiad",
+			ChainFolder:     chainFolder,
+			CosmovisorPath:  "/home/validator/go/bin/cosmovisor",
+		}
+		logger := loggerPkg.GetNopLogger()
+		tracer := tracing.InitNoopTracer()
+		client := NewCosmovisor(config, *logger, tracer)
+
+		settings, queryInfo, err := client.GetSettings(context.Background())
+		require.Error(t, err, content)
+		assert.False(t, queryInfo.Success)
+		assert.Nil(t, settings)
+	}
+}
+
+func TestCosmovisorGetSettingsDefaults(t *testing.T) {
+	t.Parallel()
+
+	chainFolder := t.TempDir()
+	require.NoError(t, os.MkdirAll(chainFolder+"/cosmovisor", 0o755))
+	require.NoError(t, os.WriteFile(
+		chainFolder+"/cosmovisor/config.toml",
+		[]byte("daemon_name = 'gaiad'\ndaemon_restart_delay = '10s'\nunsafe_skip_backup = 'true'"),
+		0o600,
+	))
+
+	config := configPkg.CosmovisorConfig{
+		Enabled:         null.BoolFrom(true),
+		ChainBinaryName: "gaiad",
+		ChainFolder:     chainFolder,
+		CosmovisorPath:  "/home/validator/go/bin/cosmovisor",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewCosmovisor(config, *logger, tracer)
+
+	settings, queryInfo, err := client.GetSettings(context.Background())
+	require.NoError(t, err)
+	assert.True(t, queryInfo.Success)
+	require.NotNil(t, settings)
+	assert.False(t, settings.AllowDownloadBinaries)
+	assert.True(t, settings.RestartAfterUpgrade)
+	assert.True(t, settings.UnsafeSkipBackup)
+	assert.Equal(t, 300*time.Millisecond, settings.PollInterval)
+	assert.Equal(t, 10*time.Second, settings.RestartDelay)
+	assert.Equal(t, map[string]string{
+		"DAEMON_NAME":          "gaiad",
+		"DAEMON_RESTART_DELAY": "10s",
+		"UNSAFE_SKIP_BACKUP":   "true",
+	}, settings.Values)
+}
+
+func TestCosmovisorGetSettingsOk(t *testing.T) {
+	t.Parallel()
+
+	config := configPkg.CosmovisorConfig{
+		Enabled:         null.BoolFrom(true),
+		ChainBinaryName: "gaiad",
+		ChainFolder:     "",
+		CosmovisorPath:  "/home/validator/go/bin/cosmovisor",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewCosmovisor(config, *logger, tracer)
+	client.UpgradeSubfolderPath = "cosmovisor/upgrades"
+	client.Filesystem = &fs.TestFS{}
+
+	settings, queryInfo, err := client.GetSettings(context.Background())
+	require.NoError(t, err)
+	assert.True(t, queryInfo.Success)
+	require.NotNil(t, settings)
+	assert.True(t, settings.AllowDownloadBinaries)
+	assert.False(t, settings.RestartAfterUpgrade)
+	assert.True(t, settings.UnsafeSkipBackup)
+	assert.Equal(t, 300*time.Millisecond, settings.PollInterval)
+	assert.Zero(t, settings.RestartDelay)
+	assert.Len(t, settings.Values, 16)
+	assert.Equal(t, "300ms", settings.Values["DAEMON_POLL_INTERVAL"])
+	assert.Equal(t, "0s", settings.Values["DAEMON_SHUTDOWN_GRACE"])
+	assert.Equal(t, "false", settings.Values["DAEMON_RESTART_AFTER_UPGRADE"])
+	assert.Equal(t, "kitchen", settings.Values["COSMOVISOR_TIMEFORMAT_LOGS"])
+	assert.Equal(t, "0", settings.Values["DAEMON_PREUPGRADE_MAX_RETRIES"])
+	assert.Empty(t, settings.Values["COSMOVISOR_CUSTOM_PREUPGRADE"])
+}
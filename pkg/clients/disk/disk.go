@@ -0,0 +1,181 @@
+package disk
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"main/pkg/constants"
+	"main/pkg/query_info"
+	"main/pkg/types"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/rs/zerolog"
+)
+
+type Client struct {
+	ChainFolder  string
+	Directories  []string
+	WalkInterval time.Duration
+	Logger       zerolog.Logger
+	Tracer       trace.Tracer
+
+	// data/ can hold millions of files, so directories are walked in the background once
+	// per WalkInterval, with a single walk per directory at a time
+	walks map[string]*directoryWalk
+	mutex sync.Mutex
+}
+
+type directoryWalk struct {
+	Size        int64
+	WalkedAt    time.Time
+	StartedAt   time.Time
+	IsWalking   bool
+	HasFinished bool
+}
+
+func NewClient(chainFolder string, logger zerolog.Logger, tracer trace.Tracer) *Client {
+	return &Client{
+		ChainFolder:  chainFolder,
+		Directories:  constants.DiskUsageDirectories,
+		WalkInterval: constants.DiskUsageWalkInterval,
+		Logger:       logger.With().Str("component", "disk").Logger(),
+		Tracer:       tracer,
+		walks:        map[string]*directoryWalk{},
+	}
+}
+
+// GetUsage returns the size of each of the chain folder directories and the free space
+// on the filesystem holding it. Directories that do not exist are reported as not present.
+// Sizes are taken from the last completed walk, so these are missing until the first walk finishes.
+func (c *Client) GetUsage(ctx context.Context) ([]types.DirectoryUsage, query_info.QueryInfo, error) {
+	_, span := c.Tracer.Start(
+		ctx,
+		"Fetching disk usage",
+		trace.WithAttributes(attribute.String("path", c.ChainFolder)),
+	)
+	defer span.End()
+
+	queryInfo := query_info.QueryInfo{
+		Module:  constants.ModuleDisk,
+		Action:  constants.ActionDiskGetUsage,
+		Success: false,
+	}
+
+	directories := make([]types.DirectoryUsage, len(c.Directories))
+
+	for index, name := range c.Directories {
+		directory, err := c.getDirectoryUsage(name)
+		if err != nil {
+			c.Logger.Error().Err(err).Str("directory", name).Msg("Could not get directory disk usage")
+			span.RecordError(err)
+			return nil, queryInfo, err
+		}
+
+		directories[index] = directory
+	}
+
+	queryInfo.Success = true
+	return directories, queryInfo, nil
+}
+
+func (c *Client) getDirectoryUsage(name string) (types.DirectoryUsage, error) {
+	directory := types.DirectoryUsage{Name: name}
+	directoryPath := filepath.Join(c.ChainFolder, name)
+
+	stat, err := os.Stat(directoryPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return directory, nil
+	} else if err != nil {
+		return directory, err
+	}
+
+	directory.Present = true
+
+	if err := fillFilesystemStats(directoryPath, stat, &directory); err != nil {
+		return directory, err
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	walk, ok := c.walks[name]
+	if !ok {
+		walk = &directoryWalk{}
+		c.walks[name] = walk
+	}
+
+	if !walk.IsWalking && (walk.StartedAt.IsZero() || time.Since(walk.StartedAt) >= c.WalkInterval) {
+		walk.IsWalking = true
+		walk.StartedAt = time.Now()
+		go c.walkDirectory(name, directoryPath, walk)
+	}
+
+	if walk.HasFinished {
+		directory.Size = walk.Size
+		directory.WalkedAt = walk.WalkedAt
+		directory.HasSize = true
+	}
+
+	return directory, nil
+}
+
+func (c *Client) walkDirectory(name string, directoryPath string, walk *directoryWalk) {
+	c.Logger.Debug().Str("path", directoryPath).Msg("Walking directory to calculate its size")
+
+	size, err := walkDirectorySize(directoryPath)
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	walk.IsWalking = false
+
+	if err != nil {
+		c.Logger.Error().Err(err).Str("directory", name).Msg("Could not walk directory")
+		return
+	}
+
+	walk.Size = size
+	walk.WalkedAt = time.Now()
+	walk.HasFinished = true
+}
+
+func walkDirectorySize(directoryPath string) (int64, error) {
+	// WalkDir does not follow a symlinked root, and data/ is often linked to a separate volume
+	root, err := filepath.EvalSymlinks(directoryPath)
+	if err != nil {
+		return 0, err
+	}
+
+	var size int64
+
+	err = filepath.WalkDir(root, func(_ string, entry fs.DirEntry, err error) error {
+		// files can be removed by the node while walking, like during compaction
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+
+		info, err := entry.Info()
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		size += info.Size()
+		return nil
+	})
+
+	return size, err
+}
@@ -0,0 +1,13 @@
+//go:build !unix
+
+package disk
+
+import (
+	"main/pkg/types"
+	"os"
+)
+
+// fillFilesystemStats is a no-op on platforms without statfs, so only directory sizes are reported there.
+func fillFilesystemStats(_ string, _ os.FileInfo, _ *types.DirectoryUsage) error {
+	return nil
+}
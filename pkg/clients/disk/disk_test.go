@@ -0,0 +1,157 @@
+package disk
+
+import (
+	"context"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/tracing"
+	"main/pkg/types"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func getTestChainFolder(t *testing.T) string {
+	t.Helper()
+
+	chainFolder := t.TempDir()
+	require.NoError(t, os.MkdirAll(chainFolder+"/data/application.db", 0o755))
+	require.NoError(t, os.MkdirAll(chainFolder+"/cosmovisor/genesis/bin", 0o755))
+	require.NoError(t, os.WriteFile(chainFolder+"/data/priv_validator_state.json", make([]byte, 100), 0o600))
+	require.NoError(t, os.WriteFile(chainFolder+"/data/application.db/000001.ldb", make([]byte, 1000), 0o600))
+	require.NoError(t, os.WriteFile(chainFolder+"/cosmovisor/genesis/bin/gaiad", make([]byte, 10), 0o600))
+	require.NoError(t, os.Symlink(chainFolder+"/cosmovisor/genesis", chainFolder+"/cosmovisor/current"))
+
+	return chainFolder
+}
+
+func TestDiskGetUsageError(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewClient("invalid\x00path", *logger, tracer)
+
+	usage, queryInfo, err := client.GetUsage(context.Background())
+	require.Error(t, err)
+	assert.False(t, queryInfo.Success)
+	assert.Nil(t, usage)
+}
+
+func TestDiskGetUsageOk(t *testing.T) {
+	t.Parallel()
+
+	chainFolder := getTestChainFolder(t)
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewClient(chainFolder, *logger, tracer)
+
+	// directories are walked in the background, so the first scrape has no sizes
+	usage, queryInfo, err := client.GetUsage(context.Background())
+	require.NoError(t, err)
+	assert.True(t, queryInfo.Success)
+	require.Len(t, usage, 3)
+	assert.True(t, usage[0].Present)
+	assert.True(t, usage[0].HasFilesystemStats)
+	assert.Positive(t, usage[0].TotalBytes)
+
+	usage = waitForWalks(t, client)
+	require.Len(t, usage, 3)
+
+	assert.Equal(t, "data", usage[0].Name)
+	assert.True(t, usage[0].Present)
+	assert.True(t, usage[0].HasSize)
+	assert.Equal(t, int64(1100), usage[0].Size)
+	assert.False(t, usage[0].WalkedAt.IsZero())
+	assert.Positive(t, usage[0].TotalBytes)
+
+	assert.Equal(t, "wasm", usage[1].Name)
+	assert.False(t, usage[1].Present)
+	assert.Zero(t, usage[1].Size)
+
+	// symlinks are not followed
+	assert.Equal(t, "cosmovisor", usage[2].Name)
+	assert.True(t, usage[2].Present)
+	assert.Equal(t, int64(10), usage[2].Size)
+	assert.Equal(t, usage[0].Device, usage[2].Device)
+}
+
+func TestDiskGetUsageCached(t *testing.T) {
+	t.Parallel()
+
+	chainFolder := getTestChainFolder(t)
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewClient(chainFolder, *logger, tracer)
+	client.Directories = []string{"data"}
+
+	first := waitForWalks(t, client)
+
+	require.NoError(t, os.WriteFile(chainFolder+"/data/application.db/000002.ldb", make([]byte, 1000), 0o600))
+
+	// the directory was walked recently, so the size is taken from the cache
+	cached, _, err := client.GetUsage(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, first[0].Size, cached[0].Size)
+	assert.Equal(t, first[0].WalkedAt, cached[0].WalkedAt)
+
+	// a new walk is started, with the previous size returned until it finishes
+	client.WalkInterval = 0
+
+	require.Eventually(t, func() bool {
+		second, _, err := client.GetUsage(context.Background())
+		require.NoError(t, err)
+		return second[0].WalkedAt.After(first[0].WalkedAt)
+	}, time.Second, 10*time.Millisecond)
+
+	second, _, err := client.GetUsage(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(2100), second[0].Size)
+}
+
+func TestDiskGetUsageSymlinkedDirectory(t *testing.T) {
+	t.Parallel()
+
+	volume := t.TempDir()
+	require.NoError(t, os.MkdirAll(volume+"/application.db", 0o755))
+	require.NoError(t, os.WriteFile(volume+"/application.db/000001.ldb", make([]byte, 1000), 0o600))
+
+	chainFolder := t.TempDir()
+	require.NoError(t, os.Symlink(volume, chainFolder+"/data"))
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewClient(chainFolder, *logger, tracer)
+	client.Directories = []string{"data"}
+
+	usage := waitForWalks(t, client)
+	require.Len(t, usage, 1)
+	assert.True(t, usage[0].Present)
+	assert.Equal(t, int64(1000), usage[0].Size)
+}
+
+func waitForWalks(t *testing.T, client *Client) []types.DirectoryUsage {
+	t.Helper()
+
+	var usage []types.DirectoryUsage
+
+	require.Eventually(t, func() bool {
+		var err error
+		usage, _, err = client.GetUsage(context.Background())
+		require.NoError(t, err)
+
+		for _, directory := range usage {
+			if directory.Present && !directory.HasSize {
+				return false
+			}
+		}
+
+		return true
+	}, time.Second, 10*time.Millisecond)
+
+	return usage
+}
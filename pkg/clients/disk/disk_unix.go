@@ -0,0 +1,28 @@
+//go:build unix
+
+package disk
+
+import (
+	"fmt"
+	"main/pkg/types"
+	"os"
+	"syscall"
+)
+
+func fillFilesystemStats(directoryPath string, stat os.FileInfo, directory *types.DirectoryUsage) error {
+	if sys, ok := stat.Sys().(*syscall.Stat_t); ok {
+		directory.Device = uint64(sys.Dev) //nolint:unconvert // Dev is int32 on darwin
+	}
+
+	var statfs syscall.Statfs_t
+	if err := syscall.Statfs(directoryPath, &statfs); err != nil {
+		return fmt.Errorf("could not get filesystem stats: %w", err)
+	}
+
+	blockSize := uint64(statfs.Bsize)
+	directory.FreeBytes = statfs.Bavail * blockSize
+	directory.TotalBytes = statfs.Blocks * blockSize
+	directory.HasFilesystemStats = true
+
+	return nil
+}
@@ -31,8 +31,20 @@ type Github struct {
 }
 
 type GithubReleaseInfo struct {
-	Name    string `json:"name"`
-	TagName string `json:"tag_name"`
+	Name       string               `json:"name"`
+	TagName    string               `json:"tag_name"`
+	Message    string               `json:"message"`
+	Draft      bool                 `json:"draft"`
+	Prerelease bool                 `json:"prerelease"`
+	Assets     []GithubReleaseAsset `json:"assets"`
+}
+
+type GithubReleaseAsset struct {
+	Name               string `json:"name"`
+	BrowserDownloadUrl string `json:"browser_download_url"`
+}
+
+type GithubErrorResponse struct {
 	Message string `json:"message"`
 }
 
@@ -154,3 +166,66 @@ func (g *Github) GetLatestRelease(ctx context.Context) (string, query_info.Query
 
 	return releaseInfo.TagName, queryInfo, err
 }
+
+// GetReleases returns the most recent releases, newest first. Unlike GetLatestRelease,
+// it is not cached, as it is only used for preparing upgrades.
+func (g *Github) GetReleases(ctx context.Context) ([]GithubReleaseInfo, query_info.QueryInfo, error) {
+	childCtx, span := g.Tracer.Start(ctx, "HTTP request")
+	defer span.End()
+
+	client := &http.Client{
+		Timeout:   10 * time.Second,
+		Transport: otelhttp.NewTransport(http.DefaultTransport),
+	}
+
+	releasesUrl := fmt.Sprintf(
+		"%s/repos/%s/%s/releases?per_page=%d",
+		g.ApiBaseUrl,
+		g.Organization,
+		g.Repository,
+		constants.GithubReleasesPerPage,
+	)
+
+	queryInfo := query_info.QueryInfo{
+		Module:  constants.ModuleGit,
+		Action:  constants.ActionGitGetReleases,
+		Success: false,
+	}
+
+	req, err := http.NewRequestWithContext(childCtx, http.MethodGet, releasesUrl, nil)
+	if err != nil {
+		return nil, queryInfo, err
+	}
+
+	g.Logger.Trace().
+		Str("url", releasesUrl).
+		Msg("Querying GitHub releases")
+
+	if g.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+g.Token)
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, queryInfo, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		errorResponse := GithubErrorResponse{}
+		if err := json.NewDecoder(res.Body).Decode(&errorResponse); err != nil || errorResponse.Message == "" {
+			return nil, queryInfo, fmt.Errorf("got unexpected status from Github: %d", res.StatusCode)
+		}
+
+		return nil, queryInfo, fmt.Errorf("got error from Github: %s", errorResponse.Message)
+	}
+
+	releases := []GithubReleaseInfo{}
+	if err := json.NewDecoder(res.Body).Decode(&releases); err != nil {
+		return nil, queryInfo, err
+	}
+
+	queryInfo.Success = true
+
+	return releases, queryInfo, nil
+}
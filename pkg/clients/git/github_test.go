@@ -179,3 +179,141 @@ func TestGetGithubClientCachedWithPreviousResponse(t *testing.T) {
 	assert.True(t, queryInfo.Success)
 	require.Equal(t, "v1.2.3", release)
 }
+
+func TestGetGithubReleasesFailToBuildQuery(t *testing.T) {
+	t.Parallel()
+
+	config := configPkg.GitConfig{Repository: "https://github.com/cosmos/gaia"}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewGithub(config, *logger, tracer)
+	client.ApiBaseUrl = "://"
+
+	releases, queryInfo, err := client.GetReleases(context.Background())
+
+	require.Error(t, err)
+	assert.False(t, queryInfo.Success)
+	require.Empty(t, releases)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestGetGithubReleasesQueryError(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.github.com/repos/cosmos/gaia/releases?per_page=30",
+		httpmock.NewErrorResponder(errors.New("custom error")),
+	)
+
+	config := configPkg.GitConfig{Repository: "https://github.com/cosmos/gaia"}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewGithub(config, *logger, tracer)
+
+	releases, queryInfo, err := client.GetReleases(context.Background())
+
+	require.Error(t, err)
+	require.ErrorContains(t, err, "custom error")
+	assert.False(t, queryInfo.Success)
+	require.Empty(t, releases)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestGetGithubReleasesRateLimit(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.github.com/repos/cosmos/gaia/releases?per_page=30",
+		httpmock.NewBytesResponder(403, assets.GetBytesOrPanic("github-error.json")),
+	)
+
+	config := configPkg.GitConfig{Repository: "https://github.com/cosmos/gaia", Token: "token"}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewGithub(config, *logger, tracer)
+
+	releases, queryInfo, err := client.GetReleases(context.Background())
+
+	require.Error(t, err)
+	require.ErrorContains(t, err, "API rate limit exceeded")
+	assert.False(t, queryInfo.Success)
+	require.Empty(t, releases)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestGetGithubReleasesUnexpectedStatus(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.github.com/repos/cosmos/gaia/releases?per_page=30",
+		httpmock.NewStringResponder(502, "Bad Gateway"),
+	)
+
+	config := configPkg.GitConfig{Repository: "https://github.com/cosmos/gaia"}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewGithub(config, *logger, tracer)
+
+	releases, queryInfo, err := client.GetReleases(context.Background())
+
+	require.Error(t, err)
+	require.ErrorContains(t, err, "got unexpected status from Github: 502")
+	assert.False(t, queryInfo.Success)
+	require.Empty(t, releases)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestGetGithubReleasesInvalidResponse(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.github.com/repos/cosmos/gaia/releases?per_page=30",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("github-valid.json")),
+	)
+
+	config := configPkg.GitConfig{Repository: "https://github.com/cosmos/gaia"}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewGithub(config, *logger, tracer)
+
+	releases, queryInfo, err := client.GetReleases(context.Background())
+
+	require.Error(t, err)
+	assert.False(t, queryInfo.Success)
+	require.Empty(t, releases)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestGetGithubReleasesOk(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.github.com/repos/cosmos/gaia/releases?per_page=30",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("github-releases.json")),
+	)
+
+	config := configPkg.GitConfig{Repository: "https://github.com/cosmos/gaia"}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewGithub(config, *logger, tracer)
+
+	releases, queryInfo, err := client.GetReleases(context.Background())
+
+	require.NoError(t, err)
+	assert.True(t, queryInfo.Success)
+	require.Len(t, releases, 3)
+	assert.Equal(t, "v1.5.0", releases[1].TagName)
+	assert.True(t, releases[0].Prerelease)
+	require.Len(t, releases[1].Assets, 4)
+	assert.Equal(t, "gaiad-v1.5.0-linux-amd64", releases[1].Assets[2].Name)
+}
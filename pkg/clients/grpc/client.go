@@ -4,12 +4,11 @@ import (
 	"context"
 	"main/pkg/config"
 	"main/pkg/constants"
+	"main/pkg/failover"
 	"main/pkg/query_info"
 	"strings"
 	"time"
 
-	"google.golang.org/grpc/credentials/insecure"
-
 	"go.opentelemetry.io/otel/trace"
 
 	cmtTypes "github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
@@ -20,24 +19,50 @@ import (
 )
 
 type Client struct {
-	Logger zerolog.Logger
-	Client *grpc.ClientConn
-	Tracer trace.Tracer
+	Logger      zerolog.Logger
+	Connections map[string]*grpc.ClientConn
+	Failover    *failover.Failover
+	TLSError    error
+	Tracer      trace.Tracer
 }
 
 func NewClient(config config.GrpcConfig, logger zerolog.Logger, tracer trace.Tracer) *Client {
 	grpcLogger := logger.With().Str("component", "grpc").Logger()
 
-	grpcConn, _ := grpc.NewClient(
-		config.Address,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-		grpc.WithTimeout(5*time.Second),
-	)
+	addresses := config.Addresses()
+	connections := make(map[string]*grpc.ClientConn, len(addresses))
+
+	transportCredentials, err := GetTransportCredentials(config)
+	if err != nil {
+		// not failing here, the queries would fail and this would be reflected in query metrics
+		grpcLogger.Error().Err(err).Msg("Could not load TLS config")
+	}
+
+	options := []grpc.DialOption{
+		grpc.WithTransportCredentials(transportCredentials),
+		grpc.WithTimeout(5 * time.Second),
+	}
+
+	if len(config.Headers) > 0 || config.BasicAuth.Username != "" {
+		options = append(options, grpc.WithPerRPCCredentials(&HeadersCredentials{
+			Headers:          GetHeaders(config.ClientConfig),
+			TransportSecured: config.UseTLS(),
+		}))
+	}
+
+	if err == nil {
+		for _, address := range addresses {
+			grpcConn, _ := grpc.NewClient(address, options...)
+			connections[address] = grpcConn
+		}
+	}
 
 	return &Client{
-		Logger: grpcLogger,
-		Client: grpcConn,
-		Tracer: tracer,
+		Logger:      grpcLogger,
+		Connections: connections,
+		Failover:    failover.NewFailover(addresses, constants.FailoverPrimaryCooldown),
+		TLSError:    err,
+		Tracer:      tracer,
 	}
 }
 
@@ -54,24 +79,38 @@ func (g *Client) GetNodeConfig(ctx context.Context) (*nodeTypes.ConfigResponse,
 		Success: false,
 	}
 
-	client := nodeTypes.NewServiceClient(g.Client)
-	response, err := client.Config(
-		childCtx,
-		&nodeTypes.ConfigRequest{},
-	)
+	var response *nodeTypes.ConfigResponse
+
+	endpoint, err := g.Failover.Do(func(address string) error {
+		if g.TLSError != nil {
+			return g.TLSError
+		}
+
+		client := nodeTypes.NewServiceClient(g.Connections[address])
+
+		var queryErr error
+		response, queryErr = client.Config(
+			childCtx,
+			&nodeTypes.ConfigRequest{},
+		)
 
-	if err != nil {
 		// some chains do not implement this endpoint due to their cosmos-sdk version
 		// being too old
-		if strings.Contains(err.Error(), "unknown service cosmos.base.node.v1beta1.Service") {
-			queryInfo.Success = true
-			return nil, queryInfo, nil
+		if queryErr != nil && strings.Contains(queryErr.Error(), "unknown service cosmos.base.node.v1beta1.Service") {
+			response = nil
+			return nil
 		}
+
+		return queryErr
+	})
+
+	if err != nil {
 		span.RecordError(err)
 		return nil, queryInfo, err
 	}
 
 	queryInfo.Success = true
+	queryInfo.Endpoint = endpoint
 
 	return response, queryInfo, nil
 }
@@ -89,17 +128,30 @@ func (g *Client) GetNodeInfo(ctx context.Context) (*cmtTypes.GetNodeInfoResponse
 		Success: false,
 	}
 
-	client := cmtTypes.NewServiceClient(g.Client)
-	response, err := client.GetNodeInfo(
-		childCtx,
-		&cmtTypes.GetNodeInfoRequest{},
-	)
+	var response *cmtTypes.GetNodeInfoResponse
+
+	endpoint, err := g.Failover.Do(func(address string) error {
+		if g.TLSError != nil {
+			return g.TLSError
+		}
+
+		client := cmtTypes.NewServiceClient(g.Connections[address])
+
+		var queryErr error
+		response, queryErr = client.GetNodeInfo(
+			childCtx,
+			&cmtTypes.GetNodeInfoRequest{},
+		)
+
+		return queryErr
+	})
 
 	if err != nil {
 		span.RecordError(err)
 		return nil, queryInfo, err
 	}
 	queryInfo.Success = true
+	queryInfo.Endpoint = endpoint
 
 	return response, queryInfo, nil
 }
@@ -3,6 +3,8 @@ package grpc
 import (
 	"context"
 	configPkg "main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/failover"
 	loggerPkg "main/pkg/logger"
 	"main/pkg/tracing"
 	"testing"
@@ -43,9 +45,10 @@ func TestGrpcClientNodeConfigFail(t *testing.T) {
 	)
 	require.NoError(t, err)
 	client := &Client{
-		Logger: *logger,
-		Client: grpcConn,
-		Tracer: tracer,
+		Logger:      *logger,
+		Connections: map[string]*grpc.ClientConn{"localhost:9090": grpcConn},
+		Failover:    failover.NewFailover([]string{"localhost:9090"}, constants.FailoverPrimaryCooldown),
+		Tracer:      tracer,
 	}
 
 	nodeConfig, queryInfo, err := client.GetNodeConfig(context.Background())
@@ -68,9 +71,10 @@ func TestGrpcClientNodeConfigNotImplemented(t *testing.T) {
 	)
 	require.NoError(t, err)
 	client := &Client{
-		Logger: *logger,
-		Client: grpcConn,
-		Tracer: tracer,
+		Logger:      *logger,
+		Connections: map[string]*grpc.ClientConn{"localhost:9090": grpcConn},
+		Failover:    failover.NewFailover([]string{"localhost:9090"}, constants.FailoverPrimaryCooldown),
+		Tracer:      tracer,
 	}
 
 	nodeConfig, queryInfo, err := client.GetNodeConfig(context.Background())
@@ -105,9 +109,10 @@ func TestGrpcClientNodeConfigOk(t *testing.T) {
 	)
 	require.NoError(t, err)
 	client := &Client{
-		Logger: *logger,
-		Client: grpcConn,
-		Tracer: tracer,
+		Logger:      *logger,
+		Connections: map[string]*grpc.ClientConn{"localhost:9090": grpcConn},
+		Failover:    failover.NewFailover([]string{"localhost:9090"}, constants.FailoverPrimaryCooldown),
+		Tracer:      tracer,
 	}
 
 	nodeConfig, queryInfo, err := client.GetNodeConfig(context.Background())
@@ -131,9 +136,10 @@ func TestGrpcClientNodeInfoFail(t *testing.T) {
 	)
 	require.NoError(t, err)
 	client := &Client{
-		Logger: *logger,
-		Client: grpcConn,
-		Tracer: tracer,
+		Logger:      *logger,
+		Connections: map[string]*grpc.ClientConn{"localhost:9090": grpcConn},
+		Failover:    failover.NewFailover([]string{"localhost:9090"}, constants.FailoverPrimaryCooldown),
+		Tracer:      tracer,
 	}
 
 	nodeConfig, queryInfo, err := client.GetNodeInfo(context.Background())
@@ -170,9 +176,10 @@ func TestGrpcClientNodeInfoOk(t *testing.T) {
 	)
 	require.NoError(t, err)
 	client := &Client{
-		Logger: *logger,
-		Client: grpcConn,
-		Tracer: tracer,
+		Logger:      *logger,
+		Connections: map[string]*grpc.ClientConn{"localhost:9090": grpcConn},
+		Failover:    failover.NewFailover([]string{"localhost:9090"}, constants.FailoverPrimaryCooldown),
+		Tracer:      tracer,
 	}
 
 	nodeInfo, queryInfo, err := client.GetNodeInfo(context.Background())
@@ -181,3 +188,74 @@ func TestGrpcClientNodeInfoOk(t *testing.T) {
 	assert.NotNil(t, nodeInfo)
 	assert.Equal(t, "chain", nodeInfo.DefaultNodeInfo.Network)
 }
+
+func TestGrpcClientTLSError(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	config := configPkg.GrpcConfig{
+		Address:      "localhost:9090",
+		ClientConfig: configPkg.ClientConfig{TLSCA: "not-found.pem"},
+	}
+	client := NewClient(config, *logger, tracer)
+	require.Error(t, client.TLSError)
+
+	nodeConfig, queryInfo, err := client.GetNodeConfig(context.Background())
+	require.Error(t, err)
+	require.ErrorContains(t, err, "could not read TLS CA")
+	assert.False(t, queryInfo.Success)
+	assert.Nil(t, nodeConfig)
+
+	nodeInfo, queryInfo, err := client.GetNodeInfo(context.Background())
+	require.Error(t, err)
+	require.ErrorContains(t, err, "could not read TLS CA")
+	assert.False(t, queryInfo.Success)
+	assert.Nil(t, nodeInfo)
+}
+
+func TestGrpcClientHeaders(t *testing.T) {
+	t.Parallel()
+
+	_, d := grpcmock.MockServerWithBufConn(
+		grpcmock.RegisterServiceFromMethods(service.Method{
+			ServiceName: "cosmos.base.node.v1beta1.Service",
+			MethodName:  "Config",
+			MethodType:  service.TypeUnary,
+			Input:       &nodeTypes.ConfigRequest{},
+			Output:      &nodeTypes.ConfigResponse{},
+		}),
+		func(s *grpcmock.Server) {
+			s.ExpectUnary("cosmos.base.node.v1beta1.Service/Config").
+				WithHeader("x-api-key", "key").
+				WithHeader("authorization", "Basic dXNlcjpwYXNz").
+				Return(&nodeTypes.ConfigResponse{MinimumGasPrice: "0.1uatom"})
+		},
+	)(t)
+
+	logger := loggerPkg.GetDefaultLogger()
+	tracer := tracing.InitNoopTracer()
+	grpcConn, err := grpc.NewClient(
+		"localhost:9090",
+		grpc.WithContextDialer(d),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithPerRPCCredentials(&HeadersCredentials{
+			Headers: GetHeaders(configPkg.ClientConfig{
+				Headers:   map[string]string{"X-Api-Key": "key"},
+				BasicAuth: configPkg.BasicAuthConfig{Username: "user", Password: "pass"},
+			}),
+		}),
+	)
+	require.NoError(t, err)
+	client := &Client{
+		Logger:      *logger,
+		Connections: map[string]*grpc.ClientConn{"localhost:9090": grpcConn},
+		Failover:    failover.NewFailover([]string{"localhost:9090"}, constants.FailoverPrimaryCooldown),
+		Tracer:      tracer,
+	}
+
+	nodeConfig, queryInfo, err := client.GetNodeConfig(context.Background())
+	require.NoError(t, err)
+	assert.True(t, queryInfo.Success)
+	assert.Equal(t, "0.1uatom", nodeConfig.MinimumGasPrice)
+}
@@ -0,0 +1,59 @@
+package grpc
+
+import (
+	"context"
+	"encoding/base64"
+	"main/pkg/config"
+	"strings"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// HeadersCredentials attaches the custom headers and basic auth to each gRPC request.
+type HeadersCredentials struct {
+	Headers          map[string]string
+	TransportSecured bool
+}
+
+func (c *HeadersCredentials) GetRequestMetadata(_ context.Context, _ ...string) (map[string]string, error) {
+	return c.Headers, nil
+}
+
+func (c *HeadersCredentials) RequireTransportSecurity() bool {
+	return c.TransportSecured
+}
+
+// GetHeaders returns the gRPC metadata, which keys should be lowercase.
+func GetHeaders(clientConfig config.ClientConfig) map[string]string {
+	headers := make(map[string]string, len(clientConfig.Headers)+1)
+
+	for key, value := range clientConfig.Headers {
+		headers[strings.ToLower(key)] = value
+	}
+
+	if clientConfig.BasicAuth.Username != "" {
+		auth := clientConfig.BasicAuth.Username + ":" + clientConfig.BasicAuth.Password
+		headers["authorization"] = "Basic " + base64.StdEncoding.EncodeToString([]byte(auth))
+	}
+
+	return headers
+}
+
+func GetTransportCredentials(grpcConfig config.GrpcConfig) (credentials.TransportCredentials, error) {
+	if !grpcConfig.UseTLS() {
+		return insecure.NewCredentials(), nil
+	}
+
+	tlsConfig, err := grpcConfig.GetTLSConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	// tls = true without any other TLS settings, using system CAs
+	if tlsConfig == nil {
+		return credentials.NewClientTLSFromCert(nil, ""), nil
+	}
+
+	return credentials.NewTLS(tlsConfig), nil
+}
@@ -0,0 +1,73 @@
+package grpc
+
+import (
+	"context"
+	configPkg "main/pkg/config"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestGetHeaders(t *testing.T) {
+	t.Parallel()
+
+	headers := GetHeaders(configPkg.ClientConfig{
+		Headers:   map[string]string{"X-Api-Key": "key"},
+		BasicAuth: configPkg.BasicAuthConfig{Username: "user", Password: "pass"},
+	})
+	assert.Equal(t, map[string]string{
+		"x-api-key":     "key",
+		"authorization": "Basic dXNlcjpwYXNz",
+	}, headers)
+}
+
+func TestHeadersCredentials(t *testing.T) {
+	t.Parallel()
+
+	credentials := &HeadersCredentials{
+		Headers:          map[string]string{"x-api-key": "key"},
+		TransportSecured: true,
+	}
+
+	metadata, err := credentials.GetRequestMetadata(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"x-api-key": "key"}, metadata)
+	assert.True(t, credentials.RequireTransportSecurity())
+}
+
+func TestGetTransportCredentialsInsecure(t *testing.T) {
+	t.Parallel()
+
+	credentials, err := GetTransportCredentials(configPkg.GrpcConfig{})
+	require.NoError(t, err)
+	assert.Equal(t, "insecure", credentials.Info().SecurityProtocol)
+}
+
+func TestGetTransportCredentialsSystemCAs(t *testing.T) {
+	t.Parallel()
+
+	credentials, err := GetTransportCredentials(configPkg.GrpcConfig{TLS: null.BoolFrom(true)})
+	require.NoError(t, err)
+	assert.Equal(t, "tls", credentials.Info().SecurityProtocol)
+}
+
+func TestGetTransportCredentialsCustom(t *testing.T) {
+	t.Parallel()
+
+	credentials, err := GetTransportCredentials(configPkg.GrpcConfig{
+		ClientConfig: configPkg.ClientConfig{InsecureSkipVerify: null.BoolFrom(true)},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "tls", credentials.Info().SecurityProtocol)
+}
+
+func TestGetTransportCredentialsError(t *testing.T) {
+	t.Parallel()
+
+	_, err := GetTransportCredentials(configPkg.GrpcConfig{
+		ClientConfig: configPkg.ClientConfig{TLSCA: "not-found.pem"},
+	})
+	require.Error(t, err)
+}
@@ -0,0 +1,142 @@
+package ntp
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"main/pkg/constants"
+	"main/pkg/query_info"
+	"net"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/rs/zerolog"
+)
+
+const (
+	packetSize = 48
+	// LI = 0 (no warning), VN = 4, Mode = 3 (client)
+	clientHeader = 0x23
+	modeServer   = 4
+)
+
+// seconds between the NTP era start (1900-01-01) and the Unix epoch
+var ntpEpochOffset = time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC).
+	Sub(time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC))
+
+type Client struct {
+	Address string
+	Timeout time.Duration
+	Logger  zerolog.Logger
+	Tracer  trace.Tracer
+}
+
+func NewClient(address string, logger zerolog.Logger, tracer trace.Tracer) *Client {
+	return &Client{
+		Address: address,
+		Timeout: constants.NtpQueryTimeout,
+		Logger:  logger.With().Str("component", "ntp").Logger(),
+		Tracer:  tracer,
+	}
+}
+
+// GetClockOffset does an SNTP query and returns how much the local clock is behind
+// the server's one, so positive values mean the local clock is late.
+func (c *Client) GetClockOffset(ctx context.Context) (time.Duration, query_info.QueryInfo, error) {
+	childCtx, span := c.Tracer.Start(
+		ctx,
+		"Fetching NTP clock offset",
+		trace.WithAttributes(attribute.String("address", c.Address)),
+	)
+	defer span.End()
+
+	queryInfo := query_info.QueryInfo{
+		Module:   constants.ModuleNtp,
+		Action:   constants.ActionNtpGetClockOffset,
+		Success:  false,
+		Endpoint: c.Address,
+	}
+
+	offset, err := c.query(childCtx)
+	if err != nil {
+		return 0, queryInfo, err
+	}
+
+	queryInfo.Success = true
+	return offset, queryInfo, nil
+}
+
+func (c *Client) query(ctx context.Context) (time.Duration, error) {
+	dialer := net.Dialer{Timeout: c.Timeout}
+	conn, err := dialer.DialContext(ctx, "udp", c.Address)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+
+	deadline := time.Now().Add(c.Timeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+
+	if err := conn.SetDeadline(deadline); err != nil {
+		return 0, err
+	}
+
+	request := make([]byte, packetSize)
+	request[0] = clientHeader
+
+	originTime := time.Now()
+	binary.BigEndian.PutUint64(request[40:], toNtpTime(originTime))
+
+	if _, err := conn.Write(request); err != nil {
+		return 0, err
+	}
+
+	response := make([]byte, packetSize)
+	read, err := conn.Read(response)
+	if err != nil {
+		return 0, err
+	}
+
+	destinationTime := time.Now()
+
+	if read < packetSize {
+		return 0, fmt.Errorf("malformed NTP response: expected %d bytes, got %d", packetSize, read)
+	}
+
+	if mode := response[0] & 0x07; mode != modeServer {
+		return 0, fmt.Errorf("unexpected NTP response mode: %d", mode)
+	}
+
+	if stratum := response[1]; stratum == 0 {
+		return 0, errors.New("NTP server returned a kiss-of-death response")
+	}
+
+	if !bytes.Equal(response[24:32], request[40:48]) {
+		return 0, errors.New("NTP response does not match the request")
+	}
+
+	receiveTime := fromNtpTime(binary.BigEndian.Uint64(response[32:]))
+	transmitTime := fromNtpTime(binary.BigEndian.Uint64(response[40:]))
+
+	// offset = ((T2 - T1) + (T3 - T4)) / 2
+	return (receiveTime.Sub(originTime) + transmitTime.Sub(destinationTime)) / 2, nil
+}
+
+func toNtpTime(t time.Time) uint64 {
+	sinceEra := t.Sub(time.Unix(0, 0)) + ntpEpochOffset
+	seconds := uint64(sinceEra / time.Second)
+	fraction := uint64(sinceEra%time.Second) << 32 / uint64(time.Second)
+	return seconds<<32 | fraction
+}
+
+func fromNtpTime(value uint64) time.Time {
+	seconds := time.Duration(value>>32) * time.Second
+	fraction := time.Duration((value & 0xffffffff) * uint64(time.Second) >> 32)
+	return time.Unix(0, 0).Add(seconds + fraction - ntpEpochOffset)
+}
@@ -0,0 +1,147 @@
+package ntp
+
+import (
+	"context"
+	"encoding/binary"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/tracing"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// startServer runs a local UDP stand-in answering a single NTP request
+// with a clock shifted by offset, letting modify tweak the response.
+func startServer(t *testing.T, offset time.Duration, modify func(response []byte)) string {
+	t.Helper()
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	go func() {
+		request := make([]byte, packetSize)
+		_, addr, err := conn.ReadFrom(request)
+		if err != nil {
+			return
+		}
+
+		now := toNtpTime(time.Now().Add(offset))
+
+		response := make([]byte, packetSize)
+		response[0] = 0x24 // LI = 0, VN = 4, Mode = 4 (server)
+		response[1] = 2
+		copy(response[24:32], request[40:48])
+		binary.BigEndian.PutUint64(response[32:], now)
+		binary.BigEndian.PutUint64(response[40:], now)
+
+		if modify != nil {
+			modify(response)
+		}
+
+		_, _ = conn.WriteTo(response, addr)
+	}()
+
+	return conn.LocalAddr().String()
+}
+
+func TestNtpTimeConversion(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 29, 17, 20, 23, 500000000, time.UTC)
+	converted := fromNtpTime(toNtpTime(now))
+	assert.WithinDuration(t, now, converted, time.Microsecond)
+	assert.Equal(t, uint64(2208988800), toNtpTime(time.Unix(0, 0))>>32)
+}
+
+func TestNtpClockOffsetOk(t *testing.T) {
+	t.Parallel()
+
+	address := startServer(t, 10*time.Second, nil)
+	client := NewClient(address, *loggerPkg.GetNopLogger(), tracing.InitNoopTracer())
+
+	offset, queryInfo, err := client.GetClockOffset(context.Background())
+	require.NoError(t, err)
+	assert.True(t, queryInfo.Success)
+	assert.Equal(t, address, queryInfo.Endpoint)
+	assert.InDelta(t, 10, offset.Seconds(), 0.1)
+}
+
+func TestNtpClockOffsetNegative(t *testing.T) {
+	t.Parallel()
+
+	address := startServer(t, -5*time.Second, nil)
+	client := NewClient(address, *loggerPkg.GetNopLogger(), tracing.InitNoopTracer())
+
+	offset, _, err := client.GetClockOffset(context.Background())
+	require.NoError(t, err)
+	assert.InDelta(t, -5, offset.Seconds(), 0.1)
+}
+
+func TestNtpClockOffsetKissOfDeath(t *testing.T) {
+	t.Parallel()
+
+	address := startServer(t, 0, func(response []byte) {
+		response[1] = 0
+	})
+	client := NewClient(address, *loggerPkg.GetNopLogger(), tracing.InitNoopTracer())
+
+	_, queryInfo, err := client.GetClockOffset(context.Background())
+	require.Error(t, err)
+	require.ErrorContains(t, err, "kiss-of-death")
+	assert.False(t, queryInfo.Success)
+}
+
+func TestNtpClockOffsetWrongMode(t *testing.T) {
+	t.Parallel()
+
+	address := startServer(t, 0, func(response []byte) {
+		response[0] = 0x23
+	})
+	client := NewClient(address, *loggerPkg.GetNopLogger(), tracing.InitNoopTracer())
+
+	_, _, err := client.GetClockOffset(context.Background())
+	require.Error(t, err)
+	require.ErrorContains(t, err, "unexpected NTP response mode")
+}
+
+func TestNtpClockOffsetOriginMismatch(t *testing.T) {
+	t.Parallel()
+
+	address := startServer(t, 0, func(response []byte) {
+		response[24] ^= 0xff
+	})
+	client := NewClient(address, *loggerPkg.GetNopLogger(), tracing.InitNoopTracer())
+
+	_, _, err := client.GetClockOffset(context.Background())
+	require.Error(t, err)
+	require.ErrorContains(t, err, "does not match")
+}
+
+func TestNtpClockOffsetTimeout(t *testing.T) {
+	t.Parallel()
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	client := NewClient(conn.LocalAddr().String(), *loggerPkg.GetNopLogger(), tracing.InitNoopTracer())
+	client.Timeout = 100 * time.Millisecond
+
+	_, queryInfo, err := client.GetClockOffset(context.Background())
+	require.Error(t, err)
+	assert.False(t, queryInfo.Success)
+}
+
+func TestNtpClockOffsetInvalidAddress(t *testing.T) {
+	t.Parallel()
+
+	client := NewClient("invalid", *loggerPkg.GetNopLogger(), tracing.InitNoopTracer())
+
+	_, queryInfo, err := client.GetClockOffset(context.Background())
+	require.Error(t, err)
+	assert.False(t, queryInfo.Success)
+}
@@ -9,32 +9,32 @@ import (
 	"main/pkg/query_info"
 	"net/url"
 
-	"github.com/cosmos/gogoproto/proto"
-
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 
+	"cosmossdk.io/math"
 	upgradeTypes "cosmossdk.io/x/upgrade/types"
 	"github.com/cosmos/cosmos-sdk/codec"
+	queryTypes "github.com/cosmos/cosmos-sdk/types/query"
+	govV1Types "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/rs/zerolog"
 )
 
 type RPC struct {
-	Client       *http.Client
-	Logger       zerolog.Logger
-	Address      string
-	BlocksBehind int64
-	Tracer       trace.Tracer
+	Client  *http.Client
+	Logger  zerolog.Logger
+	Address string
+	Tracer  trace.Tracer
 }
 
 func NewRPC(config config.TendermintConfig, logger zerolog.Logger, tracer trace.Tracer) *RPC {
 	return &RPC{
-		Logger:       logger.With().Str("component", "tendermint_rpc").Logger(),
-		Address:      config.Address,
-		BlocksBehind: constants.BlocksBehindToCheck,
-		Client:       http.NewClient(logger, config.Address, tracer),
-		Tracer:       tracer,
+		Logger:  logger.With().Str("component", "tendermint_rpc").Logger(),
+		Address: config.Address,
+		Client:  http.NewClient(logger, config.Addresses(), config.ClientConfig, tracer),
+		Tracer:  tracer,
 	}
 }
 
@@ -53,16 +53,42 @@ func (t *RPC) Status(ctx context.Context) (StatusResponse, query_info.QueryInfo,
 	}
 
 	res := StatusResponse{}
-	err := t.Client.Query(childCtx, "/status", &res)
+	endpoint, err := t.Client.Query(childCtx, "/status", &res)
 
 	if err == nil {
 		queryInfo.Success = true
+		queryInfo.Endpoint = endpoint
 	}
 
 	return res, queryInfo, err
 }
 
-func (t *RPC) Block(ctx context.Context, height int64) (BlockResponse, error) {
+func (t *RPC) AbciInfo(ctx context.Context) (AbciInfoResponse, query_info.QueryInfo, error) {
+	childCtx, span := t.Tracer.Start(
+		ctx,
+		"Fetching ABCI info",
+		trace.WithAttributes(attribute.String("address", t.Address)),
+	)
+	defer span.End()
+
+	queryInfo := query_info.QueryInfo{
+		Module:  constants.ModuleTendermint,
+		Action:  constants.ActionTendermintGetAbciInfo,
+		Success: false,
+	}
+
+	res := AbciInfoResponse{}
+	endpoint, err := t.Client.Query(childCtx, "/abci_info", &res)
+
+	if err == nil {
+		queryInfo.Success = true
+		queryInfo.Endpoint = endpoint
+	}
+
+	return res, queryInfo, err
+}
+
+func (t *RPC) Block(ctx context.Context, height int64) (BlockResponse, string, error) {
 	childCtx, span := t.Tracer.Start(
 		ctx,
 		"Fetching block",
@@ -79,16 +105,16 @@ func (t *RPC) Block(ctx context.Context, height int64) (BlockResponse, error) {
 	}
 
 	res := BlockResponse{}
-	err := t.Client.Query(childCtx, blockUrl, &res)
-	return res, err
+	endpoint, err := t.Client.Query(childCtx, blockUrl, &res)
+	return res, endpoint, err
 }
 
 func (t *RPC) AbciQuery(
 	ctx context.Context,
 	method string,
-	message proto.Message,
+	message codec.ProtoMarshaler,
 	output codec.ProtoMarshaler,
-) error {
+) (string, error) {
 	childCtx, span := t.Tracer.Start(
 		ctx,
 		"Fetching ABCI query",
@@ -99,7 +125,11 @@ func (t *RPC) AbciQuery(
 	)
 	defer span.End()
 
-	dataBytes := message.String()
+	dataBytes, err := message.Marshal()
+	if err != nil {
+		return "", err
+	}
+
 	methodName := fmt.Sprintf("\"%s\"", method)
 	queryURL := fmt.Sprintf(
 		"/abci_query?path=%s&data=0x%x",
@@ -108,11 +138,12 @@ func (t *RPC) AbciQuery(
 	)
 
 	var response AbciQueryResponse
-	if err := t.Client.Query(childCtx, queryURL, &response); err != nil {
-		return err
+	endpoint, err := t.Client.Query(childCtx, queryURL, &response)
+	if err != nil {
+		return endpoint, err
 	}
 
-	return output.Unmarshal(response.Result.Response.Value)
+	return endpoint, output.Unmarshal(response.Result.Response.Value)
 }
 
 func (t *RPC) GetUpgradePlan(ctx context.Context) (*upgradeTypes.Plan, query_info.QueryInfo, error) {
@@ -132,16 +163,90 @@ func (t *RPC) GetUpgradePlan(ctx context.Context) (*upgradeTypes.Plan, query_inf
 	query := upgradeTypes.QueryCurrentPlanRequest{}
 
 	var response upgradeTypes.QueryCurrentPlanResponse
-	if err := t.AbciQuery(childCtx, "/cosmos.upgrade.v1beta1.Query/CurrentPlan", &query, &response); err != nil {
+	endpoint, err := t.AbciQuery(childCtx, "/cosmos.upgrade.v1beta1.Query/CurrentPlan", &query, &response)
+	if err != nil {
 		return nil, upgradePlanQuery, err
 	}
 
 	upgradePlanQuery.Success = true
+	upgradePlanQuery.Endpoint = endpoint
 
 	return response.Plan, upgradePlanQuery, nil
 }
 
-func (t *RPC) GetBlockTime(ctx context.Context) (*BlocksInfo, query_info.QueryInfo, error) {
+// GetAppliedPlans returns the heights the given upgrades were applied at,
+// with 0 for the ones that were never applied.
+func (t *RPC) GetAppliedPlans(ctx context.Context, names []string) (map[string]int64, query_info.QueryInfo, error) {
+	childCtx, span := t.Tracer.Start(
+		ctx,
+		"Fetching applied plans",
+		trace.WithAttributes(attribute.String("address", t.Address)),
+	)
+	defer span.End()
+
+	queryInfo := query_info.QueryInfo{
+		Module:  constants.ModuleTendermint,
+		Action:  constants.ActionTendermintGetAppliedPlans,
+		Success: false,
+	}
+
+	appliedPlans := make(map[string]int64, len(names))
+	var lastEndpoint string
+
+	for _, name := range names {
+		query := upgradeTypes.QueryAppliedPlanRequest{Name: name}
+
+		var response upgradeTypes.QueryAppliedPlanResponse
+		endpoint, err := t.AbciQuery(childCtx, "/cosmos.upgrade.v1beta1.Query/AppliedPlan", &query, &response)
+		if err != nil {
+			return nil, queryInfo, err
+		}
+
+		lastEndpoint = endpoint
+		appliedPlans[name] = response.Height
+	}
+
+	queryInfo.Success = true
+	queryInfo.Endpoint = lastEndpoint
+
+	return appliedPlans, queryInfo, nil
+}
+
+func (t *RPC) GetModuleVersions(ctx context.Context) ([]*upgradeTypes.ModuleVersion, query_info.QueryInfo, error) {
+	childCtx, span := t.Tracer.Start(
+		ctx,
+		"Fetching module versions",
+		trace.WithAttributes(attribute.String("address", t.Address)),
+	)
+	defer span.End()
+
+	queryInfo := query_info.QueryInfo{
+		Module:  constants.ModuleTendermint,
+		Action:  constants.ActionTendermintGetModuleVersions,
+		Success: false,
+	}
+
+	query := upgradeTypes.QueryModuleVersionsRequest{}
+
+	var response upgradeTypes.QueryModuleVersionsResponse
+	endpoint, err := t.AbciQuery(childCtx, "/cosmos.upgrade.v1beta1.Query/ModuleVersions", &query, &response)
+	if err != nil {
+		return nil, queryInfo, err
+	}
+
+	queryInfo.Success = true
+	queryInfo.Endpoint = endpoint
+
+	return response.ModuleVersions, queryInfo, nil
+}
+
+// GetBlockTime fetches the latest block and the blocks that are the given windows behind it,
+// not going below the earliest block available on the node.
+func (t *RPC) GetBlockTime(
+	ctx context.Context,
+	windows []int64,
+	earliestHeight int64,
+) (BlockTimes, query_info.QueryInfo, error) {
 	childCtx, span := t.Tracer.Start(
 		ctx,
 		"Fetching block time",
@@ -155,25 +260,361 @@ func (t *RPC) GetBlockTime(ctx context.Context) (*BlocksInfo, query_info.QueryIn
 		Success: false,
 	}
 
-	latestBlock, err := t.Block(childCtx, 0)
+	latestBlock, endpoint, err := t.Block(childCtx, 0)
 	if err != nil {
 		t.Logger.Error().Err(err).Msg("Could not fetch current block")
 		return nil, blockTimeQuery, err
 	}
 
 	latestBlockHeight := latestBlock.Result.Block.Header.Height
-	blockToCheck := latestBlockHeight - t.BlocksBehind
+	earliestHeight = max(earliestHeight, 1)
+
+	blockTimes := make(BlockTimes, 0, len(windows))
+
+	for _, window := range windows {
+		blockToCheck := max(latestBlockHeight-window, earliestHeight)
+		if blockToCheck >= latestBlockHeight {
+			t.Logger.Debug().
+				Int64("window", window).
+				Int64("latest_height", latestBlockHeight).
+				Int64("earliest_height", earliestHeight).
+				Msg("Not enough blocks to calculate block time for window")
+			continue
+		}
+
+		olderBlock, _, err := t.Block(childCtx, blockToCheck)
+		if err != nil {
+			t.Logger.Error().Err(err).Int64("window", window).Msg("Could not fetch older block")
+			return nil, blockTimeQuery, err
+		}
+
+		blockTimes = append(blockTimes, BlockTimeWindow{
+			Window: window,
+			BlocksInfo: &BlocksInfo{
+				NewerBlock: latestBlock,
+				OlderBlock: olderBlock,
+			},
+		})
+	}
+
+	blockTimeQuery.Success = true
+	blockTimeQuery.Endpoint = endpoint
+
+	return blockTimes, blockTimeQuery, nil
+}
+
+func (t *RPC) GetConsensusState(ctx context.Context) (*ConsensusState, query_info.QueryInfo, error) {
+	childCtx, span := t.Tracer.Start(
+		ctx,
+		"Fetching consensus state",
+		trace.WithAttributes(attribute.String("address", t.Address)),
+	)
+	defer span.End()
+
+	queryInfo := query_info.QueryInfo{
+		Module:  constants.ModuleTendermint,
+		Action:  constants.ActionTendermintGetConsensusState,
+		Success: false,
+	}
 
-	olderBlock, err := t.Block(childCtx, blockToCheck)
+	res := ConsensusStateResponse{}
+	endpoint, err := t.Client.Query(childCtx, "/consensus_state", &res)
 	if err != nil {
-		t.Logger.Error().Err(err).Msg("Could not fetch older block")
-		return nil, blockTimeQuery, err
+		return nil, queryInfo, err
 	}
 
-	blockTimeQuery.Success = true
+	consensusState, err := res.Result.RoundState.ConsensusState()
+	if err != nil {
+		return nil, queryInfo, err
+	}
+
+	queryInfo.Success = true
+	queryInfo.Endpoint = endpoint
+
+	return consensusState, queryInfo, nil
+}
+
+// Validators fetches the whole active validator set, going through all the pages.
+func (t *RPC) Validators(ctx context.Context) ([]Validator, query_info.QueryInfo, error) {
+	childCtx, span := t.Tracer.Start(
+		ctx,
+		"Fetching validators",
+		trace.WithAttributes(attribute.String("address", t.Address)),
+	)
+	defer span.End()
+
+	queryInfo := query_info.QueryInfo{
+		Module:  constants.ModuleTendermint,
+		Action:  constants.ActionTendermintGetValidators,
+		Success: false,
+	}
+
+	validators := []Validator{}
+	var lastEndpoint string
+
+	for page := 1; ; page++ {
+		res := ValidatorsResponse{}
+		endpoint, err := t.Client.Query(
+			childCtx,
+			fmt.Sprintf("/validators?page=%d&per_page=%d", page, constants.ValidatorsPerPage),
+			&res,
+		)
+		if err != nil {
+			return nil, queryInfo, err
+		}
+
+		lastEndpoint = endpoint
+		validators = append(validators, res.Result.Validators...)
+
+		if len(res.Result.Validators) == 0 || len(validators) >= res.Result.Total {
+			break
+		}
+	}
+
+	queryInfo.Success = true
+	queryInfo.Endpoint = lastEndpoint
+
+	return validators, queryInfo, nil
+}
+
+// GetInactiveValidators fetches the validators that are not in the active set,
+// so either unbonding or unbonded ones.
+func (t *RPC) GetInactiveValidators(ctx context.Context) ([]stakingTypes.Validator, query_info.QueryInfo, error) {
+	childCtx, span := t.Tracer.Start(
+		ctx,
+		"Fetching inactive validators",
+		trace.WithAttributes(attribute.String("address", t.Address)),
+	)
+	defer span.End()
+
+	queryInfo := query_info.QueryInfo{
+		Module:  constants.ModuleTendermint,
+		Action:  constants.ActionTendermintGetInactiveValidators,
+		Success: false,
+	}
+
+	validators := []stakingTypes.Validator{}
+	var lastEndpoint string
+
+	for _, status := range []stakingTypes.BondStatus{stakingTypes.Unbonding, stakingTypes.Unbonded} {
+		var paginationKey []byte
+
+		for {
+			query := stakingTypes.QueryValidatorsRequest{
+				Status: status.String(),
+				Pagination: &queryTypes.PageRequest{
+					Key:   paginationKey,
+					Limit: constants.ValidatorsPerPage,
+				},
+			}
+
+			var response stakingTypes.QueryValidatorsResponse
+			endpoint, err := t.AbciQuery(childCtx, "/cosmos.staking.v1beta1.Query/Validators", &query, &response)
+			if err != nil {
+				return nil, queryInfo, err
+			}
+
+			lastEndpoint = endpoint
+			validators = append(validators, response.Validators...)
+
+			if response.Pagination == nil || len(response.Pagination.NextKey) == 0 {
+				break
+			}
+
+			paginationKey = response.Pagination.NextKey
+		}
+	}
+
+	queryInfo.Success = true
+	queryInfo.Endpoint = lastEndpoint
+
+	return validators, queryInfo, nil
+}
+
+// GetBondedTokens fetches the amount of tokens bonded to the active validators set.
+func (t *RPC) GetBondedTokens(ctx context.Context) (math.Int, query_info.QueryInfo, error) {
+	childCtx, span := t.Tracer.Start(
+		ctx,
+		"Fetching staking pool",
+		trace.WithAttributes(attribute.String("address", t.Address)),
+	)
+	defer span.End()
+
+	queryInfo := query_info.QueryInfo{
+		Module:  constants.ModuleTendermint,
+		Action:  constants.ActionTendermintGetStakingPool,
+		Success: false,
+	}
+
+	var response stakingTypes.QueryPoolResponse
+	endpoint, err := t.AbciQuery(childCtx, "/cosmos.staking.v1beta1.Query/Pool", &stakingTypes.QueryPoolRequest{}, &response)
+	if err != nil {
+		return math.Int{}, queryInfo, err
+	}
+
+	queryInfo.Success = true
+	queryInfo.Endpoint = endpoint
+
+	return response.Pool.BondedTokens, queryInfo, nil
+}
+
+// GetUpgradeProposals returns software upgrade proposals that are in deposit or voting period,
+// both submitted as MsgSoftwareUpgrade and as a legacy SoftwareUpgradeProposal content.
+func (t *RPC) GetUpgradeProposals(ctx context.Context) ([]UpgradeProposal, query_info.QueryInfo, error) {
+	childCtx, span := t.Tracer.Start(
+		ctx,
+		"Fetching upgrade proposals",
+		trace.WithAttributes(attribute.String("address", t.Address)),
+	)
+	defer span.End()
+
+	queryInfo := query_info.QueryInfo{
+		Module:  constants.ModuleTendermint,
+		Action:  constants.ActionTendermintGetUpgradeProposals,
+		Success: false,
+	}
+
+	proposals := []UpgradeProposal{}
+	var lastEndpoint string
+
+	for _, status := range []govV1Types.ProposalStatus{
+		govV1Types.StatusDepositPeriod,
+		govV1Types.StatusVotingPeriod,
+	} {
+		var paginationKey []byte
+
+		for {
+			query := govV1Types.QueryProposalsRequest{
+				ProposalStatus: status,
+				Pagination: &queryTypes.PageRequest{
+					Key:   paginationKey,
+					Limit: constants.ProposalsPerPage,
+				},
+			}
+
+			var response govV1Types.QueryProposalsResponse
+			endpoint, err := t.AbciQuery(childCtx, "/cosmos.gov.v1.Query/Proposals", &query, &response)
+			if err != nil {
+				return nil, queryInfo, err
+			}
+
+			lastEndpoint = endpoint
+
+			for _, proposal := range response.Proposals {
+				plan, found, err := getUpgradePlan(proposal)
+				if err != nil {
+					return nil, queryInfo, err
+				}
+
+				if !found {
+					continue
+				}
+
+				proposals = append(proposals, UpgradeProposal{
+					ID:            proposal.Id,
+					Status:        proposal.Status,
+					Plan:          plan,
+					VotingEndTime: proposal.VotingEndTime,
+				})
+			}
+
+			if response.Pagination == nil || len(response.Pagination.NextKey) == 0 {
+				break
+			}
+
+			paginationKey = response.Pagination.NextKey
+		}
+	}
+
+	// the tally stored in a proposal is only updated once the voting is over
+	for index, proposal := range proposals {
+		if proposal.Status != govV1Types.StatusVotingPeriod {
+			continue
+		}
+
+		query := govV1Types.QueryTallyResultRequest{ProposalId: proposal.ID}
+
+		var response govV1Types.QueryTallyResultResponse
+		endpoint, err := t.AbciQuery(childCtx, "/cosmos.gov.v1.Query/TallyResult", &query, &response)
+		if err != nil {
+			return nil, queryInfo, err
+		}
+
+		lastEndpoint = endpoint
+		proposals[index].Tally = response.Tally
+	}
+
+	queryInfo.Success = true
+	queryInfo.Endpoint = lastEndpoint
+
+	return proposals, queryInfo, nil
+}
+
+func getUpgradePlan(proposal *govV1Types.Proposal) (upgradeTypes.Plan, bool, error) {
+	for _, message := range proposal.Messages {
+		switch message.TypeUrl {
+		case "/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade":
+			var msg upgradeTypes.MsgSoftwareUpgrade
+			if err := msg.Unmarshal(message.Value); err != nil {
+				return upgradeTypes.Plan{}, false, err
+			}
+
+			return msg.Plan, true, nil
+		case "/cosmos.gov.v1.MsgExecLegacyContent":
+			var msg govV1Types.MsgExecLegacyContent
+			if err := msg.Unmarshal(message.Value); err != nil {
+				return upgradeTypes.Plan{}, false, err
+			}
+
+			if msg.Content == nil || msg.Content.TypeUrl != "/cosmos.upgrade.v1beta1.SoftwareUpgradeProposal" {
+				continue
+			}
+
+			var content upgradeTypes.SoftwareUpgradeProposal //nolint:staticcheck // legacy proposals are still around
+			if err := content.Unmarshal(msg.Content.Value); err != nil {
+				return upgradeTypes.Plan{}, false, err
+			}
+
+			return content.Plan, true, nil
+		}
+	}
+
+	return upgradeTypes.Plan{}, false, nil
+}
+
+// GetPeersHeights returns the heights the node's peers are at, as seen by the consensus reactor.
+func (t *RPC) GetPeersHeights(ctx context.Context) ([]int64, query_info.QueryInfo, error) {
+	childCtx, span := t.Tracer.Start(
+		ctx,
+		"Fetching peers heights",
+		trace.WithAttributes(attribute.String("address", t.Address)),
+	)
+	defer span.End()
+
+	queryInfo := query_info.QueryInfo{
+		Module:  constants.ModuleTendermint,
+		Action:  constants.ActionTendermintGetPeersHeights,
+		Success: false,
+	}
+
+	res := DumpConsensusStateResponse{}
+	endpoint, err := t.Client.Query(childCtx, "/dump_consensus_state", &res)
+	if err != nil {
+		return nil, queryInfo, err
+	}
+
+	heights := make([]int64, 0, len(res.Result.Peers))
+	for _, peer := range res.Result.Peers {
+		// peers that haven't reported their state yet
+		if peer.PeerState.RoundState.Height == 0 {
+			continue
+		}
+
+		heights = append(heights, peer.PeerState.RoundState.Height)
+	}
+
+	queryInfo.Success = true
+	queryInfo.Endpoint = endpoint
 
-	return &BlocksInfo{
-		NewerBlock: latestBlock,
-		OlderBlock: olderBlock,
-	}, blockTimeQuery, nil
+	return heights, queryInfo, nil
 }
@@ -7,8 +7,10 @@ import (
 	configPkg "main/pkg/config"
 	loggerPkg "main/pkg/logger"
 	"main/pkg/tracing"
+	"regexp"
 	"testing"
 
+	govV1Types "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/jarcoal/httpmock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -65,6 +67,10 @@ func TestTendermintStatusSuccess(t *testing.T) {
 	assert.True(t, queryInfo.Success)
 	assert.NotEmpty(t, status)
 	assert.Equal(t, int64(0), status.Result.ValidatorInfo.VotingPower)
+	assert.Equal(t, int64(21065923), status.Result.SyncInfo.EarliestBlockHeight)
+	assert.Equal(t, int64(1719614636), status.Result.SyncInfo.EarliestBlockTime.Unix())
+	assert.Equal(t, "5D269B92CC74F98A1DC4215801EB918125C96D18FC85120B92F3B007ADFB082E", status.Result.SyncInfo.EarliestAppHash)
+	assert.Equal(t, "FF8C5A16787CC2BF9C3DFE8C33CBE57C8FB7BE853A4F10B9C1D8B487E75785A0", status.Result.SyncInfo.LatestAppHash)
 }
 
 //nolint:paralleltest // disabled due to httpmock usage
@@ -86,7 +92,7 @@ func TestTendermintBlockFail(t *testing.T) {
 	tracer := tracing.InitNoopTracer()
 	rpc := NewRPC(config, *logger, tracer)
 
-	status, err := rpc.Block(context.Background(), 0)
+	status, _, err := rpc.Block(context.Background(), 0)
 	require.Error(t, err)
 	require.ErrorContains(t, err, "custom error")
 	assert.Empty(t, status)
@@ -111,7 +117,7 @@ func TestTendermintBlockSuccessLatest(t *testing.T) {
 	tracer := tracing.InitNoopTracer()
 	rpc := NewRPC(config, *logger, tracer)
 
-	status, err := rpc.Block(context.Background(), 0)
+	status, _, err := rpc.Block(context.Background(), 0)
 	require.NoError(t, err)
 	assert.Equal(t, int64(21077108), status.Result.Block.Header.Height)
 }
@@ -135,7 +141,7 @@ func TestTendermintBlockSpecific(t *testing.T) {
 	tracer := tracing.InitNoopTracer()
 	rpc := NewRPC(config, *logger, tracer)
 
-	status, err := rpc.Block(context.Background(), 21077108)
+	status, _, err := rpc.Block(context.Background(), 21077108)
 	require.NoError(t, err)
 	assert.Equal(t, int64(21077108), status.Result.Block.Header.Height)
 }
@@ -159,7 +165,7 @@ func TestTendermintGetBlockTimeFailCurrent(t *testing.T) {
 	tracer := tracing.InitNoopTracer()
 	rpc := NewRPC(config, *logger, tracer)
 
-	_, queryInfo, err := rpc.GetBlockTime(context.Background())
+	_, queryInfo, err := rpc.GetBlockTime(context.Background(), []int64{1000}, 0)
 	require.Error(t, err)
 	assert.False(t, queryInfo.Success)
 	require.ErrorContains(t, err, "custom error")
@@ -190,7 +196,7 @@ func TestTendermintGetBlockTimeFailOlder(t *testing.T) {
 	tracer := tracing.InitNoopTracer()
 	rpc := NewRPC(config, *logger, tracer)
 
-	_, queryInfo, err := rpc.GetBlockTime(context.Background())
+	_, queryInfo, err := rpc.GetBlockTime(context.Background(), []int64{1000}, 0)
 	require.Error(t, err)
 	assert.False(t, queryInfo.Success)
 	require.ErrorContains(t, err, "custom error")
@@ -221,10 +227,78 @@ func TestTendermintGetBlockTimeSuccess(t *testing.T) {
 	tracer := tracing.InitNoopTracer()
 	rpc := NewRPC(config, *logger, tracer)
 
-	blockTime, queryInfo, err := rpc.GetBlockTime(context.Background())
+	blockTimes, queryInfo, err := rpc.GetBlockTime(context.Background(), []int64{1000}, 0)
 	require.NoError(t, err)
 	require.True(t, queryInfo.Success)
-	assert.NotNil(t, blockTime)
+	require.Len(t, blockTimes, 1)
+	assert.Equal(t, int64(1000), blockTimes[0].Window)
+	assert.Equal(t, int64(21076108), blockTimes[0].BlocksInfo.OlderBlock.Result.Block.Header.Height)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestTendermintGetBlockTimeClampedToEarliest(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com:443/block",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("block.json")),
+	)
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com:443/block?height=21076108",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("block2.json")),
+	)
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com:443/block?height=21065923",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("block2.json")),
+	)
+
+	config := configPkg.TendermintConfig{
+		Enabled: null.BoolFrom(true),
+		Address: "https://example.com:443",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	rpc := NewRPC(config, *logger, tracer)
+
+	blockTimes, queryInfo, err := rpc.GetBlockTime(context.Background(), []int64{1000, 100000}, 21065923)
+	require.NoError(t, err)
+	require.True(t, queryInfo.Success)
+	require.Len(t, blockTimes, 2)
+	assert.Equal(t, int64(100000), blockTimes[1].Window)
+
+	info := httpmock.GetCallCountInfo()
+	assert.Equal(t, 1, info["GET https://example.com:443/block?height=21065923"])
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestTendermintGetBlockTimeNotEnoughBlocks(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com:443/block",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("block.json")),
+	)
+
+	config := configPkg.TendermintConfig{
+		Enabled: null.BoolFrom(true),
+		Address: "https://example.com:443",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	rpc := NewRPC(config, *logger, tracer)
+
+	blockTimes, queryInfo, err := rpc.GetBlockTime(context.Background(), []int64{1000}, 21077108)
+	require.NoError(t, err)
+	require.True(t, queryInfo.Success)
+	assert.Empty(t, blockTimes)
 }
 
 //nolint:paralleltest // disabled due to httpmock usage
@@ -301,3 +375,575 @@ func TestTendermintNotSuccessCode(t *testing.T) {
 	require.ErrorContains(t, err, "http request failed with status code 501")
 	assert.False(t, queryInfo.Success)
 }
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestTendermintGetConsensusStateError(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com:443/consensus_state",
+		httpmock.NewErrorResponder(errors.New("custom error")),
+	)
+
+	config := configPkg.TendermintConfig{
+		Enabled: null.BoolFrom(true),
+		Address: "https://example.com:443",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	rpc := NewRPC(config, *logger, tracer)
+
+	consensusState, queryInfo, err := rpc.GetConsensusState(context.Background())
+	require.Error(t, err)
+	require.ErrorContains(t, err, "custom error")
+	require.False(t, queryInfo.Success)
+	require.Nil(t, consensusState)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestTendermintGetConsensusStateInvalid(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com:443/consensus_state",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("consensus-state-invalid.json")),
+	)
+
+	config := configPkg.TendermintConfig{
+		Enabled: null.BoolFrom(true),
+		Address: "https://example.com:443",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	rpc := NewRPC(config, *logger, tracer)
+
+	consensusState, queryInfo, err := rpc.GetConsensusState(context.Background())
+	require.Error(t, err)
+	require.ErrorContains(t, err, "malformed height/round/step")
+	require.False(t, queryInfo.Success)
+	require.Nil(t, consensusState)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestTendermintGetConsensusStateSuccess(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com:443/consensus_state",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("consensus-state.json")),
+	)
+
+	config := configPkg.TendermintConfig{
+		Enabled: null.BoolFrom(true),
+		Address: "https://example.com:443",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	rpc := NewRPC(config, *logger, tracer)
+
+	consensusState, queryInfo, err := rpc.GetConsensusState(context.Background())
+	require.NoError(t, err)
+	require.True(t, queryInfo.Success)
+	require.Equal(t, &ConsensusState{
+		Height:            21077109,
+		Round:             1,
+		Step:              6,
+		PrevotesPercent:   75,
+		PrecommitsPercent: 50,
+	}, consensusState)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestTendermintValidatorsFail(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com:443/validators?page=1&per_page=100",
+		httpmock.NewErrorResponder(errors.New("custom error")),
+	)
+
+	config := configPkg.TendermintConfig{
+		Enabled: null.BoolFrom(true),
+		Address: "https://example.com:443",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	rpc := NewRPC(config, *logger, tracer)
+
+	_, queryInfo, err := rpc.Validators(context.Background())
+	require.Error(t, err)
+	require.ErrorContains(t, err, "custom error")
+	require.False(t, queryInfo.Success)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestTendermintValidatorsPaginated(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com:443/validators?page=1&per_page=100",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("validators-page-1.json")),
+	)
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com:443/validators?page=2&per_page=100",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("validators-page-2.json")),
+	)
+
+	config := configPkg.TendermintConfig{
+		Enabled: null.BoolFrom(true),
+		Address: "https://example.com:443",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	rpc := NewRPC(config, *logger, tracer)
+
+	validators, queryInfo, err := rpc.Validators(context.Background())
+	require.NoError(t, err)
+	require.True(t, queryInfo.Success)
+	require.Len(t, validators, 3)
+	assert.Equal(t, "DFA9DD9F731B4D94E19285E810D5D425CC6BB1E3", validators[1].Address)
+	assert.Equal(t, int64(10000), validators[1].VotingPower)
+	assert.Equal(t, int64(2500), validators[1].ProposerPriority)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestTendermintGetInactiveValidatorsFail(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterRegexpResponder(
+		"GET",
+		regexp.MustCompile(`/abci_query\?path=%22%2Fcosmos.staking.v1beta1.Query%2FValidators%22`),
+		httpmock.NewErrorResponder(errors.New("custom error")),
+	)
+
+	config := configPkg.TendermintConfig{
+		Enabled: null.BoolFrom(true),
+		Address: "https://example.com:443",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	rpc := NewRPC(config, *logger, tracer)
+
+	_, queryInfo, err := rpc.GetInactiveValidators(context.Background())
+	require.Error(t, err)
+	require.ErrorContains(t, err, "custom error")
+	require.False(t, queryInfo.Success)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestTendermintGetInactiveValidatorsOk(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterRegexpResponder(
+		"GET",
+		regexp.MustCompile(`/abci_query\?path=%22%2Fcosmos.staking.v1beta1.Query%2FValidators%22`),
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("staking-validators-inactive.json")),
+	)
+
+	config := configPkg.TendermintConfig{
+		Enabled: null.BoolFrom(true),
+		Address: "https://example.com:443",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	rpc := NewRPC(config, *logger, tracer)
+
+	validators, queryInfo, err := rpc.GetInactiveValidators(context.Background())
+	require.NoError(t, err)
+	require.True(t, queryInfo.Success)
+
+	// both unbonding and unbonded validators are queried
+	require.Len(t, validators, 6)
+	assert.Equal(t, "cosmosvaloper1first", validators[0].OperatorAddress)
+	assert.Equal(t, 2, httpmock.GetTotalCallCount())
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestTendermintGetBondedTokensFail(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterRegexpResponder(
+		"GET",
+		regexp.MustCompile(`/abci_query\?path=%22%2Fcosmos.staking.v1beta1.Query%2FPool%22`),
+		httpmock.NewErrorResponder(errors.New("custom error")),
+	)
+
+	config := configPkg.TendermintConfig{
+		Enabled: null.BoolFrom(true),
+		Address: "https://example.com:443",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	rpc := NewRPC(config, *logger, tracer)
+
+	_, queryInfo, err := rpc.GetBondedTokens(context.Background())
+	require.Error(t, err)
+	require.ErrorContains(t, err, "custom error")
+	require.False(t, queryInfo.Success)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestTendermintGetBondedTokensOk(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterRegexpResponder(
+		"GET",
+		regexp.MustCompile(`/abci_query\?path=%22%2Fcosmos.staking.v1beta1.Query%2FPool%22`),
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("staking-pool.json")),
+	)
+
+	config := configPkg.TendermintConfig{
+		Enabled: null.BoolFrom(true),
+		Address: "https://example.com:443",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	rpc := NewRPC(config, *logger, tracer)
+
+	bondedTokens, queryInfo, err := rpc.GetBondedTokens(context.Background())
+	require.NoError(t, err)
+	require.True(t, queryInfo.Success)
+	assert.Equal(t, "38000500000", bondedTokens.String())
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestTendermintGetPeersHeightsFail(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com:443/dump_consensus_state",
+		httpmock.NewErrorResponder(errors.New("custom error")),
+	)
+
+	config := configPkg.TendermintConfig{
+		Enabled: null.BoolFrom(true),
+		Address: "https://example.com:443",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	rpc := NewRPC(config, *logger, tracer)
+
+	_, queryInfo, err := rpc.GetPeersHeights(context.Background())
+	require.Error(t, err)
+	require.ErrorContains(t, err, "custom error")
+	require.False(t, queryInfo.Success)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestTendermintGetPeersHeightsOk(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com:443/dump_consensus_state",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("dump-consensus-state.json")),
+	)
+
+	config := configPkg.TendermintConfig{
+		Enabled: null.BoolFrom(true),
+		Address: "https://example.com:443",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	rpc := NewRPC(config, *logger, tracer)
+
+	heights, queryInfo, err := rpc.GetPeersHeights(context.Background())
+	require.NoError(t, err)
+	require.True(t, queryInfo.Success)
+	assert.Equal(t, []int64{21077110, 21077112, 21077111}, heights)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestTendermintAbciInfoFail(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com:443/abci_info",
+		httpmock.NewErrorResponder(errors.New("custom error")),
+	)
+
+	config := configPkg.TendermintConfig{
+		Enabled: null.BoolFrom(true),
+		Address: "https://example.com:443",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	rpc := NewRPC(config, *logger, tracer)
+
+	_, queryInfo, err := rpc.AbciInfo(context.Background())
+	require.Error(t, err)
+	require.ErrorContains(t, err, "custom error")
+	require.False(t, queryInfo.Success)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestTendermintAbciInfoOk(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com:443/abci_info",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("abci-info.json")),
+	)
+
+	config := configPkg.TendermintConfig{
+		Enabled: null.BoolFrom(true),
+		Address: "https://example.com:443",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	rpc := NewRPC(config, *logger, tracer)
+
+	abciInfo, queryInfo, err := rpc.AbciInfo(context.Background())
+	require.NoError(t, err)
+	require.True(t, queryInfo.Success)
+	assert.Equal(t, "GaiaApp", abciInfo.Result.Response.Data)
+	assert.Equal(t, "v17.2.0", abciInfo.Result.Response.Version)
+	assert.Equal(t, uint64(2), abciInfo.Result.Response.AppVersion)
+	assert.Equal(t, int64(21076914), abciInfo.Result.Response.LastBlockHeight)
+	assert.Len(t, abciInfo.Result.Response.LastBlockAppHash, 32)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestTendermintGetUpgradeProposalsFail(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterRegexpResponder(
+		"GET",
+		regexp.MustCompile(`/abci_query\?path=%22%2Fcosmos.gov.v1.Query%2FProposals%22`),
+		httpmock.NewErrorResponder(errors.New("custom error")),
+	)
+
+	config := configPkg.TendermintConfig{
+		Enabled: null.BoolFrom(true),
+		Address: "https://example.com:443",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	rpc := NewRPC(config, *logger, tracer)
+
+	_, queryInfo, err := rpc.GetUpgradeProposals(context.Background())
+	require.Error(t, err)
+	require.ErrorContains(t, err, "custom error")
+	require.False(t, queryInfo.Success)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestTendermintGetUpgradeProposalsTallyFail(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com:443/abci_query?path=%22%2Fcosmos.gov.v1.Query%2FProposals%22&data=0x080122021864",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("gov-proposals-deposit.json")),
+	)
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com:443/abci_query?path=%22%2Fcosmos.gov.v1.Query%2FProposals%22&data=0x080222021864",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("gov-proposals-voting.json")),
+	)
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com:443/abci_query?path=%22%2Fcosmos.gov.v1.Query%2FTallyResult%22&data=0x080a",
+		httpmock.NewErrorResponder(errors.New("custom error")),
+	)
+
+	config := configPkg.TendermintConfig{
+		Enabled: null.BoolFrom(true),
+		Address: "https://example.com:443",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	rpc := NewRPC(config, *logger, tracer)
+
+	_, queryInfo, err := rpc.GetUpgradeProposals(context.Background())
+	require.Error(t, err)
+	require.ErrorContains(t, err, "custom error")
+	require.False(t, queryInfo.Success)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestTendermintGetUpgradeProposalsOk(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com:443/abci_query?path=%22%2Fcosmos.gov.v1.Query%2FProposals%22&data=0x080122021864",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("gov-proposals-deposit.json")),
+	)
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com:443/abci_query?path=%22%2Fcosmos.gov.v1.Query%2FProposals%22&data=0x080222021864",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("gov-proposals-voting.json")),
+	)
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com:443/abci_query?path=%22%2Fcosmos.gov.v1.Query%2FTallyResult%22&data=0x080a",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("gov-tally.json")),
+	)
+
+	config := configPkg.TendermintConfig{
+		Enabled: null.BoolFrom(true),
+		Address: "https://example.com:443",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	rpc := NewRPC(config, *logger, tracer)
+
+	proposals, queryInfo, err := rpc.GetUpgradeProposals(context.Background())
+	require.NoError(t, err)
+	require.True(t, queryInfo.Success)
+	require.Len(t, proposals, 2)
+
+	assert.Equal(t, uint64(11), proposals[0].ID)
+	assert.Equal(t, govV1Types.StatusDepositPeriod, proposals[0].Status)
+	assert.Equal(t, "v19", proposals[0].Plan.Name)
+	assert.Equal(t, int64(21500000), proposals[0].Plan.Height)
+	assert.Nil(t, proposals[0].Tally)
+
+	assert.Equal(t, uint64(10), proposals[1].ID)
+	assert.Equal(t, govV1Types.StatusVotingPeriod, proposals[1].Status)
+	assert.Equal(t, "v18", proposals[1].Plan.Name)
+	assert.Equal(t, int64(21300000), proposals[1].Plan.Height)
+	require.NotNil(t, proposals[1].VotingEndTime)
+	assert.Equal(t, int64(1720008000), proposals[1].VotingEndTime.Unix())
+	require.NotNil(t, proposals[1].Tally)
+	assert.Equal(t, "100000000", proposals[1].Tally.YesCount)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestTendermintGetAppliedPlansFail(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterRegexpResponder(
+		"GET",
+		regexp.MustCompile(`/abci_query\?path=%22%2Fcosmos.upgrade.v1beta1.Query%2FAppliedPlan%22`),
+		httpmock.NewErrorResponder(errors.New("custom error")),
+	)
+
+	config := configPkg.TendermintConfig{
+		Enabled: null.BoolFrom(true),
+		Address: "https://example.com:443",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	rpc := NewRPC(config, *logger, tracer)
+
+	_, queryInfo, err := rpc.GetAppliedPlans(context.Background(), []string{"v17"})
+	require.Error(t, err)
+	require.ErrorContains(t, err, "custom error")
+	require.False(t, queryInfo.Success)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestTendermintGetAppliedPlansOk(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com:443/abci_query?path=%22%2Fcosmos.upgrade.v1beta1.Query%2FAppliedPlan%22&data=0x0a03763137",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("upgrade-applied-plan.json")),
+	)
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com:443/abci_query?path=%22%2Fcosmos.upgrade.v1beta1.Query%2FAppliedPlan%22&data=0x0a03763138",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("upgrade-applied-plan-empty.json")),
+	)
+
+	config := configPkg.TendermintConfig{
+		Enabled: null.BoolFrom(true),
+		Address: "https://example.com:443",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	rpc := NewRPC(config, *logger, tracer)
+
+	appliedPlans, queryInfo, err := rpc.GetAppliedPlans(context.Background(), []string{"v17", "v18"})
+	require.NoError(t, err)
+	require.True(t, queryInfo.Success)
+	assert.Equal(t, map[string]int64{"v17": 20739800, "v18": 0}, appliedPlans)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestTendermintGetModuleVersionsFail(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com:443/abci_query?path=%22%2Fcosmos.upgrade.v1beta1.Query%2FModuleVersions%22&data=0x",
+		httpmock.NewErrorResponder(errors.New("custom error")),
+	)
+
+	config := configPkg.TendermintConfig{
+		Enabled: null.BoolFrom(true),
+		Address: "https://example.com:443",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	rpc := NewRPC(config, *logger, tracer)
+
+	_, queryInfo, err := rpc.GetModuleVersions(context.Background())
+	require.Error(t, err)
+	require.ErrorContains(t, err, "custom error")
+	require.False(t, queryInfo.Success)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestTendermintGetModuleVersionsOk(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com:443/abci_query?path=%22%2Fcosmos.upgrade.v1beta1.Query%2FModuleVersions%22&data=0x",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("upgrade-module-versions.json")),
+	)
+
+	config := configPkg.TendermintConfig{
+		Enabled: null.BoolFrom(true),
+		Address: "https://example.com:443",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	rpc := NewRPC(config, *logger, tracer)
+
+	moduleVersions, queryInfo, err := rpc.GetModuleVersions(context.Background())
+	require.NoError(t, err)
+	require.True(t, queryInfo.Success)
+	require.Len(t, moduleVersions, 4)
+	assert.Equal(t, "auth", moduleVersions[0].Name)
+	assert.Equal(t, uint64(5), moduleVersions[0].Version)
+}
@@ -1,6 +1,15 @@
 package tendermint
 
-import "time"
+import (
+	"fmt"
+	"main/pkg/constants"
+	"main/pkg/utils"
+	"strings"
+	"time"
+
+	upgradeTypes "cosmossdk.io/x/upgrade/types"
+	govV1Types "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
 
 type StatusResponse struct {
 	Result StatusResult `json:"result"`
@@ -12,6 +21,22 @@ type StatusResult struct {
 	ValidatorInfo ValidatorInfo `json:"validator_info"`
 }
 
+type AbciInfoResponse struct {
+	Result AbciInfoResult `json:"result"`
+}
+
+type AbciInfoResult struct {
+	Response AbciInfo `json:"response"`
+}
+
+type AbciInfo struct {
+	Data             string `json:"data"`
+	Version          string `json:"version"`
+	AppVersion       uint64 `json:"app_version,string"`
+	LastBlockHeight  int64  `json:"last_block_height,string"`
+	LastBlockAppHash []byte `json:"last_block_app_hash"`
+}
+
 type NodeInfo struct {
 	Moniker string `json:"moniker"`
 	Network string `json:"network"`
@@ -19,13 +44,28 @@ type NodeInfo struct {
 }
 
 type SyncInfo struct {
-	LatestBlockHeight int64     `json:"latest_block_height,string"`
-	LatestBlockTime   time.Time `json:"latest_block_time"`
-	CatchingUp        bool      `json:"catching_up"`
+	LatestBlockHeight   int64     `json:"latest_block_height,string"`
+	LatestBlockTime     time.Time `json:"latest_block_time"`
+	LatestAppHash       string    `json:"latest_app_hash"`
+	EarliestBlockHeight int64     `json:"earliest_block_height,string"`
+	EarliestBlockTime   time.Time `json:"earliest_block_time"`
+	EarliestAppHash     string    `json:"earliest_app_hash"`
+	CatchingUp          bool      `json:"catching_up"`
+}
+
+// RetainedBlocks returns the amount of blocks the node still has, which is
+// less than the chain height on pruned or state-synced nodes.
+func (s SyncInfo) RetainedBlocks() int64 {
+	if s.EarliestBlockHeight == 0 || s.LatestBlockHeight < s.EarliestBlockHeight {
+		return 0
+	}
+
+	return s.LatestBlockHeight - s.EarliestBlockHeight + 1
 }
 
 type ValidatorInfo struct {
-	VotingPower int64 `json:"voting_power,string"`
+	Address     string `json:"address"`
+	VotingPower int64  `json:"voting_power,string"`
 }
 
 type BlockResponse struct {
@@ -45,6 +85,44 @@ type BlockHeader struct {
 	Time   time.Time `json:"time"`
 }
 
+type ValidatorsResponse struct {
+	Result ValidatorsResult `json:"result"`
+}
+
+type ValidatorsResult struct {
+	BlockHeight int64       `json:"block_height,string"`
+	Validators  []Validator `json:"validators"`
+	Count       int         `json:"count,string"`
+	Total       int         `json:"total,string"`
+}
+
+type Validator struct {
+	Address          string `json:"address"`
+	VotingPower      int64  `json:"voting_power,string"`
+	ProposerPriority int64  `json:"proposer_priority,string"`
+}
+
+type DumpConsensusStateResponse struct {
+	Result DumpConsensusStateResult `json:"result"`
+}
+
+type DumpConsensusStateResult struct {
+	Peers []ConsensusPeer `json:"peers"`
+}
+
+type ConsensusPeer struct {
+	NodeAddress string    `json:"node_address"`
+	PeerState   PeerState `json:"peer_state"`
+}
+
+type PeerState struct {
+	RoundState PeerRoundState `json:"round_state"`
+}
+
+type PeerRoundState struct {
+	Height int64 `json:"height,string"`
+}
+
 type AbciQueryResponse struct {
 	Result AbciQueryResult `json:"result"`
 }
@@ -58,6 +136,36 @@ type AbciResponse struct {
 	Value []byte `json:"value"`
 }
 
+type WebsocketRequest struct {
+	JSONRPC string            `json:"jsonrpc"`
+	Method  string            `json:"method"`
+	ID      int               `json:"id"`
+	Params  map[string]string `json:"params"`
+}
+
+type WebsocketEvent struct {
+	Result WebsocketEventResult `json:"result"`
+	Error  *WebsocketError      `json:"error"`
+}
+
+type WebsocketError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type WebsocketEventResult struct {
+	Data WebsocketEventData `json:"data"`
+}
+
+type WebsocketEventData struct {
+	Type  string              `json:"type"`
+	Value WebsocketEventValue `json:"value"`
+}
+
+type WebsocketEventValue struct {
+	Block Block `json:"block"`
+}
+
 type BlocksInfo struct {
 	NewerBlock BlockResponse
 	OlderBlock BlockResponse
@@ -68,3 +176,136 @@ func (b *BlocksInfo) BlockTime() float64 {
 	blocksDiffTime := b.NewerBlock.Result.Block.Header.Time.Sub(b.OlderBlock.Result.Block.Header.Time)
 	return blocksDiffTime.Seconds() / float64(blocksDiffHeight)
 }
+
+// EstimatedTimeAt extrapolates the time of the block at the given height
+// from the newer block, using the average block time.
+func (b *BlocksInfo) EstimatedTimeAt(height int64) time.Time {
+	blocksTillEstimatedBlock := height - b.NewerBlock.Result.Block.Header.Height
+	secondsTillEstimatedBlock := int64(float64(blocksTillEstimatedBlock) * b.BlockTime())
+	durationTillEstimatedBlock := time.Duration(secondsTillEstimatedBlock * int64(time.Second))
+
+	return b.NewerBlock.Result.Block.Header.Time.Add(durationTillEstimatedBlock)
+}
+
+type BlockTimeWindow struct {
+	Window     int64
+	BlocksInfo *BlocksInfo
+}
+
+type BlockTimes []BlockTimeWindow
+
+func (b BlockTimes) ForWindow(window int64) (*BlocksInfo, bool) {
+	for _, blockTime := range b {
+		if blockTime.Window == window {
+			return blockTime.BlocksInfo, true
+		}
+	}
+
+	return nil, false
+}
+
+type ConsensusStateResponse struct {
+	Result ConsensusStateResult `json:"result"`
+}
+
+type ConsensusStateResult struct {
+	RoundState RoundState `json:"round_state"`
+}
+
+type RoundState struct {
+	HeightRoundStep string          `json:"height/round/step"`
+	HeightVoteSet   []HeightVoteSet `json:"height_vote_set"`
+}
+
+type HeightVoteSet struct {
+	Round              int32  `json:"round"`
+	PrevotesBitArray   string `json:"prevotes_bit_array"`
+	PrecommitsBitArray string `json:"precommits_bit_array"`
+}
+
+type ConsensusState struct {
+	Height            int64
+	Round             int32
+	Step              int64
+	PrevotesPercent   float64
+	PrecommitsPercent float64
+}
+
+func (r RoundState) ConsensusState() (*ConsensusState, error) {
+	// height/round/step is returned as a single string, like "21077109/0/1"
+	split := strings.Split(r.HeightRoundStep, "/")
+	if len(split) != 3 {
+		return nil, fmt.Errorf("malformed height/round/step: %s", r.HeightRoundStep)
+	}
+
+	height, err := utils.StringToInt64(split[0])
+	if err != nil {
+		return nil, err
+	}
+
+	round, err := utils.StringToInt64(split[1])
+	if err != nil {
+		return nil, err
+	}
+
+	step, err := utils.StringToInt64(split[2])
+	if err != nil {
+		return nil, err
+	}
+
+	state := &ConsensusState{
+		Height: height,
+		Round:  int32(round),
+		Step:   step,
+	}
+
+	for _, voteSet := range r.HeightVoteSet {
+		if voteSet.Round != state.Round {
+			continue
+		}
+
+		if state.PrevotesPercent, err = parseBitArrayPercent(voteSet.PrevotesBitArray); err != nil {
+			return nil, err
+		}
+
+		if state.PrecommitsPercent, err = parseBitArrayPercent(voteSet.PrecommitsBitArray); err != nil {
+			return nil, err
+		}
+	}
+
+	return state, nil
+}
+
+// bit arrays look like "BA{180:xx_x} 12345/67890 = 0.18", the voted/total
+// voting power is taken from there as the trailing ratio is rounded.
+func parseBitArrayPercent(bitArray string) (float64, error) {
+	matches := constants.BitArrayVotingPowerRegexp.FindStringSubmatch(bitArray)
+	if len(matches) != 3 {
+		return 0, fmt.Errorf("malformed bit array: %s", bitArray)
+	}
+
+	voted, err := utils.StringToFloat64(matches[1])
+	if err != nil {
+		return 0, err
+	}
+
+	total, err := utils.StringToFloat64(matches[2])
+	if err != nil {
+		return 0, err
+	}
+
+	if total == 0 {
+		return 0, nil
+	}
+
+	return voted / total * 100, nil
+}
+
+type UpgradeProposal struct {
+	ID            uint64
+	Status        govV1Types.ProposalStatus
+	Plan          upgradeTypes.Plan
+	VotingEndTime *time.Time
+	// only available for proposals in voting period
+	Tally *govV1Types.TallyResult
+}
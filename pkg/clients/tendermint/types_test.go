@@ -0,0 +1,123 @@
+package tendermint
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoundStateInvalidHeight(t *testing.T) {
+	t.Parallel()
+
+	roundState := RoundState{HeightRoundStep: "abc/0/1"}
+	state, err := roundState.ConsensusState()
+	require.Error(t, err)
+	require.Nil(t, state)
+}
+
+func TestRoundStateInvalidRound(t *testing.T) {
+	t.Parallel()
+
+	roundState := RoundState{HeightRoundStep: "100/abc/1"}
+	state, err := roundState.ConsensusState()
+	require.Error(t, err)
+	require.Nil(t, state)
+}
+
+func TestRoundStateInvalidStep(t *testing.T) {
+	t.Parallel()
+
+	roundState := RoundState{HeightRoundStep: "100/0/abc"}
+	state, err := roundState.ConsensusState()
+	require.Error(t, err)
+	require.Nil(t, state)
+}
+
+func TestRoundStateInvalidPrevotes(t *testing.T) {
+	t.Parallel()
+
+	roundState := RoundState{
+		HeightRoundStep: "100/0/1",
+		HeightVoteSet: []HeightVoteSet{
+			{Round: 0, PrevotesBitArray: "invalid"},
+		},
+	}
+	state, err := roundState.ConsensusState()
+	require.Error(t, err)
+	require.ErrorContains(t, err, "malformed bit array")
+	require.Nil(t, state)
+}
+
+func TestRoundStateInvalidPrecommits(t *testing.T) {
+	t.Parallel()
+
+	roundState := RoundState{
+		HeightRoundStep: "100/0/1",
+		HeightVoteSet: []HeightVoteSet{
+			{Round: 0, PrevotesBitArray: "BA{1:x} 1/1 = 1.00", PrecommitsBitArray: "invalid"},
+		},
+	}
+	state, err := roundState.ConsensusState()
+	require.Error(t, err)
+	require.ErrorContains(t, err, "malformed bit array")
+	require.Nil(t, state)
+}
+
+func TestRoundStateZeroTotalPower(t *testing.T) {
+	t.Parallel()
+
+	roundState := RoundState{
+		HeightRoundStep: "100/0/1",
+		HeightVoteSet: []HeightVoteSet{
+			{Round: 0, PrevotesBitArray: "BA{1:_} 0/0 = 0.00", PrecommitsBitArray: "BA{1:_} 0/0 = 0.00"},
+		},
+	}
+	state, err := roundState.ConsensusState()
+	require.NoError(t, err)
+	require.Zero(t, state.PrevotesPercent)
+	require.Zero(t, state.PrecommitsPercent)
+}
+
+func TestBlockTimesForWindow(t *testing.T) {
+	t.Parallel()
+
+	blockTimes := BlockTimes{
+		{Window: 100, BlocksInfo: &BlocksInfo{}},
+		{Window: 1000, BlocksInfo: &BlocksInfo{}},
+	}
+
+	blocksInfo, found := blockTimes.ForWindow(1000)
+	assert.True(t, found)
+	assert.Same(t, blockTimes[1].BlocksInfo, blocksInfo)
+
+	_, found = blockTimes.ForWindow(10000)
+	assert.False(t, found)
+}
+
+func TestSyncInfoRetainedBlocks(t *testing.T) {
+	t.Parallel()
+
+	assert.Zero(t, SyncInfo{LatestBlockHeight: 100}.RetainedBlocks())
+	assert.Zero(t, SyncInfo{LatestBlockHeight: 100, EarliestBlockHeight: 200}.RetainedBlocks())
+	assert.Equal(t, int64(100), SyncInfo{LatestBlockHeight: 100, EarliestBlockHeight: 1}.RetainedBlocks())
+	assert.Equal(t, int64(1), SyncInfo{LatestBlockHeight: 100, EarliestBlockHeight: 100}.RetainedBlocks())
+}
+
+func TestBlocksInfoEstimatedTimeAt(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, 6, 29, 0, 0, 0, 0, time.UTC)
+	blocksInfo := &BlocksInfo{
+		NewerBlock: BlockResponse{Result: BlockResult{Block: Block{
+			Header: BlockHeader{Height: 2000, Time: start},
+		}}},
+		OlderBlock: BlockResponse{Result: BlockResult{Block: Block{
+			Header: BlockHeader{Height: 1000, Time: start.Add(-6000 * time.Second)},
+		}}},
+	}
+
+	assert.Equal(t, start.Add(600*time.Second), blocksInfo.EstimatedTimeAt(2100))
+	assert.Equal(t, start.Add(-600*time.Second), blocksInfo.EstimatedTimeAt(1900))
+}
@@ -0,0 +1,394 @@
+package tendermint
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"main/pkg/config"
+	"main/pkg/constants"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/rs/zerolog"
+)
+
+type HeadersBuffer struct {
+	headers []BlockHeader
+	start   int
+	size    int
+}
+
+func NewHeadersBuffer(capacity int) *HeadersBuffer {
+	return &HeadersBuffer{headers: make([]BlockHeader, capacity)}
+}
+
+func (b *HeadersBuffer) Add(header BlockHeader) {
+	if b.size < len(b.headers) {
+		b.headers[(b.start+b.size)%len(b.headers)] = header
+		b.size++
+		return
+	}
+
+	// buffer is full, overwriting the oldest header
+	b.headers[b.start] = header
+	b.start = (b.start + 1) % len(b.headers)
+}
+
+func (b *HeadersBuffer) Reset() {
+	b.start = 0
+	b.size = 0
+}
+
+func (b *HeadersBuffer) Len() int {
+	return b.size
+}
+
+func (b *HeadersBuffer) Latest() (BlockHeader, bool) {
+	if b.size == 0 {
+		return BlockHeader{}, false
+	}
+
+	return b.headers[(b.start+b.size-1)%len(b.headers)], true
+}
+
+// All returns the stored headers, from the oldest to the newest one.
+func (b *HeadersBuffer) All() []BlockHeader {
+	headers := make([]BlockHeader, b.size)
+	for index := range headers {
+		headers[index] = b.headers[(b.start+index)%len(b.headers)]
+	}
+
+	return headers
+}
+
+type WebsocketSnapshot struct {
+	Connected          bool
+	Headers            []BlockHeader
+	TimeSinceLastBlock time.Duration
+}
+
+// Live returns whether the snapshot comes from an alive subscription that has received blocks,
+// so its latest block can be used instead of the polled one.
+func (s WebsocketSnapshot) Live() bool {
+	return s.Connected && len(s.Headers) > 0
+}
+
+func (s WebsocketSnapshot) LatestHeader() (BlockHeader, bool) {
+	if len(s.Headers) == 0 {
+		return BlockHeader{}, false
+	}
+
+	return s.Headers[len(s.Headers)-1], true
+}
+
+// BlocksInfo builds the block time estimate out of the stored headers,
+// so it won't be needed to query the older block over RPC.
+func (s WebsocketSnapshot) BlocksInfo() (*BlocksInfo, bool) {
+	if len(s.Headers) < constants.WebsocketMinHeadersForBlockTime {
+		return nil, false
+	}
+
+	return &BlocksInfo{
+		NewerBlock: BlockResponse{Result: BlockResult{Block: Block{Header: s.Headers[len(s.Headers)-1]}}},
+		OlderBlock: BlockResponse{Result: BlockResult{Block: Block{Header: s.Headers[0]}}},
+	}, true
+}
+
+// BlocksInfoForWindow builds the block time estimate for the given window
+// out of the stored headers, if there are enough of them. Blocks might be missed
+// while reconnecting, so the older header is picked by height, not by position.
+func (s WebsocketSnapshot) BlocksInfoForWindow(window int64) (*BlocksInfo, bool) {
+	latest, found := s.LatestHeader()
+	if window <= 0 || !found {
+		return nil, false
+	}
+
+	// headers are sorted by height, taking the newest one at least window blocks older
+	olderHeight := latest.Height - window
+	index := sort.Search(len(s.Headers), func(i int) bool {
+		return s.Headers[i].Height > olderHeight
+	})
+
+	if index == 0 {
+		return nil, false
+	}
+
+	return &BlocksInfo{
+		NewerBlock: BlockResponse{Result: BlockResult{Block: Block{Header: latest}}},
+		OlderBlock: BlockResponse{Result: BlockResult{Block: Block{Header: s.Headers[index-1]}}},
+	}, true
+}
+
+type WebsocketClient struct {
+	Logger     zerolog.Logger
+	URL        string
+	Headers    http.Header
+	Dialer     *websocket.Dialer
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+	// the connection is considered dead if nothing, including pongs, was read for PongWait
+	PingInterval time.Duration
+	PongWait     time.Duration
+
+	mutex               sync.Mutex
+	headers             *HeadersBuffer
+	connected           bool
+	lastBlockReceivedAt time.Time
+	connection          *websocket.Conn
+	stopChannel         chan struct{}
+	stopped             bool
+}
+
+func NewWebsocketClient(config config.TendermintConfig, logger zerolog.Logger) *WebsocketClient {
+	// http://localhost:26657 -> ws://localhost:26657/websocket
+	url := strings.TrimSuffix(config.Address, "/") + "/websocket"
+	url = strings.Replace(url, "http", "ws", 1)
+
+	// storing enough headers to calculate the block time for the largest window
+	buffer := constants.BlocksBehindToCheck
+	for _, window := range config.BlockTimeWindows {
+		buffer = max(buffer, int(window))
+	}
+
+	websocketLogger := logger.With().Str("component", "tendermint_websocket").Logger()
+
+	headers := http.Header{}
+	for key, value := range config.Headers {
+		headers.Set(key, value)
+	}
+
+	if config.BasicAuth.Username != "" {
+		auth := config.BasicAuth.Username + ":" + config.BasicAuth.Password
+		headers.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(auth)))
+	}
+
+	dialer := *websocket.DefaultDialer
+
+	tlsConfig, err := config.GetTLSConfig()
+	if err != nil {
+		// the connection would still be attempted, failing if the node requires this TLS config
+		websocketLogger.Error().Err(err).Msg("Could not load TLS config")
+	} else if tlsConfig != nil {
+		dialer.TLSClientConfig = tlsConfig
+	}
+
+	return &WebsocketClient{
+		Logger:       websocketLogger,
+		URL:          url,
+		Headers:      headers,
+		Dialer:       &dialer,
+		MinBackoff:   constants.WebsocketMinReconnectBackoff,
+		MaxBackoff:   constants.WebsocketMaxReconnectBackoff,
+		PingInterval: constants.WebsocketPingInterval,
+		PongWait:     constants.WebsocketPongWait,
+		headers:      NewHeadersBuffer(buffer + 1),
+		stopChannel:  make(chan struct{}),
+	}
+}
+
+func (c *WebsocketClient) Start() {
+	go c.run()
+}
+
+func (c *WebsocketClient) Stop() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.stopped {
+		return
+	}
+
+	c.stopped = true
+	close(c.stopChannel)
+
+	if c.connection != nil {
+		_ = c.connection.Close()
+	}
+}
+
+func (c *WebsocketClient) Snapshot() WebsocketSnapshot {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	snapshot := WebsocketSnapshot{
+		Connected: c.connected,
+		Headers:   c.headers.All(),
+	}
+
+	if !c.lastBlockReceivedAt.IsZero() {
+		snapshot.TimeSinceLastBlock = time.Since(c.lastBlockReceivedAt)
+	}
+
+	return snapshot
+}
+
+func (c *WebsocketClient) run() {
+	backoff := c.MinBackoff
+
+	for {
+		connectedAt := time.Now()
+		err := c.listen()
+
+		c.mutex.Lock()
+		c.connected = false
+		c.connection = nil
+		c.mutex.Unlock()
+
+		select {
+		case <-c.stopChannel:
+			c.Logger.Debug().Msg("Websocket client stopped")
+			return
+		default:
+		}
+
+		// the connection was alive for a while, so starting the backoff from scratch
+		if time.Since(connectedAt) > c.MaxBackoff {
+			backoff = c.MinBackoff
+		}
+
+		c.Logger.Warn().
+			Err(err).
+			Str("backoff", backoff.String()).
+			Msg("Websocket connection closed, reconnecting")
+
+		select {
+		case <-c.stopChannel:
+			c.Logger.Debug().Msg("Websocket client stopped")
+			return
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > c.MaxBackoff {
+			backoff = c.MaxBackoff
+		}
+	}
+}
+
+func (c *WebsocketClient) listen() error {
+	c.Logger.Debug().Str("url", c.URL).Msg("Connecting to websocket")
+
+	connection, _, err := c.Dialer.Dial(c.URL, c.Headers)
+	if err != nil {
+		return err
+	}
+	defer connection.Close()
+
+	c.mutex.Lock()
+	if c.stopped {
+		c.mutex.Unlock()
+		return errors.New("websocket client is stopped")
+	}
+	c.connection = connection
+	c.mutex.Unlock()
+
+	if err := connection.WriteJSON(WebsocketRequest{
+		JSONRPC: "2.0",
+		Method:  "subscribe",
+		ID:      1,
+		Params:  map[string]string{"query": "tm.event='NewBlock'"},
+	}); err != nil {
+		return err
+	}
+
+	c.mutex.Lock()
+	c.connected = true
+	c.mutex.Unlock()
+
+	c.Logger.Info().Str("url", c.URL).Msg("Subscribed to new blocks")
+
+	// without a read deadline, a half-open connection would block the read forever
+	if err := connection.SetReadDeadline(time.Now().Add(c.PongWait)); err != nil {
+		return err
+	}
+
+	connection.SetPongHandler(func(string) error {
+		return connection.SetReadDeadline(time.Now().Add(c.PongWait))
+	})
+
+	pingerDone := make(chan struct{})
+	defer close(pingerDone)
+
+	go c.ping(connection, pingerDone)
+
+	for {
+		_, message, err := connection.ReadMessage()
+		if err != nil {
+			return err
+		}
+
+		if err := connection.SetReadDeadline(time.Now().Add(c.PongWait)); err != nil {
+			return err
+		}
+
+		var event WebsocketEvent
+		if err := json.Unmarshal(message, &event); err != nil {
+			c.Logger.Warn().Err(err).Msg("Could not unmarshal websocket event")
+			continue
+		}
+
+		if event.Error != nil {
+			return errors.New(event.Error.Message)
+		}
+
+		// subscription confirmation has an empty result
+		if event.Result.Data.Type != "tendermint/event/NewBlock" {
+			continue
+		}
+
+		header := event.Result.Data.Value.Block.Header
+
+		c.Logger.Trace().Int64("height", header.Height).Msg("Got new block")
+
+		c.mutex.Lock()
+		c.addHeader(header)
+		c.mutex.Unlock()
+	}
+}
+
+// addHeader stores the new block header, skipping duplicates. A lower height means
+// the chain was restarted or the node was reset, so the stored headers are dropped,
+// as they would not describe the blocks to come.
+func (c *WebsocketClient) addHeader(header BlockHeader) {
+	latest, found := c.headers.Latest()
+	if found && latest.Height == header.Height {
+		return
+	}
+
+	if found && latest.Height > header.Height {
+		c.Logger.Warn().
+			Int64("latest_height", latest.Height).
+			Int64("height", header.Height).
+			Msg("Got a block lower than the latest one, resetting stored blocks")
+		c.headers.Reset()
+	}
+
+	c.headers.Add(header)
+	c.lastBlockReceivedAt = time.Now()
+}
+
+func (c *WebsocketClient) ping(connection *websocket.Conn, done chan struct{}) {
+	ticker := time.NewTicker(c.PingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			// WriteControl is safe to call concurrently with reading
+			if err := connection.WriteControl(
+				websocket.PingMessage,
+				nil,
+				time.Now().Add(c.PingInterval),
+			); err != nil {
+				c.Logger.Debug().Err(err).Msg("Could not send websocket ping")
+				return
+			}
+		}
+	}
+}
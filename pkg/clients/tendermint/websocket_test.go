@@ -0,0 +1,446 @@
+package tendermint
+
+import (
+	"fmt"
+	configPkg "main/pkg/config"
+	loggerPkg "main/pkg/logger"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newBlockEvent(height int64) string {
+	return fmt.Sprintf(
+		`{"jsonrpc":"2.0","id":1,"result":{"query":"tm.event='NewBlock'","data":{"type":"tendermint/event/NewBlock","value":{"block":{"header":{"height":"%d","time":"2024-06-29T17:39:%02d.000000000Z"}}}}}}`,
+		height,
+		height%60,
+	)
+}
+
+func newWebsocketServer(t *testing.T, messages []string) *httptest.Server {
+	t.Helper()
+
+	upgrader := websocket.Upgrader{}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		connection, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer connection.Close()
+
+		// waiting for the subscription request
+		if _, _, err := connection.ReadMessage(); err != nil {
+			return
+		}
+
+		for _, message := range messages {
+			if err := connection.WriteMessage(websocket.TextMessage, []byte(message)); err != nil {
+				return
+			}
+		}
+
+		// keeping the connection open until the client closes it
+		for {
+			if _, _, err := connection.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+}
+
+func TestHeadersBufferOverwritesOldest(t *testing.T) {
+	t.Parallel()
+
+	buffer := NewHeadersBuffer(3)
+	_, found := buffer.Latest()
+	assert.False(t, found)
+	assert.Empty(t, buffer.All())
+
+	for height := int64(1); height <= 5; height++ {
+		buffer.Add(BlockHeader{Height: height})
+	}
+
+	assert.Equal(t, 3, buffer.Len())
+	assert.Equal(t, []BlockHeader{{Height: 3}, {Height: 4}, {Height: 5}}, buffer.All())
+
+	latest, found := buffer.Latest()
+	assert.True(t, found)
+	assert.Equal(t, int64(5), latest.Height)
+}
+
+func TestWebsocketSnapshotLive(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, WebsocketSnapshot{}.Live())
+	assert.False(t, WebsocketSnapshot{Connected: true}.Live())
+	assert.False(t, WebsocketSnapshot{Headers: []BlockHeader{{Height: 1}}}.Live())
+	assert.True(t, WebsocketSnapshot{Connected: true, Headers: []BlockHeader{{Height: 1}}}.Live())
+}
+
+func TestWebsocketSnapshotNotEnoughHeaders(t *testing.T) {
+	t.Parallel()
+
+	snapshot := WebsocketSnapshot{Headers: []BlockHeader{{Height: 1}, {Height: 2}}}
+	blocksInfo, found := snapshot.BlocksInfo()
+	assert.False(t, found)
+	assert.Nil(t, blocksInfo)
+
+	latest, found := snapshot.LatestHeader()
+	assert.True(t, found)
+	assert.Equal(t, int64(2), latest.Height)
+
+	_, found = WebsocketSnapshot{}.LatestHeader()
+	assert.False(t, found)
+}
+
+func TestWebsocketSnapshotBlocksInfo(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, 6, 29, 0, 0, 0, 0, time.UTC)
+	headers := make([]BlockHeader, 101)
+	for index := range headers {
+		headers[index] = BlockHeader{
+			Height: int64(1000 + index),
+			Time:   start.Add(time.Duration(index) * 6 * time.Second),
+		}
+	}
+
+	snapshot := WebsocketSnapshot{Headers: headers}
+	blocksInfo, found := snapshot.BlocksInfo()
+	require.True(t, found)
+	assert.Equal(t, int64(1100), blocksInfo.NewerBlock.Result.Block.Header.Height)
+	assert.Equal(t, int64(1000), blocksInfo.OlderBlock.Result.Block.Header.Height)
+	assert.InDelta(t, 6, blocksInfo.BlockTime(), 0.01)
+}
+
+func TestWebsocketSnapshotBlocksInfoForWindow(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, 6, 29, 0, 0, 0, 0, time.UTC)
+	headers := make([]BlockHeader, 101)
+	for index := range headers {
+		headers[index] = BlockHeader{
+			Height: int64(1000 + index),
+			Time:   start.Add(time.Duration(index) * 6 * time.Second),
+		}
+	}
+
+	snapshot := WebsocketSnapshot{Headers: headers}
+	blocksInfo, found := snapshot.BlocksInfoForWindow(10)
+	require.True(t, found)
+	assert.Equal(t, int64(1100), blocksInfo.NewerBlock.Result.Block.Header.Height)
+	assert.Equal(t, int64(1090), blocksInfo.OlderBlock.Result.Block.Header.Height)
+
+	blocksInfo, found = snapshot.BlocksInfoForWindow(100)
+	require.True(t, found)
+	assert.Equal(t, int64(1000), blocksInfo.OlderBlock.Result.Block.Header.Height)
+
+	_, found = snapshot.BlocksInfoForWindow(101)
+	assert.False(t, found)
+}
+
+func TestWebsocketSnapshotBlocksInfoForWindowWithGaps(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, 6, 29, 0, 0, 0, 0, time.UTC)
+	headers := []BlockHeader{}
+
+	// blocks 1050-1079 were missed while reconnecting
+	for height := int64(1000); height <= 1100; height++ {
+		if height >= 1050 && height < 1080 {
+			continue
+		}
+
+		headers = append(headers, BlockHeader{
+			Height: height,
+			Time:   start.Add(time.Duration(height-1000) * 6 * time.Second),
+		})
+	}
+
+	snapshot := WebsocketSnapshot{Headers: headers}
+	blocksInfo, found := snapshot.BlocksInfoForWindow(50)
+	require.True(t, found)
+	assert.Equal(t, int64(1100), blocksInfo.NewerBlock.Result.Block.Header.Height)
+	assert.Equal(t, int64(1049), blocksInfo.OlderBlock.Result.Block.Header.Height)
+	assert.InDelta(t, 6, blocksInfo.BlockTime(), 0.01)
+
+	blocksInfo, found = snapshot.BlocksInfoForWindow(100)
+	require.True(t, found)
+	assert.Equal(t, int64(1000), blocksInfo.OlderBlock.Result.Block.Header.Height)
+
+	_, found = snapshot.BlocksInfoForWindow(70)
+	require.True(t, found)
+}
+
+func TestWebsocketClientBufferSize(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+
+	client := NewWebsocketClient(configPkg.TendermintConfig{Address: "http://localhost:26657"}, *logger)
+	assert.Len(t, client.headers.headers, 1001)
+
+	client = NewWebsocketClient(configPkg.TendermintConfig{
+		Address:          "http://localhost:26657",
+		BlockTimeWindows: []int64{100, 10000},
+	}, *logger)
+	assert.Len(t, client.headers.headers, 10001)
+}
+
+func TestWebsocketClientURL(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+
+	client := NewWebsocketClient(configPkg.TendermintConfig{Address: "http://localhost:26657"}, *logger)
+	assert.Equal(t, "ws://localhost:26657/websocket", client.URL)
+
+	client = NewWebsocketClient(configPkg.TendermintConfig{Address: "https://example.com/"}, *logger)
+	assert.Equal(t, "wss://example.com/websocket", client.URL)
+}
+
+func TestWebsocketClientReceivesBlocks(t *testing.T) {
+	t.Parallel()
+
+	server := newWebsocketServer(t, []string{
+		`{"jsonrpc":"2.0","id":1,"result":{}}`,
+		"invalid",
+		newBlockEvent(100),
+		newBlockEvent(101),
+		newBlockEvent(101),
+		newBlockEvent(102),
+	})
+	defer server.Close()
+
+	logger := loggerPkg.GetNopLogger()
+	client := NewWebsocketClient(configPkg.TendermintConfig{Address: server.URL}, *logger)
+	client.Start()
+	defer client.Stop()
+
+	require.Eventually(t, func() bool {
+		return len(client.Snapshot().Headers) == 3
+	}, 5*time.Second, 10*time.Millisecond)
+
+	snapshot := client.Snapshot()
+	assert.True(t, snapshot.Connected)
+	assert.Equal(t, int64(100), snapshot.Headers[0].Height)
+	assert.Equal(t, int64(102), snapshot.Headers[2].Height)
+}
+
+func TestWebsocketClientResetsOnLowerHeight(t *testing.T) {
+	t.Parallel()
+
+	server := newWebsocketServer(t, []string{
+		newBlockEvent(100),
+		newBlockEvent(101),
+		newBlockEvent(102),
+		// the chain was restarted from a lower height
+		newBlockEvent(50),
+		newBlockEvent(51),
+	})
+	defer server.Close()
+
+	logger := loggerPkg.GetNopLogger()
+	client := NewWebsocketClient(configPkg.TendermintConfig{Address: server.URL}, *logger)
+	client.Start()
+	defer client.Stop()
+
+	require.Eventually(t, func() bool {
+		latest, found := client.Snapshot().LatestHeader()
+		return found && latest.Height == 51
+	}, 5*time.Second, 10*time.Millisecond)
+
+	snapshot := client.Snapshot()
+	require.Len(t, snapshot.Headers, 2)
+	assert.Equal(t, int64(50), snapshot.Headers[0].Height)
+}
+
+func TestWebsocketClientReconnects(t *testing.T) {
+	t.Parallel()
+
+	upgrader := websocket.Upgrader{}
+	connections := make(chan struct{}, 10)
+
+	// the server closes the connection right after sending one block
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		connection, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer connection.Close()
+
+		connections <- struct{}{}
+
+		if _, _, err := connection.ReadMessage(); err != nil {
+			return
+		}
+
+		_ = connection.WriteMessage(websocket.TextMessage, []byte(newBlockEvent(int64(100+len(connections)))))
+	}))
+	defer server.Close()
+
+	logger := loggerPkg.GetNopLogger()
+	client := NewWebsocketClient(configPkg.TendermintConfig{Address: server.URL}, *logger)
+	client.MinBackoff = 10 * time.Millisecond
+	client.MaxBackoff = 20 * time.Millisecond
+	client.Start()
+	defer client.Stop()
+
+	require.Eventually(t, func() bool {
+		return len(connections) >= 2
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestWebsocketClientSubscriptionError(t *testing.T) {
+	t.Parallel()
+
+	server := newWebsocketServer(t, []string{
+		`{"jsonrpc":"2.0","id":1,"error":{"code":-32603,"message":"subscription limit reached"}}`,
+	})
+	defer server.Close()
+
+	logger := loggerPkg.GetNopLogger()
+	client := NewWebsocketClient(configPkg.TendermintConfig{Address: server.URL}, *logger)
+
+	err := client.listen()
+	require.Error(t, err)
+	require.ErrorContains(t, err, "subscription limit reached")
+}
+
+func TestWebsocketClientDialError(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	client := NewWebsocketClient(configPkg.TendermintConfig{Address: "http://localhost:1"}, *logger)
+
+	err := client.listen()
+	require.Error(t, err)
+	assert.False(t, client.Snapshot().Connected)
+}
+
+func TestWebsocketClientStop(t *testing.T) {
+	t.Parallel()
+
+	server := newWebsocketServer(t, []string{newBlockEvent(100)})
+	defer server.Close()
+
+	logger := loggerPkg.GetNopLogger()
+	client := NewWebsocketClient(configPkg.TendermintConfig{Address: server.URL}, *logger)
+	client.Start()
+
+	require.Eventually(t, func() bool {
+		return client.Snapshot().Connected
+	}, 5*time.Second, 10*time.Millisecond)
+
+	client.Stop()
+	client.Stop()
+
+	require.Eventually(t, func() bool {
+		return !client.Snapshot().Connected
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestWebsocketClientSendsHeaders(t *testing.T) {
+	t.Parallel()
+
+	upgrader := websocket.Upgrader{}
+	requests := make(chan *http.Request, 1)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests <- r
+
+		connection, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer connection.Close()
+	}))
+	defer server.Close()
+
+	logger := loggerPkg.GetNopLogger()
+	client := NewWebsocketClient(configPkg.TendermintConfig{
+		Address: server.URL,
+		ClientConfig: configPkg.ClientConfig{
+			Headers:   map[string]string{"X-Api-Key": "key"},
+			BasicAuth: configPkg.BasicAuthConfig{Username: "user", Password: "pass"},
+		},
+	}, *logger)
+
+	_ = client.listen()
+
+	request := <-requests
+	assert.Equal(t, "key", request.Header.Get("X-Api-Key"))
+
+	username, password, ok := request.BasicAuth()
+	require.True(t, ok)
+	assert.Equal(t, "user", username)
+	assert.Equal(t, "pass", password)
+}
+
+func TestWebsocketClientReconnectsOnHalfOpenConnection(t *testing.T) {
+	t.Parallel()
+
+	upgrader := websocket.Upgrader{}
+	connections := make(chan struct{}, 10)
+	release := make(chan struct{})
+
+	// the server stops reading after the subscription, so pings are never answered
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		connection, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer connection.Close()
+
+		connections <- struct{}{}
+
+		if _, _, err := connection.ReadMessage(); err != nil {
+			return
+		}
+
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	logger := loggerPkg.GetNopLogger()
+	client := NewWebsocketClient(configPkg.TendermintConfig{Address: server.URL}, *logger)
+	client.MinBackoff = 10 * time.Millisecond
+	client.MaxBackoff = 20 * time.Millisecond
+	client.PingInterval = 20 * time.Millisecond
+	client.PongWait = 100 * time.Millisecond
+	client.Start()
+	defer client.Stop()
+
+	require.Eventually(t, func() bool {
+		return len(connections) >= 2
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestWebsocketClientPongsKeepConnectionAlive(t *testing.T) {
+	t.Parallel()
+
+	server := newWebsocketServer(t, []string{newBlockEvent(100)})
+	defer server.Close()
+
+	logger := loggerPkg.GetNopLogger()
+	client := NewWebsocketClient(configPkg.TendermintConfig{Address: server.URL}, *logger)
+	client.PingInterval = 20 * time.Millisecond
+	client.PongWait = 100 * time.Millisecond
+	client.Start()
+	defer client.Stop()
+
+	require.Eventually(t, func() bool {
+		return client.Snapshot().Live()
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// no blocks are coming, but the server answers pings, so the connection stays
+	time.Sleep(300 * time.Millisecond)
+	assert.True(t, client.Snapshot().Live())
+}
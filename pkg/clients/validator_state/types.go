@@ -0,0 +1,9 @@
+package validator_state
+
+// PrivValidatorState is the last height, round and step the validator key signed at,
+// as written to data/priv_validator_state.json by CometBFT.
+type PrivValidatorState struct {
+	Height int64 `json:"height,string"`
+	Round  int32 `json:"round"`
+	Step   int8  `json:"step"`
+}
@@ -0,0 +1,74 @@
+package validator_state
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"main/pkg/constants"
+	"main/pkg/fs"
+	"main/pkg/query_info"
+	"os"
+	"path"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/rs/zerolog"
+)
+
+// Client reads the signing state of the validator key from <chain-folder>/data.
+type Client struct {
+	ChainFolder string
+	Filesystem  fs.FS
+	Logger      zerolog.Logger
+	Tracer      trace.Tracer
+}
+
+func NewClient(chainFolder string, logger zerolog.Logger, tracer trace.Tracer) *Client {
+	return &Client{
+		ChainFolder: chainFolder,
+		Filesystem:  &fs.OsFS{},
+		Logger:      logger.With().Str("component", "validator_state").Logger(),
+		Tracer:      tracer,
+	}
+}
+
+// GetState returns nil without an error if the state file is not present,
+// like on a node that was never run or which key is stored remotely.
+func (c *Client) GetState(ctx context.Context) (*PrivValidatorState, query_info.QueryInfo, error) {
+	queryInfo := query_info.QueryInfo{
+		Module:  constants.ModuleValidatorState,
+		Action:  constants.ActionValidatorStateGetState,
+		Success: false,
+	}
+
+	statePath := path.Join(c.ChainFolder, "data", "priv_validator_state.json")
+
+	_, span := c.Tracer.Start(
+		ctx,
+		"Reading priv_validator_state.json",
+		trace.WithAttributes(attribute.String("path", statePath)),
+	)
+	defer span.End()
+
+	content, err := c.Filesystem.ReadFile(statePath)
+	if errors.Is(err, os.ErrNotExist) {
+		c.Logger.Trace().Str("path", statePath).Msg("Validator state file is not present")
+		queryInfo.Success = true
+		return nil, queryInfo, nil
+	} else if err != nil {
+		c.Logger.Error().Err(err).Str("path", statePath).Msg("Could not read validator state file")
+		span.RecordError(err)
+		return nil, queryInfo, err
+	}
+
+	state := &PrivValidatorState{}
+	if err := json.Unmarshal(content, state); err != nil {
+		c.Logger.Error().Err(err).Str("path", statePath).Msg("Could not parse validator state file")
+		span.RecordError(err)
+		return nil, queryInfo, err
+	}
+
+	queryInfo.Success = true
+	return state, queryInfo, nil
+}
@@ -0,0 +1,74 @@
+package validator_state
+
+import (
+	"context"
+	"main/pkg/fs"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/tracing"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatorStateGetStateNotFound(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewClient(t.TempDir(), *logger, tracer)
+
+	state, queryInfo, err := client.GetState(context.Background())
+	require.NoError(t, err)
+	assert.True(t, queryInfo.Success)
+	assert.Nil(t, state)
+}
+
+func TestValidatorStateGetStateReadError(t *testing.T) {
+	t.Parallel()
+
+	chainFolder := t.TempDir()
+	// a directory in place of the file cannot be read
+	require.NoError(t, os.MkdirAll(chainFolder+"/data/priv_validator_state.json", 0o755))
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewClient(chainFolder, *logger, tracer)
+
+	state, queryInfo, err := client.GetState(context.Background())
+	require.Error(t, err)
+	assert.False(t, queryInfo.Success)
+	assert.Nil(t, state)
+}
+
+func TestValidatorStateGetStateInvalid(t *testing.T) {
+	t.Parallel()
+
+	chainFolder := t.TempDir()
+	require.NoError(t, os.MkdirAll(chainFolder+"/data", 0o755))
+	require.NoError(t, os.WriteFile(chainFolder+"/data/priv_validator_state.json", []byte("{\"height\":\"abc\"}"), 0o600))
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewClient(chainFolder, *logger, tracer)
+
+	state, queryInfo, err := client.GetState(context.Background())
+	require.Error(t, err)
+	assert.False(t, queryInfo.Success)
+	assert.Nil(t, state)
+}
+
+func TestValidatorStateGetStateOk(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewClient("node-home", *logger, tracer)
+	client.Filesystem = &fs.TestFS{}
+
+	state, queryInfo, err := client.GetState(context.Background())
+	require.NoError(t, err)
+	assert.True(t, queryInfo.Success)
+	assert.Equal(t, &PrivValidatorState{Height: 19823456, Round: 0, Step: 3}, state)
+}
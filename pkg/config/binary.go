@@ -0,0 +1,26 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+type BinaryConfig struct {
+	Path string   `default:"" toml:"path"`
+	Home string   `default:"" toml:"home"`
+	Env  []string `toml:"env"`
+}
+
+func (c *BinaryConfig) Validate() error {
+	if c.Path == "" {
+		return nil
+	}
+
+	for _, env := range c.Env {
+		if key, _, found := strings.Cut(env, "="); !found || key == "" {
+			return fmt.Errorf("env variable %s should be in KEY=VALUE format", env)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBinaryDisabled(t *testing.T) {
+	t.Parallel()
+
+	binaryConfig := BinaryConfig{Env: []string{"invalid"}}
+	err := binaryConfig.Validate()
+	require.NoError(t, err)
+}
+
+func TestBinaryInvalidEnv(t *testing.T) {
+	t.Parallel()
+
+	binaryConfig := BinaryConfig{Path: "/usr/bin/gaiad", Env: []string{"=value"}}
+	err := binaryConfig.Validate()
+	require.Error(t, err)
+
+	binaryConfig = BinaryConfig{Path: "/usr/bin/gaiad", Env: []string{"KEY"}}
+	err = binaryConfig.Validate()
+	require.Error(t, err)
+}
+
+func TestBinaryValid(t *testing.T) {
+	t.Parallel()
+
+	binaryConfig := BinaryConfig{
+		Path: "/usr/bin/gaiad",
+		Home: "/home/validator/.gaia",
+		Env:  []string{"LD_LIBRARY_PATH=/usr/local/lib"},
+	}
+	err := binaryConfig.Validate()
+	require.NoError(t, err)
+}
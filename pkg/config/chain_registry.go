@@ -0,0 +1,27 @@
+package config
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+)
+
+type ChainRegistryConfig struct {
+	Path string `default:"" toml:"path"`
+}
+
+func (c *ChainRegistryConfig) IsRemote() bool {
+	return strings.HasPrefix(c.Path, "http://") || strings.HasPrefix(c.Path, "https://")
+}
+
+func (c *ChainRegistryConfig) Validate() error {
+	if c.Path == "" || !c.IsRemote() {
+		return nil
+	}
+
+	if _, err := url.ParseRequestURI(c.Path); err != nil {
+		return errors.New("path is not a valid URL")
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChainRegistryDisabled(t *testing.T) {
+	t.Parallel()
+
+	chainRegistryConfig := ChainRegistryConfig{}
+	err := chainRegistryConfig.Validate()
+	require.NoError(t, err)
+}
+
+func TestChainRegistryInvalidUrl(t *testing.T) {
+	t.Parallel()
+
+	chainRegistryConfig := ChainRegistryConfig{Path: "https://exa mple.com/chain.json"}
+	err := chainRegistryConfig.Validate()
+	require.Error(t, err)
+}
+
+func TestChainRegistryValidUrl(t *testing.T) {
+	t.Parallel()
+
+	chainRegistryConfig := ChainRegistryConfig{
+		Path: "https://raw.githubusercontent.com/cosmos/chain-registry/master/cosmoshub/chain.json",
+	}
+	err := chainRegistryConfig.Validate()
+	require.NoError(t, err)
+	assert.True(t, chainRegistryConfig.IsRemote())
+}
+
+func TestChainRegistryValidLocalPath(t *testing.T) {
+	t.Parallel()
+
+	chainRegistryConfig := ChainRegistryConfig{Path: "/home/validator/chain-registry/cosmoshub/chain.json"}
+	err := chainRegistryConfig.Validate()
+	require.NoError(t, err)
+	assert.False(t, chainRegistryConfig.IsRemote())
+}
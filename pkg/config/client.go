@@ -0,0 +1,81 @@
+package config
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+
+	"gopkg.in/guregu/null.v4"
+)
+
+type BasicAuthConfig struct {
+	Username string `toml:"username"`
+	Password string `toml:"password"`
+}
+
+// ClientConfig holds the settings shared by the RPC and gRPC clients
+// for the endpoints sitting behind a proxy requiring auth or TLS.
+type ClientConfig struct {
+	Headers            map[string]string `toml:"headers"`
+	BasicAuth          BasicAuthConfig   `toml:"basic-auth"`
+	TLSCA              string            `toml:"tls-ca"`
+	TLSCert            string            `toml:"tls-cert"`
+	TLSKey             string            `toml:"tls-key"`
+	InsecureSkipVerify null.Bool         `default:"false" toml:"insecure-skip-verify"`
+}
+
+func (c *ClientConfig) Validate() error {
+	if (c.TLSCert == "") != (c.TLSKey == "") {
+		return errors.New("tls-cert and tls-key should be either both set or both omitted")
+	}
+
+	if c.BasicAuth.Password != "" && c.BasicAuth.Username == "" {
+		return errors.New("basic-auth password is set without username")
+	}
+
+	return nil
+}
+
+func (c *ClientConfig) HasTLSConfig() bool {
+	return c.TLSCA != "" || c.TLSCert != "" || c.InsecureSkipVerify.Bool
+}
+
+// GetTLSConfig builds the TLS config out of the CA and client certificates,
+// returning nil if none of the TLS settings are specified.
+func (c *ClientConfig) GetTLSConfig() (*tls.Config, error) {
+	if !c.HasTLSConfig() {
+		return nil, nil //nolint:nilnil // no custom TLS config is not an error
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: c.InsecureSkipVerify.Bool,
+	}
+
+	if c.TLSCA != "" {
+		caBytes, err := os.ReadFile(c.TLSCA)
+		if err != nil {
+			return nil, fmt.Errorf("could not read TLS CA: %s", err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caBytes) {
+			return nil, errors.New("could not parse TLS CA")
+		}
+
+		tlsConfig.RootCAs = pool
+	}
+
+	if c.TLSCert != "" {
+		certificate, err := tls.LoadX509KeyPair(c.TLSCert, c.TLSKey)
+		if err != nil {
+			return nil, fmt.Errorf("could not load TLS client certificate: %s", err)
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	return tlsConfig, nil
+}
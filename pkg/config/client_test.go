@@ -0,0 +1,145 @@
+package config
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+func writeTestCertificate(t *testing.T) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "cosmos-node-exporter"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+
+	certBytes, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	keyBytes, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	certPath := filepath.Join(dir, "cert.pem")
+	keyPath := filepath.Join(dir, "key.pem")
+
+	err = os.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certBytes}), 0o600)
+	require.NoError(t, err)
+
+	err = os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyBytes}), 0o600)
+	require.NoError(t, err)
+
+	return certPath, keyPath
+}
+
+func TestClientConfigCertWithoutKey(t *testing.T) {
+	t.Parallel()
+
+	clientConfig := ClientConfig{TLSCert: "cert.pem"}
+	err := clientConfig.Validate()
+	require.Error(t, err)
+}
+
+func TestClientConfigPasswordWithoutUsername(t *testing.T) {
+	t.Parallel()
+
+	clientConfig := ClientConfig{BasicAuth: BasicAuthConfig{Password: "password"}}
+	err := clientConfig.Validate()
+	require.Error(t, err)
+}
+
+func TestClientConfigValid(t *testing.T) {
+	t.Parallel()
+
+	clientConfig := ClientConfig{
+		BasicAuth: BasicAuthConfig{Username: "user", Password: "password"},
+		TLSCert:   "cert.pem",
+		TLSKey:    "key.pem",
+	}
+	err := clientConfig.Validate()
+	require.NoError(t, err)
+}
+
+func TestClientConfigNoTLSConfig(t *testing.T) {
+	t.Parallel()
+
+	clientConfig := ClientConfig{}
+	tlsConfig, err := clientConfig.GetTLSConfig()
+	require.NoError(t, err)
+	assert.Nil(t, tlsConfig)
+}
+
+func TestClientConfigInsecureSkipVerify(t *testing.T) {
+	t.Parallel()
+
+	clientConfig := ClientConfig{InsecureSkipVerify: null.BoolFrom(true)}
+	tlsConfig, err := clientConfig.GetTLSConfig()
+	require.NoError(t, err)
+	require.NotNil(t, tlsConfig)
+	assert.True(t, tlsConfig.InsecureSkipVerify)
+}
+
+func TestClientConfigCANotFound(t *testing.T) {
+	t.Parallel()
+
+	clientConfig := ClientConfig{TLSCA: "not-found.pem"}
+	_, err := clientConfig.GetTLSConfig()
+	require.Error(t, err)
+	require.ErrorContains(t, err, "could not read TLS CA")
+}
+
+func TestClientConfigCAInvalid(t *testing.T) {
+	t.Parallel()
+
+	_, keyPath := writeTestCertificate(t)
+
+	clientConfig := ClientConfig{TLSCA: keyPath}
+	_, err := clientConfig.GetTLSConfig()
+	require.Error(t, err)
+	require.ErrorContains(t, err, "could not parse TLS CA")
+}
+
+func TestClientConfigCertInvalid(t *testing.T) {
+	t.Parallel()
+
+	certPath, _ := writeTestCertificate(t)
+
+	clientConfig := ClientConfig{TLSCert: certPath, TLSKey: certPath}
+	_, err := clientConfig.GetTLSConfig()
+	require.Error(t, err)
+	require.ErrorContains(t, err, "could not load TLS client certificate")
+}
+
+func TestClientConfigTLSValid(t *testing.T) {
+	t.Parallel()
+
+	certPath, keyPath := writeTestCertificate(t)
+
+	clientConfig := ClientConfig{TLSCA: certPath, TLSCert: certPath, TLSKey: keyPath}
+	tlsConfig, err := clientConfig.GetTLSConfig()
+	require.NoError(t, err)
+	require.NotNil(t, tlsConfig)
+	assert.NotNil(t, tlsConfig.RootCAs)
+	assert.Len(t, tlsConfig.Certificates, 1)
+	assert.False(t, tlsConfig.InsecureSkipVerify)
+}
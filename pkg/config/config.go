@@ -16,17 +16,6 @@ type LogConfig struct {
 	JSONOutput null.Bool `default:"false" toml:"json"`
 }
 
-type TendermintConfig struct {
-	Enabled       null.Bool `default:"true"                   toml:"enabled"`
-	Address       string    `default:"http://localhost:26657" toml:"address"`
-	QueryUpgrades null.Bool `default:"true"                   toml:"query-upgrades"`
-}
-
-type GrpcConfig struct {
-	Enabled null.Bool `default:"true"           toml:"enabled"`
-	Address string    `default:"localhost:9090" toml:"address"`
-}
-
 type Config struct {
 	LogConfig     LogConfig     `toml:"log"`
 	TracingConfig TracingConfig `toml:"tracing"`
@@ -52,6 +41,16 @@ func (c *Config) Validate() error {
 	return nil
 }
 
+func (c *Config) GetNodeConfig(name string) (NodeConfig, bool) {
+	for _, nodeConfig := range c.NodeConfigs {
+		if nodeConfig.Name == name {
+			return nodeConfig, true
+		}
+	}
+
+	return NodeConfig{}, false
+}
+
 func GetConfig(filesystem fs.FS, path string) (*Config, error) {
 	configBytes, err := filesystem.ReadFile(path)
 	if err != nil {
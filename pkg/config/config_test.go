@@ -74,3 +74,18 @@ func TestGetConfigValid(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, config)
 }
+
+func TestConfigGetNodeConfig(t *testing.T) {
+	t.Parallel()
+
+	appConfig := Config{
+		NodeConfigs: []NodeConfig{{Name: "first"}, {Name: "second"}},
+	}
+
+	nodeConfig, found := appConfig.GetNodeConfig("second")
+	require.True(t, found)
+	require.Equal(t, "second", nodeConfig.Name)
+
+	_, found = appConfig.GetNodeConfig("third")
+	require.False(t, found)
+}
@@ -7,10 +7,11 @@ import (
 )
 
 type CosmovisorConfig struct {
-	Enabled         null.Bool `default:"true"           toml:"enabled"`
-	ChainBinaryName string    `toml:"chain-binary-name"`
-	ChainFolder     string    `toml:"chain-folder"`
-	CosmovisorPath  string    `toml:"cosmovisor-path"`
+	Enabled           null.Bool `default:"true"           toml:"enabled"`
+	ChainBinaryName   string    `toml:"chain-binary-name"`
+	ChainFolder       string    `toml:"chain-folder"`
+	CosmovisorPath    string    `toml:"cosmovisor-path"`
+	SmokeTestUpgrades null.Bool `default:"false"          toml:"smoke-test-upgrades"`
 }
 
 func (c *CosmovisorConfig) Validate() error {
@@ -0,0 +1,30 @@
+package config
+
+import "gopkg.in/guregu/null.v4"
+
+type GrpcConfig struct {
+	ClientConfig
+
+	Enabled           null.Bool `default:"true"           toml:"enabled"`
+	Address           string    `default:"localhost:9090" toml:"address"`
+	FallbackAddresses []string  `toml:"fallback-addresses"`
+	TLS               null.Bool `default:"false"          toml:"tls"`
+}
+
+func (c *GrpcConfig) Addresses() []string {
+	return append([]string{c.Address}, c.FallbackAddresses...)
+}
+
+func (c *GrpcConfig) Validate() error {
+	if !c.Enabled.Bool {
+		return nil
+	}
+
+	return c.ClientConfig.Validate()
+}
+
+// UseTLS returns whether the gRPC connection should be secure, either because
+// it was explicitly asked for or because some TLS settings were provided.
+func (c *GrpcConfig) UseTLS() bool {
+	return c.TLS.Bool || c.HasTLSConfig()
+}
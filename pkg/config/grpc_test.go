@@ -0,0 +1,36 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestGrpcDisabled(t *testing.T) {
+	t.Parallel()
+
+	grpcConfig := GrpcConfig{ClientConfig: ClientConfig{TLSCert: "cert.pem"}}
+	err := grpcConfig.Validate()
+	require.NoError(t, err)
+}
+
+func TestGrpcInvalidClientConfig(t *testing.T) {
+	t.Parallel()
+
+	grpcConfig := GrpcConfig{
+		Enabled:      null.BoolFrom(true),
+		ClientConfig: ClientConfig{TLSCert: "cert.pem"},
+	}
+	err := grpcConfig.Validate()
+	require.Error(t, err)
+}
+
+func TestGrpcUseTLS(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, (&GrpcConfig{}).UseTLS())
+	assert.True(t, (&GrpcConfig{TLS: null.BoolFrom(true)}).UseTLS())
+	assert.True(t, (&GrpcConfig{ClientConfig: ClientConfig{TLSCA: "ca.pem"}}).UseTLS())
+}
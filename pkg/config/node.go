@@ -3,14 +3,21 @@ package config
 import (
 	"errors"
 	"fmt"
+	"main/pkg/constants"
+	"slices"
+	"strings"
 )
 
 type NodeConfig struct {
-	Name             string           `toml:"name"`
-	TendermintConfig TendermintConfig `toml:"tendermint"`
-	CosmovisorConfig CosmovisorConfig `toml:"cosmovisor"`
-	GrpcConfig       GrpcConfig       `toml:"grpc"`
-	GitConfig        GitConfig        `toml:"git"`
+	Name                string              `toml:"name"`
+	Role                string              `toml:"role"`
+	TendermintConfig    TendermintConfig    `toml:"tendermint"`
+	CosmovisorConfig    CosmovisorConfig    `toml:"cosmovisor"`
+	BinaryConfig        BinaryConfig        `toml:"binary"`
+	GrpcConfig          GrpcConfig          `toml:"grpc"`
+	GitConfig           GitConfig           `toml:"git"`
+	NtpConfig           NtpConfig           `toml:"ntp"`
+	ChainRegistryConfig ChainRegistryConfig `toml:"chain-registry"`
 }
 
 func (c *NodeConfig) Validate() error {
@@ -18,6 +25,22 @@ func (c *NodeConfig) Validate() error {
 		return errors.New("node name is empty")
 	}
 
+	if c.Role != "" && !slices.Contains(constants.NodeRoles, c.Role) {
+		return fmt.Errorf(
+			"node role should be one of %s, got %s",
+			strings.Join(constants.NodeRoles, ", "),
+			c.Role,
+		)
+	}
+
+	if err := c.TendermintConfig.Validate(); err != nil {
+		return fmt.Errorf("Tendermint config is invalid: %s", err)
+	}
+
+	if err := c.GrpcConfig.Validate(); err != nil {
+		return fmt.Errorf("gRPC config is invalid: %s", err)
+	}
+
 	if err := c.GitConfig.Validate(); err != nil {
 		return fmt.Errorf("GitHub config is invalid: %s", err)
 	}
@@ -26,5 +49,27 @@ func (c *NodeConfig) Validate() error {
 		return fmt.Errorf("Cosmovisor config is invalid: %s", err)
 	}
 
+	if err := c.BinaryConfig.Validate(); err != nil {
+		return fmt.Errorf("binary config is invalid: %s", err)
+	}
+
+	if err := c.NtpConfig.Validate(); err != nil {
+		return fmt.Errorf("NTP config is invalid: %s", err)
+	}
+
+	if err := c.ChainRegistryConfig.Validate(); err != nil {
+		return fmt.Errorf("chain-registry config is invalid: %s", err)
+	}
+
 	return nil
 }
+
+// GetChainFolder returns the node home folder, which is known either
+// from the Cosmovisor config or from the binary one.
+func (c *NodeConfig) GetChainFolder() string {
+	if c.CosmovisorConfig.Enabled.Bool {
+		return c.CosmovisorConfig.ChainFolder
+	}
+
+	return c.BinaryConfig.Home
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/guregu/null.v4"
 )
@@ -15,6 +16,14 @@ func TestNodeNoName(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestNodeInvalidRole(t *testing.T) {
+	t.Parallel()
+
+	nodeConfig := NodeConfig{Name: "node", Role: "invalid"}
+	err := nodeConfig.Validate()
+	require.Error(t, err)
+}
+
 func TestNodeInvalidGitConfig(t *testing.T) {
 	t.Parallel()
 
@@ -31,10 +40,58 @@ func TestNodeInvalidCosmovisorConfig(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestNodeInvalidBinaryConfig(t *testing.T) {
+	t.Parallel()
+
+	nodeConfig := NodeConfig{Name: "node", BinaryConfig: BinaryConfig{Path: "/usr/bin/gaiad", Env: []string{"invalid"}}}
+	err := nodeConfig.Validate()
+	require.Error(t, err)
+}
+
+func TestNodeInvalidNtpConfig(t *testing.T) {
+	t.Parallel()
+
+	nodeConfig := NodeConfig{Name: "node", NtpConfig: NtpConfig{Address: "invalid"}}
+	err := nodeConfig.Validate()
+	require.Error(t, err)
+}
+
+func TestNodeInvalidChainRegistryConfig(t *testing.T) {
+	t.Parallel()
+
+	nodeConfig := NodeConfig{
+		Name:                "node",
+		ChainRegistryConfig: ChainRegistryConfig{Path: "https://exa mple.com/chain.json"},
+	}
+	err := nodeConfig.Validate()
+	require.Error(t, err)
+}
+
 func TestNodeValid(t *testing.T) {
 	t.Parallel()
 
 	nodeConfig := NodeConfig{Name: "node"}
 	err := nodeConfig.Validate()
 	require.NoError(t, err)
+
+	nodeConfig.Role = "validator"
+	err = nodeConfig.Validate()
+	require.NoError(t, err)
+}
+
+func TestNodeGetChainFolder(t *testing.T) {
+	t.Parallel()
+
+	nodeConfig := NodeConfig{
+		Name:             "node",
+		CosmovisorConfig: CosmovisorConfig{Enabled: null.BoolFrom(true), ChainFolder: "/home/validator/.gaia"},
+		BinaryConfig:     BinaryConfig{Path: "/usr/bin/gaiad", Home: "/home/validator/.gaia-binary"},
+	}
+	assert.Equal(t, "/home/validator/.gaia", nodeConfig.GetChainFolder())
+
+	nodeConfig.CosmovisorConfig.Enabled = null.BoolFrom(false)
+	assert.Equal(t, "/home/validator/.gaia-binary", nodeConfig.GetChainFolder())
+
+	nodeConfig.BinaryConfig = BinaryConfig{}
+	assert.Empty(t, nodeConfig.GetChainFolder())
 }
@@ -0,0 +1,22 @@
+package config
+
+import (
+	"fmt"
+	"net"
+)
+
+type NtpConfig struct {
+	Address string `default:"" toml:"address"`
+}
+
+func (c *NtpConfig) Validate() error {
+	if c.Address == "" {
+		return nil
+	}
+
+	if _, _, err := net.SplitHostPort(c.Address); err != nil {
+		return fmt.Errorf("address is not valid: %s", err)
+	}
+
+	return nil
+}
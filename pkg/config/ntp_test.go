@@ -0,0 +1,31 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNtpDisabled(t *testing.T) {
+	t.Parallel()
+
+	ntpConfig := NtpConfig{}
+	err := ntpConfig.Validate()
+	require.NoError(t, err)
+}
+
+func TestNtpInvalid(t *testing.T) {
+	t.Parallel()
+
+	ntpConfig := NtpConfig{Address: "pool.ntp.org"}
+	err := ntpConfig.Validate()
+	require.Error(t, err)
+}
+
+func TestNtpValid(t *testing.T) {
+	t.Parallel()
+
+	ntpConfig := NtpConfig{Address: "pool.ntp.org:123"}
+	err := ntpConfig.Validate()
+	require.NoError(t, err)
+}
@@ -0,0 +1,67 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"gopkg.in/guregu/null.v4"
+)
+
+type TendermintConfig struct {
+	ClientConfig
+
+	Enabled                null.Bool `default:"true"                   toml:"enabled"`
+	Address                string    `default:"http://localhost:26657" toml:"address"`
+	QueryUpgrades          null.Bool `default:"true"                   toml:"query-upgrades"`
+	FallbackAddresses      []string  `toml:"fallback-addresses"`
+	ReferenceAddresses     []string  `toml:"reference-addresses"`
+	Websocket              null.Bool `default:"false"                  toml:"websocket"`
+	BlockTimeWindows       []int64   `default:"[1000]"                 toml:"block-time-windows"`
+	UpgradeBlockTimeWindow int64     `toml:"upgrade-block-time-window"`
+	QueryValidators        null.Bool `default:"false"                  toml:"query-validators"`
+	QueryUpgradeProposals  null.Bool `default:"false"                  toml:"query-upgrade-proposals"`
+}
+
+func (c *TendermintConfig) Addresses() []string {
+	return append([]string{c.Address}, c.FallbackAddresses...)
+}
+
+func (c *TendermintConfig) Validate() error {
+	if !c.Enabled.Bool {
+		return nil
+	}
+
+	if err := c.ClientConfig.Validate(); err != nil {
+		return err
+	}
+
+	if len(c.BlockTimeWindows) == 0 {
+		return errors.New("block-time-windows should not be empty")
+	}
+
+	for _, window := range c.BlockTimeWindows {
+		if window <= 0 {
+			return fmt.Errorf("block time window should be positive, got %d", window)
+		}
+	}
+
+	if c.UpgradeBlockTimeWindow != 0 && !slices.Contains(c.BlockTimeWindows, c.UpgradeBlockTimeWindow) {
+		return fmt.Errorf(
+			"upgrade-block-time-window %d is not one of block-time-windows",
+			c.UpgradeBlockTimeWindow,
+		)
+	}
+
+	return nil
+}
+
+// GetUpgradeBlockTimeWindow returns the window used for estimating upgrade time,
+// which is the largest one unless specified explicitly.
+func (c *TendermintConfig) GetUpgradeBlockTimeWindow() int64 {
+	if c.UpgradeBlockTimeWindow != 0 || len(c.BlockTimeWindows) == 0 {
+		return c.UpgradeBlockTimeWindow
+	}
+
+	return slices.Max(c.BlockTimeWindows)
+}
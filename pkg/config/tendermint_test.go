@@ -0,0 +1,90 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestTendermintDisabled(t *testing.T) {
+	t.Parallel()
+
+	tendermintConfig := TendermintConfig{Enabled: null.BoolFrom(false)}
+	err := tendermintConfig.Validate()
+	require.NoError(t, err)
+}
+
+func TestTendermintNoBlockTimeWindows(t *testing.T) {
+	t.Parallel()
+
+	tendermintConfig := TendermintConfig{Enabled: null.BoolFrom(true)}
+	err := tendermintConfig.Validate()
+	require.Error(t, err)
+}
+
+func TestTendermintInvalidBlockTimeWindow(t *testing.T) {
+	t.Parallel()
+
+	tendermintConfig := TendermintConfig{
+		Enabled:          null.BoolFrom(true),
+		BlockTimeWindows: []int64{100, 0},
+	}
+	err := tendermintConfig.Validate()
+	require.Error(t, err)
+}
+
+func TestTendermintInvalidUpgradeBlockTimeWindow(t *testing.T) {
+	t.Parallel()
+
+	tendermintConfig := TendermintConfig{
+		Enabled:                null.BoolFrom(true),
+		BlockTimeWindows:       []int64{100, 1000},
+		UpgradeBlockTimeWindow: 10000,
+	}
+	err := tendermintConfig.Validate()
+	require.Error(t, err)
+}
+
+func TestTendermintValid(t *testing.T) {
+	t.Parallel()
+
+	tendermintConfig := TendermintConfig{
+		Enabled:                null.BoolFrom(true),
+		BlockTimeWindows:       []int64{100, 1000},
+		UpgradeBlockTimeWindow: 100,
+	}
+	err := tendermintConfig.Validate()
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), tendermintConfig.GetUpgradeBlockTimeWindow())
+}
+
+func TestTendermintUpgradeBlockTimeWindowDefault(t *testing.T) {
+	t.Parallel()
+
+	tendermintConfig := TendermintConfig{
+		Enabled:          null.BoolFrom(true),
+		BlockTimeWindows: []int64{100, 10000, 1000},
+	}
+	assert.Equal(t, int64(10000), tendermintConfig.GetUpgradeBlockTimeWindow())
+}
+
+func TestTendermintUpgradeBlockTimeWindowNoWindows(t *testing.T) {
+	t.Parallel()
+
+	tendermintConfig := TendermintConfig{}
+	assert.Zero(t, tendermintConfig.GetUpgradeBlockTimeWindow())
+}
+
+func TestTendermintInvalidClientConfig(t *testing.T) {
+	t.Parallel()
+
+	tendermintConfig := TendermintConfig{
+		Enabled:          null.BoolFrom(true),
+		BlockTimeWindows: []int64{1000},
+		ClientConfig:     ClientConfig{TLSKey: "key.pem"},
+	}
+	err := tendermintConfig.Validate()
+	require.Error(t, err)
+}
@@ -0,0 +1,148 @@
+package config_lint
+
+import (
+	"context"
+	"errors"
+	"main/pkg/clients/config_files"
+	"main/pkg/constants"
+	"net"
+	"slices"
+	"strings"
+)
+
+// Rule is a check for a risky node setting. Check returns whether the setting is risky
+// for a node with the given role, and whether it could be checked at all, as each of
+// the config files it needs might be missing.
+type Rule struct {
+	Name        string
+	Severity    string
+	Description string
+	Check       func(files *config_files.NodeConfigFiles, role string) (bool, bool)
+}
+
+type Result struct {
+	Rule        string
+	Severity    string
+	Description string
+	Violated    bool
+}
+
+var Rules = []Rule{
+	{
+		Name:        "rpc-public-on-validator",
+		Severity:    constants.ConfigLintSeverityCritical,
+		Description: "RPC laddr in config.toml listens on all interfaces on a validator",
+		Check: func(files *config_files.NodeConfigFiles, role string) (bool, bool) {
+			if files.Config == nil {
+				return false, false
+			}
+
+			return role == constants.NodeRoleValidator && IsPublicAddress(files.Config.RPC.ListenAddress), true
+		},
+	},
+	{
+		Name:        "double-sign-check-disabled",
+		Severity:    constants.ConfigLintSeverityWarning,
+		Description: "double_sign_check_height in config.toml is 0 on a validator",
+		Check: func(files *config_files.NodeConfigFiles, role string) (bool, bool) {
+			if files.Config == nil {
+				return false, false
+			}
+
+			return role == constants.NodeRoleValidator && files.Config.Consensus.DoubleSignCheckHeight == 0, true
+		},
+	},
+	{
+		Name:        "rpc-cors-wildcard",
+		Severity:    constants.ConfigLintSeverityWarning,
+		Description: "cors_allowed_origins in config.toml allows any origin",
+		Check: func(files *config_files.NodeConfigFiles, _ string) (bool, bool) {
+			if files.Config == nil {
+				return false, false
+			}
+
+			return slices.Contains(files.Config.RPC.CORSAllowedOrigins, "*"), true
+		},
+	},
+	{
+		Name:        "pruning-nothing-not-archive",
+		Severity:    constants.ConfigLintSeverityWarning,
+		Description: "pruning in app.toml is \"nothing\" on a node that is not an archive one",
+		Check: func(files *config_files.NodeConfigFiles, role string) (bool, bool) {
+			if files.App == nil {
+				return false, false
+			}
+
+			return files.App.Pruning == "nothing" && role != constants.NodeRoleArchive, true
+		},
+	},
+	{
+		Name:        "minimum-gas-prices-empty",
+		Severity:    constants.ConfigLintSeverityWarning,
+		Description: "minimum-gas-prices in app.toml is empty",
+		Check: func(files *config_files.NodeConfigFiles, _ string) (bool, bool) {
+			if files.App == nil {
+				return false, false
+			}
+
+			return strings.TrimSpace(files.App.MinimumGasPrices) == "", true
+		},
+	},
+}
+
+// Lint runs all rules against the node config files, skipping the ones
+// that cannot be checked.
+func Lint(files *config_files.NodeConfigFiles, role string) []Result {
+	results := []Result{}
+
+	for _, rule := range Rules {
+		violated, checked := rule.Check(files, role)
+		if !checked {
+			continue
+		}
+
+		results = append(results, Result{
+			Rule:        rule.Name,
+			Severity:    rule.Severity,
+			Description: rule.Description,
+			Violated:    violated,
+		})
+	}
+
+	return results
+}
+
+// LintNode reads the node config files and lints them, returning an error
+// only if none of them could be read.
+func LintNode(ctx context.Context, client *config_files.Client, role string) ([]Result, error) {
+	configToml, _, configErr := client.GetConfigToml(ctx)
+	appToml, _, appErr := client.GetAppToml(ctx)
+
+	if configErr != nil && appErr != nil {
+		return nil, errors.Join(configErr, appErr)
+	}
+
+	return Lint(&config_files.NodeConfigFiles{Config: configToml, App: appToml}, role), nil
+}
+
+// IsPublicAddress returns whether the listen address, like "tcp://0.0.0.0:26657",
+// accepts connections on all interfaces. Unix sockets are never public.
+func IsPublicAddress(address string) bool {
+	if address == "" {
+		return false
+	}
+
+	scheme, hostPort, found := strings.Cut(address, "://")
+	if !found {
+		hostPort = scheme
+	} else if scheme == "unix" {
+		return false
+	}
+
+	host, _, err := net.SplitHostPort(hostPort)
+	if err != nil {
+		host = hostPort
+	}
+
+	return host == "" || host == "0.0.0.0" || host == "::"
+}
@@ -0,0 +1,111 @@
+package config_lint
+
+import (
+	"context"
+	"main/pkg/clients/config_files"
+	"main/pkg/constants"
+	"main/pkg/fs"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/tracing"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func violatedRules(results []Result) []string {
+	violated := []string{}
+	for _, result := range results {
+		if result.Violated {
+			violated = append(violated, result.Rule)
+		}
+	}
+
+	return violated
+}
+
+func TestIsPublicAddress(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, IsPublicAddress("tcp://0.0.0.0:26657"))
+	assert.True(t, IsPublicAddress("tcp://[::]:26657"))
+	assert.True(t, IsPublicAddress(":26657"))
+	assert.False(t, IsPublicAddress("tcp://127.0.0.1:26657"))
+	assert.False(t, IsPublicAddress("localhost:26657"))
+	assert.False(t, IsPublicAddress("unix:///var/run/node.sock"))
+	assert.False(t, IsPublicAddress(""))
+}
+
+func TestLintNoFiles(t *testing.T) {
+	t.Parallel()
+
+	results := Lint(&config_files.NodeConfigFiles{}, constants.NodeRoleValidator)
+	assert.Empty(t, results)
+}
+
+func TestLintOnlyAppToml(t *testing.T) {
+	t.Parallel()
+
+	results := Lint(&config_files.NodeConfigFiles{
+		App: &config_files.AppToml{Pruning: "nothing"},
+	}, constants.NodeRoleArchive)
+	require.Len(t, results, 2)
+	assert.Equal(t, []string{"minimum-gas-prices-empty"}, violatedRules(results))
+}
+
+func TestLintValidator(t *testing.T) {
+	t.Parallel()
+
+	files := &config_files.NodeConfigFiles{
+		Config: &config_files.ConfigToml{
+			RPC: config_files.RPCConfig{
+				ListenAddress:      "tcp://0.0.0.0:26657",
+				CORSAllowedOrigins: []string{"*"},
+			},
+		},
+		App: &config_files.AppToml{Pruning: "nothing", MinimumGasPrices: "0.0025uatom"},
+	}
+
+	results := Lint(files, constants.NodeRoleValidator)
+	require.Len(t, results, len(Rules))
+	assert.Equal(t, []string{
+		"rpc-public-on-validator",
+		"double-sign-check-disabled",
+		"rpc-cors-wildcard",
+		"pruning-nothing-not-archive",
+	}, violatedRules(results))
+	assert.Equal(t, constants.ConfigLintSeverityCritical, results[0].Severity)
+
+	// public RPC and disabled double sign check are fine on a sentry
+	results = Lint(files, constants.NodeRoleSentry)
+	assert.Equal(t, []string{
+		"rpc-cors-wildcard",
+		"pruning-nothing-not-archive",
+	}, violatedRules(results))
+}
+
+func TestLintNodeNoFiles(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := config_files.NewClient(t.TempDir(), *logger, tracer)
+
+	results, err := LintNode(context.Background(), client, constants.NodeRoleValidator)
+	require.Error(t, err)
+	assert.Nil(t, results)
+}
+
+func TestLintNodeOk(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := config_files.NewClient("node-home", *logger, tracer)
+	client.Filesystem = &fs.TestFS{}
+
+	results, err := LintNode(context.Background(), client, constants.NodeRoleValidator)
+	require.NoError(t, err)
+	require.Len(t, results, len(Rules))
+	assert.Equal(t, []string{"double-sign-check-disabled"}, violatedRules(results))
+}
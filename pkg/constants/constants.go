@@ -11,22 +11,69 @@ type Action string
 type FetcherName string
 
 const (
-	MetricsPrefix                  = "cosmos_node_exporter_"
-	UncachedGithubQueryTime        = 120 * time.Second
-	BlocksBehindToCheck            = 1000
-	ModuleCosmovisor        Module = "cosmovisor"
-	ModuleTendermint        Module = "tendermint"
-	ModuleGit               Module = "git"
-	ModuleGrpc              Module = "grpc"
+	MetricsPrefix                          = "cosmos_node_exporter_"
+	UncachedGithubQueryTime                = 120 * time.Second
+	UncachedChainRegistryQueryTime         = 120 * time.Second
+	BlocksBehindToCheck                    = 1000
+	BlockTimeRefreshInterval               = 5 * time.Minute
+	WebsocketMinHeadersForBlockTime        = 100
+	WebsocketMinReconnectBackoff           = time.Second
+	WebsocketMaxReconnectBackoff           = 60 * time.Second
+	WebsocketPingInterval                  = 20 * time.Second
+	WebsocketPongWait                      = 60 * time.Second
+	FailoverPrimaryCooldown                = 5 * time.Minute
+	ValidatorsPerPage                      = 100
+	ProposalsPerPage                       = 100
+	UpgradeBinaryDownloadTimeout           = 10 * time.Minute
+	UpgradeBinarySmokeTestTimeout          = 30 * time.Second
+	UpgradeSmokeTestRetryInterval          = 10 * time.Minute
+	CommandWaitDelay                       = 5 * time.Second
+	CosmovisorDefaultPollInterval          = 300 * time.Millisecond
+	GithubReleasesPerPage                  = 30
+	SyncRateSamplesCount                   = 10
+	NtpQueryTimeout                        = 5 * time.Second
+	DiskUsageWalkInterval                  = 10 * time.Minute
+	DiskUsageSamplesCount                  = 144 // a day of walks done every 10 minutes
+	ModuleCosmovisor                Module = "cosmovisor"
+	ModuleTendermint                Module = "tendermint"
+	ModuleGit                       Module = "git"
+	ModuleGrpc                      Module = "grpc"
+	ModuleNtp                       Module = "ntp"
+	ModuleChainRegistry             Module = "chain_registry"
+	ModuleBinary                    Module = "binary"
+	ModuleDisk                      Module = "disk"
+	ModuleConfigFiles               Module = "config_files"
+	ModuleValidatorState            Module = "validator_state"
 
 	ActionCosmovisorGetVersion               Action = "get_version"
 	ActionCosmovisorGetCosmovisorVersion     Action = "get_cosmovisor_version"
 	ActionCosmovisorGetCosmovisorUpgradeInfo Action = "get_cosmovisor_upgrade_info"
 	ActionCosmovisorGetUpgrades              Action = "get_upgrades"
+	ActionCosmovisorGetUpgradeBinaryChecksum Action = "get_upgrade_binary_checksum"
+	ActionCosmovisorGetState                 Action = "get_state"
+	ActionCosmovisorSmokeTestUpgradeBinary   Action = "smoke_test_upgrade_binary"
+	ActionCosmovisorGetSettings              Action = "get_settings"
+	ActionBinaryGetVersion                   Action = "get_binary_version"
+	ActionDiskGetUsage                       Action = "get_disk_usage"
+	ActionConfigFilesGetConfigToml           Action = "get_config_toml"
+	ActionConfigFilesGetAppToml              Action = "get_app_toml"
+	ActionValidatorStateGetState             Action = "get_priv_validator_state"
 	ActionGitGetLatestRelease                Action = "get_latest_release"
+	ActionGitGetReleases                     Action = "get_releases"
+	ActionChainRegistryGetChainInfo          Action = "get_chain_info"
 	ActionTendermintGetNodeStatus            Action = "get_node_status"
 	ActionTendermintGetUpgradePlan           Action = "get_upgrade_plan"
 	ActionTendermintGetBlockTime             Action = "get_block_time"
+	ActionTendermintGetConsensusState        Action = "get_consensus_state"
+	ActionTendermintGetValidators            Action = "get_validators"
+	ActionTendermintGetInactiveValidators    Action = "get_inactive_validators"
+	ActionTendermintGetStakingPool           Action = "get_staking_pool"
+	ActionTendermintGetPeersHeights          Action = "get_peers_heights"
+	ActionTendermintGetAbciInfo              Action = "get_abci_info"
+	ActionNtpGetClockOffset                  Action = "get_clock_offset"
+	ActionTendermintGetUpgradeProposals      Action = "get_upgrade_proposals"
+	ActionTendermintGetAppliedPlans          Action = "get_applied_plans"
+	ActionTendermintGetModuleVersions        Action = "get_module_versions"
 	ActionGrpcGetNodeConfig                  Action = "get_node_config"
 	ActionGrpcGetNodeInfo                    Action = "get_node_info"
 
@@ -42,13 +89,47 @@ const (
 	FetcherNameUpgrades              FetcherName = "upgrades"
 	FetcherNameBlockTime             FetcherName = "block_time"
 	FetcherNameCosmovisorUpgrades    FetcherName = "cosmovisor_upgrades"
+	FetcherNameConsensusState        FetcherName = "consensus_state"
+	FetcherNameReferenceStatus       FetcherName = "reference_status"
+	FetcherNameWebsocketBlocks       FetcherName = "websocket_blocks"
+	FetcherNameValidators            FetcherName = "validators"
+	FetcherNameSyncProgress          FetcherName = "sync_progress"
+	FetcherNameAbciInfo              FetcherName = "abci_info"
+	FetcherNameClockDrift            FetcherName = "clock_drift"
+	FetcherNameUpgradeProposals      FetcherName = "upgrade_proposals"
+	FetcherNameAppliedUpgrades       FetcherName = "applied_upgrades"
+	FetcherNameCosmovisorState       FetcherName = "cosmovisor_state"
+	FetcherNameUpgradeBinaries       FetcherName = "upgrade_binaries"
+	FetcherNameUpgradeSmokeTests     FetcherName = "upgrade_smoke_tests"
+	FetcherNameCosmovisorSettings    FetcherName = "cosmovisor_settings"
+	FetcherNameChainRegistry         FetcherName = "chain_registry"
+	FetcherNameDiskUsage             FetcherName = "disk_usage"
+	FetcherNameConfigFiles           FetcherName = "config_files"
+	FetcherNameValidatorState        FetcherName = "validator_state"
 
 	UpgradeSourceGovernance  string = "governance"
 	UpgradeSourceUpgradeInfo string = "upgrade-info"
+
+	CosmovisorGenesisName string = "genesis"
+	CosmovisorConfigName  string = "config.toml"
+
+	HaltHeightInconsistencyInPast       string = "in_past"
+	HaltHeightInconsistencyAfterUpgrade string = "after_upgrade"
+
+	NodeRoleValidator string = "validator"
+	NodeRoleSentry    string = "sentry"
+	NodeRoleFullnode  string = "fullnode"
+	NodeRoleArchive   string = "archive"
+
+	ConfigLintSeverityWarning  string = "warning"
+	ConfigLintSeverityCritical string = "critical"
 )
 
 var (
-	GithubRegexp  = regexp.MustCompile("https://github.com/(?P<Org>[a-zA-Z0-9-].*)/(?P<Repo>[a-zA-Z0-9-].*)")
-	GitopiaRegexp = regexp.MustCompile("gitopia://(?P<Org>[a-zA-Z0-9-].*)/(?P<Repo>[a-zA-Z0-9-].*)")
-	ColorsRegexp  = regexp.MustCompile("[\u001B\u009B][[\\]()#;?]*(?:(?:(?:[a-zA-Z\\d]*(?:;[a-zA-Z\\d]*)*)?\u0007)|(?:(?:\\d{1,4}(?:;\\d{0,4})*)?[\\dA-PRZcf-ntqry=><~]))")
+	DiskUsageDirectories      = []string{"data", "wasm", "cosmovisor"}
+	NodeRoles                 = []string{NodeRoleValidator, NodeRoleSentry, NodeRoleFullnode, NodeRoleArchive}
+	GithubRegexp              = regexp.MustCompile("https://github.com/(?P<Org>[a-zA-Z0-9-].*)/(?P<Repo>[a-zA-Z0-9-].*)")
+	GitopiaRegexp             = regexp.MustCompile("gitopia://(?P<Org>[a-zA-Z0-9-].*)/(?P<Repo>[a-zA-Z0-9-].*)")
+	BitArrayVotingPowerRegexp = regexp.MustCompile(`(\d+)/(\d+) = [\d.]+$`)
+	ColorsRegexp              = regexp.MustCompile("[\u001B\u009B][[\\]()#;?]*(?:(?:(?:[a-zA-Z\\d]*(?:;[a-zA-Z\\d]*)*)?\u0007)|(?:(?:\\d{1,4}(?:;\\d{0,4})*)?[\\dA-PRZcf-ntqry=><~]))")
 )
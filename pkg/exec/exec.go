@@ -1,12 +1,16 @@
 package exec
 
 import (
+	"context"
 	"errors"
+	"main/pkg/constants"
 	"os/exec"
+	"time"
 )
 
 type CommandExecutor interface {
 	RunWithEnv(command string, args []string, env []string) ([]byte, error)
+	RunWithTimeout(command string, args []string, env []string, timeout time.Duration) ([]byte, error)
 }
 
 type NativeCommandExecutor struct {
@@ -19,6 +23,36 @@ func (fs *NativeCommandExecutor) RunWithEnv(command string, args []string, env [
 	return cmd.CombinedOutput()
 }
 
+func (fs *NativeCommandExecutor) RunWithTimeout(
+	command string,
+	args []string,
+	env []string,
+	timeout time.Duration,
+) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, command, args...)
+	cmd.Env = env
+	// the output pipes might be kept open by child processes after the command is killed
+	// on timeout, so waiting for them is limited as well
+	cmd.WaitDelay = constants.CommandWaitDelay
+
+	out, err := cmd.CombinedOutput()
+	if ctx.Err() != nil {
+		return out, ctx.Err()
+	}
+
+	return out, err
+}
+
+// IsExitError returns whether the command was run and exited with a non-zero code,
+// as opposed to timing out or failing to start, which might not happen again on retry.
+func IsExitError(err error) bool {
+	var exitErr *exec.ExitError
+	return errors.As(err, &exitErr)
+}
+
 type TestCommandExecutor struct {
 	Fail     bool
 	Expected []byte
@@ -31,3 +65,12 @@ func (fs *TestCommandExecutor) RunWithEnv(command string, args []string, env []s
 
 	return fs.Expected, nil
 }
+
+func (fs *TestCommandExecutor) RunWithTimeout(
+	command string,
+	args []string,
+	env []string,
+	timeout time.Duration,
+) ([]byte, error) {
+	return fs.RunWithEnv(command, args, env)
+}
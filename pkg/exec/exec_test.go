@@ -1,8 +1,10 @@
 package exec
 
 import (
+	"context"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -16,3 +18,33 @@ func TestNativeExec(t *testing.T) {
 	require.NoError(t, err)
 	assert.NotEmpty(t, out)
 }
+
+func TestNativeExecWithTimeout(t *testing.T) {
+	t.Parallel()
+
+	exec := NativeCommandExecutor{}
+	out, err := exec.RunWithTimeout("ls", []string{}, os.Environ(), time.Minute)
+	require.NoError(t, err)
+	assert.NotEmpty(t, out)
+}
+
+func TestNativeExecWithTimeoutExceeded(t *testing.T) {
+	t.Parallel()
+
+	exec := NativeCommandExecutor{}
+	_, err := exec.RunWithTimeout("sleep", []string{"5"}, os.Environ(), 10*time.Millisecond)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestNativeExecIsExitError(t *testing.T) {
+	t.Parallel()
+
+	exec := NativeCommandExecutor{}
+	_, err := exec.RunWithTimeout("false", []string{}, os.Environ(), time.Minute)
+	require.Error(t, err)
+	assert.True(t, IsExitError(err))
+
+	_, err = exec.RunWithTimeout("sleep", []string{"5"}, os.Environ(), 10*time.Millisecond)
+	require.Error(t, err)
+	assert.False(t, IsExitError(err))
+}
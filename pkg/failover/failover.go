@@ -0,0 +1,101 @@
+package failover
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// Failover picks which of the node's endpoints to query. The endpoints are tried
+// in order, the last healthy one is remembered and is tried first next time,
+// and once the cooldown passes since switching away from the primary endpoint,
+// the primary one is tried first again. If it is still failing, the cooldown
+// starts over, so a dead primary endpoint is retried once per cooldown only.
+type Failover struct {
+	Addresses []string
+	Cooldown  time.Duration
+
+	mutex   sync.Mutex
+	current int
+	// when the primary endpoint was switched away from or was last retried
+	switchedAt time.Time
+}
+
+func NewFailover(addresses []string, cooldown time.Duration) *Failover {
+	return &Failover{
+		Addresses: addresses,
+		Cooldown:  cooldown,
+	}
+}
+
+func (f *Failover) Order() []int {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	order := make([]int, 0, len(f.Addresses))
+
+	if f.current != 0 && time.Since(f.switchedAt) >= f.Cooldown {
+		order = append(order, 0)
+	}
+
+	order = append(order, f.current)
+
+	for index := range f.Addresses {
+		if index != f.current && index != order[0] {
+			order = append(order, index)
+		}
+	}
+
+	return order
+}
+
+func (f *Failover) ReportSuccess(index int) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if f.current != index {
+		f.current = index
+		f.switchedAt = time.Now()
+	}
+}
+
+func (f *Failover) ReportFailure(index int) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if index == 0 && f.current != 0 {
+		f.switchedAt = time.Now()
+	}
+}
+
+// Do runs the query against the endpoints until one of them succeeds,
+// returning the address of the endpoint that served the query.
+// The query should only set its results once it succeeds, so nothing
+// from a failed endpoint is mixed into the next endpoint's results.
+func (f *Failover) Do(query func(address string) error) (string, error) {
+	if len(f.Addresses) == 0 {
+		return "", errors.New("no addresses provided")
+	}
+
+	var errs []error
+
+	for _, index := range f.Order() {
+		address := f.Addresses[index]
+
+		err := query(address)
+		if err == nil {
+			f.ReportSuccess(index)
+			return address, nil
+		}
+
+		f.ReportFailure(index)
+		errs = append(errs, err)
+	}
+
+	// single endpoint, returning its error as is
+	if len(errs) == 1 {
+		return "", errs[0]
+	}
+
+	return "", errors.Join(errs...)
+}
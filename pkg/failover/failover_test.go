@@ -0,0 +1,118 @@
+package failover
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFailoverNoAddresses(t *testing.T) {
+	t.Parallel()
+
+	failover := NewFailover([]string{}, time.Minute)
+	_, err := failover.Do(func(address string) error {
+		return nil
+	})
+	require.Error(t, err)
+	require.ErrorContains(t, err, "no addresses provided")
+}
+
+func TestFailoverSingleAddressError(t *testing.T) {
+	t.Parallel()
+
+	failover := NewFailover([]string{"primary"}, time.Minute)
+	_, err := failover.Do(func(address string) error {
+		return errors.New("custom error")
+	})
+	require.Error(t, err)
+	require.Equal(t, "custom error", err.Error())
+}
+
+func TestFailoverAllAddressesFail(t *testing.T) {
+	t.Parallel()
+
+	failover := NewFailover([]string{"primary", "fallback"}, time.Minute)
+	_, err := failover.Do(func(address string) error {
+		return errors.New(address + " error")
+	})
+	require.Error(t, err)
+	require.ErrorContains(t, err, "primary error")
+	require.ErrorContains(t, err, "fallback error")
+}
+
+func TestFailoverSwitchesToFallback(t *testing.T) {
+	t.Parallel()
+
+	failover := NewFailover([]string{"primary", "fallback"}, time.Minute)
+
+	queried := []string{}
+	address, err := failover.Do(func(address string) error {
+		queried = append(queried, address)
+		if address == "primary" {
+			return errors.New("primary is down")
+		}
+
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, "fallback", address)
+	require.Equal(t, []string{"primary", "fallback"}, queried)
+
+	// fallback is remembered and is tried first
+	require.Equal(t, []int{1, 0}, failover.Order())
+}
+
+func TestFailoverSwitchesBackToPrimary(t *testing.T) {
+	t.Parallel()
+
+	failover := NewFailover([]string{"primary", "fallback", "another"}, time.Minute)
+	failover.ReportSuccess(1)
+	require.Equal(t, []int{1, 0, 2}, failover.Order())
+
+	// cooldown has passed, trying the primary endpoint first
+	failover.switchedAt = time.Now().Add(-2 * time.Minute)
+	require.Equal(t, []int{0, 1, 2}, failover.Order())
+
+	address, err := failover.Do(func(address string) error {
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, "primary", address)
+	require.Equal(t, []int{0, 1, 2}, failover.Order())
+}
+
+func TestFailoverPrimaryStillDownAfterCooldown(t *testing.T) {
+	t.Parallel()
+
+	failover := NewFailover([]string{"primary", "fallback"}, time.Minute)
+	failover.ReportSuccess(1)
+
+	// cooldown has passed, but the primary endpoint is still down
+	failover.switchedAt = time.Now().Add(-2 * time.Minute)
+
+	queried := []string{}
+	query := func(address string) error {
+		queried = append(queried, address)
+		if address == "primary" {
+			return errors.New("primary is down")
+		}
+
+		return nil
+	}
+
+	address, err := failover.Do(query)
+	require.NoError(t, err)
+	require.Equal(t, "fallback", address)
+	require.Equal(t, []string{"primary", "fallback"}, queried)
+
+	// cooldown starts over, so the primary endpoint is not retried on the next query
+	require.Equal(t, []int{1, 0}, failover.Order())
+
+	queried = []string{}
+	address, err = failover.Do(query)
+	require.NoError(t, err)
+	require.Equal(t, "fallback", address)
+	require.Equal(t, []string{"fallback"}, queried)
+}
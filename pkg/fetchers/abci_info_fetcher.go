@@ -0,0 +1,69 @@
+package fetchers
+
+import (
+	"context"
+	"main/pkg/clients/tendermint"
+	"main/pkg/constants"
+	"main/pkg/query_info"
+	"main/pkg/types"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/rs/zerolog"
+)
+
+type AbciInfoFetcher struct {
+	TendermintRPC *tendermint.RPC
+	Logger        zerolog.Logger
+	Tracer        trace.Tracer
+}
+
+func NewAbciInfoFetcher(logger zerolog.Logger, tendermintRPC *tendermint.RPC, tracer trace.Tracer) *AbciInfoFetcher {
+	return &AbciInfoFetcher{
+		Logger:        logger.With().Str("component", "abci_info_fetcher").Logger(),
+		TendermintRPC: tendermintRPC,
+		Tracer:        tracer,
+	}
+}
+
+func (n *AbciInfoFetcher) Enabled() bool {
+	return n.TendermintRPC != nil
+}
+
+func (n *AbciInfoFetcher) Name() constants.FetcherName {
+	return constants.FetcherNameAbciInfo
+}
+
+func (n *AbciInfoFetcher) Dependencies() []constants.FetcherName {
+	return []constants.FetcherName{}
+}
+
+func (n *AbciInfoFetcher) Get(ctx context.Context, data ...interface{}) (interface{}, []query_info.QueryInfo) {
+	childCtx, span := n.Tracer.Start(
+		ctx,
+		"Fetcher "+string(n.Name()),
+		trace.WithAttributes(attribute.String("node", n.TendermintRPC.Address)),
+	)
+	defer span.End()
+
+	abciInfo, abciInfoQueryInfo, err := n.TendermintRPC.AbciInfo(childCtx)
+	if err != nil {
+		n.Logger.Error().Err(err).Msg("Could not fetch ABCI info")
+		return nil, []query_info.QueryInfo{abciInfoQueryInfo}
+	}
+
+	info := &types.AbciInfo{Info: abciInfo.Result.Response}
+
+	// the latest height is queried here instead of being taken from the node status fetcher,
+	// as that one might be overridden by the websocket or fetched before ABCI info
+	status, statusQueryInfo, err := n.TendermintRPC.Status(childCtx)
+	if err != nil {
+		n.Logger.Error().Err(err).Msg("Could not fetch node status")
+	} else {
+		info.LatestBlockHeight = status.Result.SyncInfo.LatestBlockHeight
+		info.HasLatestBlockHeight = true
+	}
+
+	return info, []query_info.QueryInfo{abciInfoQueryInfo, statusQueryInfo}
+}
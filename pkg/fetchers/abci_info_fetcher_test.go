@@ -0,0 +1,129 @@
+package fetchers
+
+import (
+	"context"
+	"errors"
+	"main/assets"
+	"main/pkg/clients/tendermint"
+	configPkg "main/pkg/config"
+	"main/pkg/constants"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/tracing"
+	"main/pkg/types"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAbciInfoFetcherBase(t *testing.T) {
+	t.Parallel()
+
+	config := configPkg.TendermintConfig{
+		Address: "https://example.com",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := tendermint.NewRPC(config, *logger, tracer)
+	fetcher := NewAbciInfoFetcher(*logger, client, tracer)
+	assert.True(t, fetcher.Enabled())
+	assert.Equal(t, constants.FetcherNameAbciInfo, fetcher.Name())
+	assert.Empty(t, fetcher.Dependencies())
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestAbciInfoFetcherFail(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/abci_info",
+		httpmock.NewErrorResponder(errors.New("custom error")),
+	)
+
+	config := configPkg.TendermintConfig{
+		Address: "https://example.com",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := tendermint.NewRPC(config, *logger, tracer)
+	fetcher := NewAbciInfoFetcher(*logger, client, tracer)
+
+	data, queryInfos := fetcher.Get(context.Background())
+	assert.Len(t, queryInfos, 1)
+	assert.False(t, queryInfos[0].Success)
+	assert.Nil(t, data)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestAbciInfoFetcherStatusFail(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/abci_info",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("abci-info.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/status",
+		httpmock.NewErrorResponder(errors.New("custom error")),
+	)
+
+	config := configPkg.TendermintConfig{
+		Address: "https://example.com",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := tendermint.NewRPC(config, *logger, tracer)
+	fetcher := NewAbciInfoFetcher(*logger, client, tracer)
+
+	data, queryInfos := fetcher.Get(context.Background())
+	assert.Len(t, queryInfos, 2)
+	assert.True(t, queryInfos[0].Success)
+	assert.False(t, queryInfos[1].Success)
+
+	abciInfo, ok := data.(*types.AbciInfo)
+	require.True(t, ok)
+	assert.False(t, abciInfo.HasLatestBlockHeight)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestAbciInfoFetcherOk(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/abci_info",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("abci-info.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/status",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("status.json")),
+	)
+
+	config := configPkg.TendermintConfig{
+		Address: "https://example.com",
+	}
+	logger := loggerPkg.GetDefaultLogger()
+	tracer := tracing.InitNoopTracer()
+	client := tendermint.NewRPC(config, *logger, tracer)
+	fetcher := NewAbciInfoFetcher(*logger, client, tracer)
+
+	data, queryInfos := fetcher.Get(context.Background())
+	assert.Len(t, queryInfos, 2)
+	assert.True(t, queryInfos[0].Success)
+	assert.True(t, queryInfos[1].Success)
+
+	abciInfo, ok := data.(*types.AbciInfo)
+	require.True(t, ok)
+	assert.Positive(t, abciInfo.Info.LastBlockHeight)
+	assert.True(t, abciInfo.HasLatestBlockHeight)
+	assert.Positive(t, abciInfo.LatestBlockHeight)
+}
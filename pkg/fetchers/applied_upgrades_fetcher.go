@@ -0,0 +1,182 @@
+package fetchers
+
+import (
+	"context"
+	"main/pkg/clients/chain_registry"
+	"main/pkg/clients/tendermint"
+	"main/pkg/constants"
+	"main/pkg/query_info"
+	"main/pkg/types"
+	"sync"
+
+	upgradeTypes "cosmossdk.io/x/upgrade/types"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/rs/zerolog"
+)
+
+type AppliedUpgradesFetcher struct {
+	TendermintRPC *tendermint.RPC
+	QueryUpgrades bool
+	Logger        zerolog.Logger
+	Tracer        trace.Tracer
+
+	// the height an upgrade was applied at never changes, so applied plans are only queried once,
+	// while the ones not applied yet are queried on every scrape
+	AppliedHeights map[string]int64
+	Mutex          sync.Mutex
+}
+
+func NewAppliedUpgradesFetcher(
+	logger zerolog.Logger,
+	tendermintRPC *tendermint.RPC,
+	queryUpgrades bool,
+	tracer trace.Tracer,
+) *AppliedUpgradesFetcher {
+	return &AppliedUpgradesFetcher{
+		Logger:         logger.With().Str("component", "applied_upgrades_fetcher").Logger(),
+		TendermintRPC:  tendermintRPC,
+		QueryUpgrades:  queryUpgrades,
+		Tracer:         tracer,
+		AppliedHeights: map[string]int64{},
+	}
+}
+
+func (n *AppliedUpgradesFetcher) Enabled() bool {
+	return n.TendermintRPC != nil && n.QueryUpgrades
+}
+
+func (n *AppliedUpgradesFetcher) Name() constants.FetcherName {
+	return constants.FetcherNameAppliedUpgrades
+}
+
+func (n *AppliedUpgradesFetcher) Dependencies() []constants.FetcherName {
+	return []constants.FetcherName{
+		constants.FetcherNameCosmovisorUpgrades,
+		constants.FetcherNameUpgrades,
+		constants.FetcherNameCosmovisorUpgradeInfo,
+		constants.FetcherNameChainRegistry,
+	}
+}
+
+func (n *AppliedUpgradesFetcher) Get(ctx context.Context, data ...interface{}) (interface{}, []query_info.QueryInfo) {
+	if len(data) < 4 {
+		panic("data is empty")
+	}
+
+	cosmovisorUpgrades, cosmovisorUpgradesConverted := Convert[*types.UpgradesPresent](data[0])
+	governancePlan, governancePlanConverted := Convert[*upgradeTypes.Plan](data[1])
+	upgradeInfoPlan, upgradeInfoPlanConverted := Convert[*upgradeTypes.Plan](data[2])
+	chainInfo, chainInfoConverted := Convert[*chain_registry.ChainInfo](data[3])
+
+	childCtx, span := n.Tracer.Start(
+		ctx,
+		"Fetcher "+string(n.Name()),
+		trace.WithAttributes(attribute.String("node", n.TendermintRPC.Address)),
+	)
+	defer span.End()
+
+	appliedUpgrades := types.AppliedUpgrades{}
+	queryInfos := []query_info.QueryInfo{}
+
+	moduleVersions, moduleVersionsQueryInfo, err := n.TendermintRPC.GetModuleVersions(childCtx)
+	queryInfos = append(queryInfos, moduleVersionsQueryInfo)
+
+	if err != nil {
+		n.Logger.Error().Err(err).Msg("Could not fetch module versions")
+	} else {
+		appliedUpgrades.ModuleVersions = make(map[string]uint64, len(moduleVersions))
+		for _, moduleVersion := range moduleVersions {
+			appliedUpgrades.ModuleVersions[moduleVersion.Name] = moduleVersion.Version
+		}
+	}
+
+	// the upgrades to check are taken from the cosmovisor folder, as the chain
+	// does not expose the list of the applied ones
+	if cosmovisorUpgradesConverted && len(*cosmovisorUpgrades) > 0 {
+		// folder names are lowercase, while applied plans are looked up by the exact name,
+		// so the original names are taken from the sources that have them
+		knownNames := []string{}
+		if governancePlanConverted {
+			knownNames = append(knownNames, governancePlan.Name)
+		}
+		if upgradeInfoPlanConverted {
+			knownNames = append(knownNames, upgradeInfoPlan.Name)
+		}
+		if chainInfoConverted && chainInfo.Codebase != nil {
+			for _, version := range chainInfo.Codebase.Versions {
+				knownNames = append(knownNames, version.Name)
+			}
+		}
+
+		resolvedNames, unresolvedNames := cosmovisorUpgrades.ResolveNames(knownNames)
+
+		appliedPlans, appliedPlansQueryInfos, err := n.getAppliedPlans(
+			childCtx,
+			append(resolvedNames, unresolvedNames...),
+		)
+		queryInfos = append(queryInfos, appliedPlansQueryInfos...)
+
+		if err != nil {
+			n.Logger.Error().Err(err).Msg("Could not fetch applied plans")
+		} else {
+			// a zero height for a folder name is not reliable, as the upgrade
+			// might have been applied under a name with a different case
+			for _, name := range unresolvedNames {
+				if appliedPlans[name] == 0 {
+					delete(appliedPlans, name)
+				}
+			}
+
+			appliedUpgrades.AppliedPlans = appliedPlans
+		}
+	}
+
+	if appliedUpgrades.ModuleVersions == nil && appliedUpgrades.AppliedPlans == nil {
+		return nil, queryInfos
+	}
+
+	return &appliedUpgrades, queryInfos
+}
+
+// getAppliedPlans returns the heights the given upgrades were applied at,
+// only querying the ones that were not seen as applied before.
+func (n *AppliedUpgradesFetcher) getAppliedPlans(
+	ctx context.Context,
+	names []string,
+) (map[string]int64, []query_info.QueryInfo, error) {
+	n.Mutex.Lock()
+	defer n.Mutex.Unlock()
+
+	appliedPlans := make(map[string]int64, len(names))
+	namesToQuery := []string{}
+
+	for _, name := range names {
+		if height, ok := n.AppliedHeights[name]; ok {
+			appliedPlans[name] = height
+		} else {
+			namesToQuery = append(namesToQuery, name)
+		}
+	}
+
+	if len(namesToQuery) == 0 {
+		return appliedPlans, []query_info.QueryInfo{}, nil
+	}
+
+	queriedPlans, queryInfo, err := n.TendermintRPC.GetAppliedPlans(ctx, namesToQuery)
+	if err != nil {
+		return nil, []query_info.QueryInfo{queryInfo}, err
+	}
+
+	for name, height := range queriedPlans {
+		if height > 0 {
+			n.AppliedHeights[name] = height
+		}
+
+		appliedPlans[name] = height
+	}
+
+	return appliedPlans, []query_info.QueryInfo{queryInfo}, nil
+}
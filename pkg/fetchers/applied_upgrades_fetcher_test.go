@@ -0,0 +1,231 @@
+package fetchers
+
+import (
+	"context"
+	"errors"
+	"main/assets"
+	"main/pkg/clients/chain_registry"
+	"main/pkg/clients/tendermint"
+	configPkg "main/pkg/config"
+	"main/pkg/constants"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/tracing"
+	"main/pkg/types"
+	"regexp"
+	"testing"
+
+	upgradeTypes "cosmossdk.io/x/upgrade/types"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppliedUpgradesFetcherBase(t *testing.T) {
+	t.Parallel()
+
+	config := configPkg.TendermintConfig{Address: "https://example.com"}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := tendermint.NewRPC(config, *logger, tracer)
+
+	fetcher := NewAppliedUpgradesFetcher(*logger, client, true, tracer)
+	assert.True(t, fetcher.Enabled())
+	assert.Equal(t, constants.FetcherNameAppliedUpgrades, fetcher.Name())
+	assert.Equal(t, []constants.FetcherName{
+		constants.FetcherNameCosmovisorUpgrades,
+		constants.FetcherNameUpgrades,
+		constants.FetcherNameCosmovisorUpgradeInfo,
+		constants.FetcherNameChainRegistry,
+	}, fetcher.Dependencies())
+
+	fetcher = NewAppliedUpgradesFetcher(*logger, client, false, tracer)
+	assert.False(t, fetcher.Enabled())
+}
+
+func TestAppliedUpgradesFetcherDataEmpty(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if r := recover(); r == nil {
+			require.Fail(t, "Expected to have a panic here!")
+		}
+	}()
+
+	config := configPkg.TendermintConfig{Address: "https://example.com"}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := tendermint.NewRPC(config, *logger, tracer)
+	fetcher := NewAppliedUpgradesFetcher(*logger, client, true, tracer)
+	fetcher.Get(context.Background())
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestAppliedUpgradesFetcherAllFail(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterRegexpResponder(
+		"GET",
+		regexp.MustCompile(`/abci_query\?path=%22%2Fcosmos.upgrade.v1beta1.Query`),
+		httpmock.NewErrorResponder(errors.New("custom error")),
+	)
+
+	config := configPkg.TendermintConfig{Address: "https://example.com"}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := tendermint.NewRPC(config, *logger, tracer)
+	fetcher := NewAppliedUpgradesFetcher(*logger, client, true, tracer)
+
+	data, queryInfos := fetcher.Get(context.Background(), &types.UpgradesPresent{"v17": true}, nil, nil, nil)
+	assert.Len(t, queryInfos, 2)
+	assert.False(t, queryInfos[0].Success)
+	assert.False(t, queryInfos[1].Success)
+	assert.Nil(t, data)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestAppliedUpgradesFetcherNoCosmovisorUpgrades(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/abci_query?path=%22%2Fcosmos.upgrade.v1beta1.Query%2FModuleVersions%22&data=0x",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("upgrade-module-versions.json")),
+	)
+
+	config := configPkg.TendermintConfig{Address: "https://example.com"}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := tendermint.NewRPC(config, *logger, tracer)
+	fetcher := NewAppliedUpgradesFetcher(*logger, client, true, tracer)
+
+	data, queryInfos := fetcher.Get(context.Background(), nil, nil, nil, nil)
+	assert.Len(t, queryInfos, 1)
+	assert.True(t, queryInfos[0].Success)
+
+	appliedUpgrades, ok := data.(*types.AppliedUpgrades)
+	require.True(t, ok)
+	assert.Nil(t, appliedUpgrades.AppliedPlans)
+	assert.Len(t, appliedUpgrades.ModuleVersions, 4)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestAppliedUpgradesFetcherOk(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/abci_query?path=%22%2Fcosmos.upgrade.v1beta1.Query%2FModuleVersions%22&data=0x",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("upgrade-module-versions.json")),
+	)
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/abci_query?path=%22%2Fcosmos.upgrade.v1beta1.Query%2FAppliedPlan%22&data=0x0a03763137",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("upgrade-applied-plan.json")),
+	)
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/abci_query?path=%22%2Fcosmos.upgrade.v1beta1.Query%2FAppliedPlan%22&data=0x0a03763138",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("upgrade-applied-plan-empty.json")),
+	)
+
+	config := configPkg.TendermintConfig{Address: "https://example.com"}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := tendermint.NewRPC(config, *logger, tracer)
+	fetcher := NewAppliedUpgradesFetcher(*logger, client, true, tracer)
+
+	data, queryInfos := fetcher.Get(
+		context.Background(),
+		&types.UpgradesPresent{"v17": true, "v18": false},
+		nil,
+		nil,
+		&chain_registry.ChainInfo{Codebase: &chain_registry.Codebase{
+			Versions: []chain_registry.ChainVersion{{Name: "v18"}},
+		}},
+	)
+	assert.Len(t, queryInfos, 2)
+	assert.True(t, queryInfos[0].Success)
+	assert.True(t, queryInfos[1].Success)
+
+	appliedUpgrades, ok := data.(*types.AppliedUpgrades)
+	require.True(t, ok)
+	assert.Equal(t, map[string]int64{"v17": 20739800, "v18": 0}, appliedUpgrades.AppliedPlans)
+	assert.Equal(t, map[string]uint64{
+		"auth":    5,
+		"bank":    4,
+		"staking": 5,
+		"upgrade": 2,
+	}, appliedUpgrades.ModuleVersions)
+
+	// v17 was applied, so only v18 is queried again
+	data, queryInfos = fetcher.Get(
+		context.Background(),
+		&types.UpgradesPresent{"v17": true, "v18": false},
+		nil,
+		nil,
+		&chain_registry.ChainInfo{Codebase: &chain_registry.Codebase{
+			Versions: []chain_registry.ChainVersion{{Name: "v18"}},
+		}},
+	)
+	assert.Len(t, queryInfos, 2)
+
+	appliedUpgrades, ok = data.(*types.AppliedUpgrades)
+	require.True(t, ok)
+	assert.Equal(t, map[string]int64{"v17": 20739800, "v18": 0}, appliedUpgrades.AppliedPlans)
+
+	callCounts := httpmock.GetCallCountInfo()
+	assert.Equal(t, 1, callCounts["GET https://example.com/abci_query?path=%22%2Fcosmos.upgrade.v1beta1.Query%2FAppliedPlan%22&data=0x0a03763137"])
+	assert.Equal(t, 2, callCounts["GET https://example.com/abci_query?path=%22%2Fcosmos.upgrade.v1beta1.Query%2FAppliedPlan%22&data=0x0a03763138"])
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestAppliedUpgradesFetcherUnknownCase(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/abci_query?path=%22%2Fcosmos.upgrade.v1beta1.Query%2FModuleVersions%22&data=0x",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("upgrade-module-versions.json")),
+	)
+
+	// V17, with the original case taken from the governance plan
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/abci_query?path=%22%2Fcosmos.upgrade.v1beta1.Query%2FAppliedPlan%22&data=0x0a03563137",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("upgrade-applied-plan.json")),
+	)
+
+	// v18, with the case unknown, so it is not reported as never applied
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/abci_query?path=%22%2Fcosmos.upgrade.v1beta1.Query%2FAppliedPlan%22&data=0x0a03763138",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("upgrade-applied-plan-empty.json")),
+	)
+
+	config := configPkg.TendermintConfig{Address: "https://example.com"}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := tendermint.NewRPC(config, *logger, tracer)
+	fetcher := NewAppliedUpgradesFetcher(*logger, client, true, tracer)
+
+	data, queryInfos := fetcher.Get(
+		context.Background(),
+		&types.UpgradesPresent{"v17": true, "v18": false},
+		&upgradeTypes.Plan{Name: "V17"},
+		nil,
+		nil,
+	)
+	assert.Len(t, queryInfos, 2)
+	assert.True(t, queryInfos[0].Success)
+	assert.True(t, queryInfos[1].Success)
+
+	appliedUpgrades, ok := data.(*types.AppliedUpgrades)
+	require.True(t, ok)
+	assert.Equal(t, map[string]int64{"V17": 20739800}, appliedUpgrades.AppliedPlans)
+}
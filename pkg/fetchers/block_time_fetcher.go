@@ -1,12 +1,17 @@
 package fetchers
 
 import (
+	"cmp"
 	"context"
 	"main/pkg/clients/tendermint"
 	"main/pkg/constants"
 	"main/pkg/query_info"
+	"slices"
+	"sync"
+	"time"
 
 	"cosmossdk.io/x/upgrade/types"
+	"github.com/cosmos/cosmos-sdk/client/grpc/node"
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -16,14 +21,29 @@ import (
 
 type BlockTimeFetcher struct {
 	TendermintRPC *tendermint.RPC
+	Windows       []int64
 	Logger        zerolog.Logger
 	Tracer        trace.Tracer
+
+	// block times queried over RPC are reused across scrapes unless there's an upgrade
+	// or a halt height coming, as querying older blocks is expensive and the average
+	// block time barely changes
+	LastQueried        tendermint.BlockTimes
+	LastQueriedWindows []int64
+	LastQueriedAt      time.Time
+	Mutex              sync.Mutex
 }
 
-func NewBlockTimeFetcher(logger zerolog.Logger, tendermintRPC *tendermint.RPC, tracer trace.Tracer) *BlockTimeFetcher {
+func NewBlockTimeFetcher(
+	logger zerolog.Logger,
+	tendermintRPC *tendermint.RPC,
+	windows []int64,
+	tracer trace.Tracer,
+) *BlockTimeFetcher {
 	return &BlockTimeFetcher{
 		Logger:        logger.With().Str("component", "block_time_fetcher").Logger(),
 		TendermintRPC: tendermintRPC,
+		Windows:       windows,
 		Tracer:        tracer,
 	}
 }
@@ -40,20 +60,59 @@ func (n *BlockTimeFetcher) Dependencies() []constants.FetcherName {
 	return []constants.FetcherName{
 		constants.FetcherNameUpgrades,
 		constants.FetcherNameCosmovisorUpgradeInfo,
+		constants.FetcherNameWebsocketBlocks,
+		constants.FetcherNameNodeStatus,
+		constants.FetcherNameNodeConfig,
 	}
 }
 
 func (n *BlockTimeFetcher) Get(ctx context.Context, data ...interface{}) (interface{}, []query_info.QueryInfo) {
-	if len(data) < 2 {
+	if len(data) < 5 {
 		panic("data is empty")
 	}
 
 	_, governanceUpgradePlanConverted := Convert[*types.Plan](data[0])
 	_, upgradeInfoJSONConverted := Convert[*types.Plan](data[1])
+	websocketSnapshot, websocketSnapshotConverted := Convert[*tendermint.WebsocketSnapshot](data[2])
+	status, statusConverted := Convert[tendermint.StatusResponse](data[3])
+	nodeConfig, nodeConfigConverted := Convert[*node.ConfigResponse](data[4])
+
+	hasHaltHeight := nodeConfigConverted && nodeConfig.HaltHeight > 0
+	needsFreshBlockTime := governanceUpgradePlanConverted || upgradeInfoJSONConverted || hasHaltHeight
+
+	blockTimes := make(tendermint.BlockTimes, 0, len(n.Windows))
+	windowsToQuery := make([]int64, 0, len(n.Windows))
+
+	for _, window := range n.Windows {
+		if websocketSnapshotConverted {
+			if blocksInfo, ok := websocketSnapshot.BlocksInfoForWindow(window); ok {
+				blockTimes = append(blockTimes, tendermint.BlockTimeWindow{Window: window, BlocksInfo: blocksInfo})
+				continue
+			}
+		}
+
+		windowsToQuery = append(windowsToQuery, window)
+	}
 
-	if !governanceUpgradePlanConverted && !upgradeInfoJSONConverted {
-		n.Logger.Trace().Msg("Upgrade plan is empty, not fetching block time.")
-		return nil, []query_info.QueryInfo{}
+	if len(windowsToQuery) == 0 {
+		n.Logger.Trace().Msg("Using block time from websocket blocks, not querying RPC.")
+		return blockTimes, []query_info.QueryInfo{}
+	}
+
+	n.Mutex.Lock()
+	defer n.Mutex.Unlock()
+
+	if !needsFreshBlockTime && n.hasCachedWindows(windowsToQuery) {
+		n.Logger.Trace().Msg("No upgrade or halt height coming, using cached block time.")
+
+		for _, blockTime := range n.LastQueried {
+			if slices.Contains(windowsToQuery, blockTime.Window) {
+				blockTimes = append(blockTimes, blockTime)
+			}
+		}
+
+		sortBlockTimes(blockTimes)
+		return blockTimes, []query_info.QueryInfo{}
 	}
 
 	childCtx, span := n.Tracer.Start(
@@ -63,11 +122,44 @@ func (n *BlockTimeFetcher) Get(ctx context.Context, data ...interface{}) (interf
 	)
 	defer span.End()
 
-	blockTime, queryInfo, err := n.TendermintRPC.GetBlockTime(childCtx)
+	// if the node status is not available, assuming the node has all the blocks
+	var earliestHeight int64
+	if statusConverted {
+		earliestHeight = status.Result.SyncInfo.EarliestBlockHeight
+	}
+
+	queriedBlockTimes, queryInfo, err := n.TendermintRPC.GetBlockTime(childCtx, windowsToQuery, earliestHeight)
 	if err != nil {
 		n.Logger.Error().Err(err).Msg("Could not fetch block time info")
 		return nil, []query_info.QueryInfo{queryInfo}
 	}
 
-	return blockTime, []query_info.QueryInfo{queryInfo}
+	n.LastQueried = queriedBlockTimes
+	n.LastQueriedWindows = windowsToQuery
+	n.LastQueriedAt = time.Now()
+
+	blockTimes = append(blockTimes, queriedBlockTimes...)
+	sortBlockTimes(blockTimes)
+
+	return blockTimes, []query_info.QueryInfo{queryInfo}
+}
+
+func (n *BlockTimeFetcher) hasCachedWindows(windows []int64) bool {
+	if n.LastQueriedAt.IsZero() || time.Since(n.LastQueriedAt) >= constants.BlockTimeRefreshInterval {
+		return false
+	}
+
+	for _, window := range windows {
+		if !slices.Contains(n.LastQueriedWindows, window) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func sortBlockTimes(blockTimes tendermint.BlockTimes) {
+	slices.SortFunc(blockTimes, func(a, b tendermint.BlockTimeWindow) int {
+		return cmp.Compare(a.Window, b.Window)
+	})
 }
@@ -10,8 +10,10 @@ import (
 	loggerPkg "main/pkg/logger"
 	"main/pkg/tracing"
 	"testing"
+	"time"
 
 	"cosmossdk.io/x/upgrade/types"
+	"github.com/cosmos/cosmos-sdk/client/grpc/node"
 	"github.com/stretchr/testify/require"
 
 	"github.com/jarcoal/httpmock"
@@ -26,11 +28,14 @@ func TestBlockTimeFetcherBase(t *testing.T) {
 	logger := loggerPkg.GetNopLogger()
 	tracer := tracing.InitNoopTracer()
 	client := tendermint.NewRPC(config, *logger, tracer)
-	fetcher := NewBlockTimeFetcher(*logger, client, tracer)
+	fetcher := NewBlockTimeFetcher(*logger, client, []int64{1000}, tracer)
 	assert.True(t, fetcher.Enabled())
 	assert.Equal(t, []constants.FetcherName{
 		constants.FetcherNameUpgrades,
 		constants.FetcherNameCosmovisorUpgradeInfo,
+		constants.FetcherNameWebsocketBlocks,
+		constants.FetcherNameNodeStatus,
+		constants.FetcherNameNodeConfig,
 	}, fetcher.Dependencies())
 	assert.Equal(t, constants.FetcherNameBlockTime, fetcher.Name())
 }
@@ -48,26 +53,10 @@ func TestBlockTimeFetcherDataEmpty(t *testing.T) {
 	logger := loggerPkg.GetNopLogger()
 	tracer := tracing.InitNoopTracer()
 	client := tendermint.NewRPC(config, *logger, tracer)
-	fetcher := NewBlockTimeFetcher(*logger, client, tracer)
+	fetcher := NewBlockTimeFetcher(*logger, client, []int64{1000}, tracer)
 	fetcher.Get(context.Background())
 }
 
-func TestBlockTimeFetcherDataNil(t *testing.T) {
-	t.Parallel()
-
-	config := configPkg.TendermintConfig{Address: "https://example.com"}
-	logger := loggerPkg.GetNopLogger()
-	tracer := tracing.InitNoopTracer()
-	client := tendermint.NewRPC(config, *logger, tracer)
-	fetcher := NewBlockTimeFetcher(*logger, client, tracer)
-
-	var upgradePlan *types.Plan
-
-	data, queryInfos := fetcher.Get(context.Background(), upgradePlan, upgradePlan)
-	assert.Empty(t, queryInfos)
-	assert.Nil(t, data)
-}
-
 func TestBlockTimeFetcherDataWrong(t *testing.T) {
 	t.Parallel()
 
@@ -81,7 +70,7 @@ func TestBlockTimeFetcherDataWrong(t *testing.T) {
 	logger := loggerPkg.GetNopLogger()
 	tracer := tracing.InitNoopTracer()
 	client := tendermint.NewRPC(config, *logger, tracer)
-	fetcher := NewBlockTimeFetcher(*logger, client, tracer)
+	fetcher := NewBlockTimeFetcher(*logger, client, []int64{1000}, tracer)
 	fetcher.Get(context.Background(), 3)
 }
 
@@ -100,9 +89,9 @@ func TestBlockTimeFetcherTendermintFailBlock(t *testing.T) {
 	logger := loggerPkg.GetNopLogger()
 	tracer := tracing.InitNoopTracer()
 	client := tendermint.NewRPC(config, *logger, tracer)
-	fetcher := NewBlockTimeFetcher(*logger, client, tracer)
+	fetcher := NewBlockTimeFetcher(*logger, client, []int64{1000}, tracer)
 
-	data, queryInfos := fetcher.Get(context.Background(), &types.Plan{}, &types.Plan{})
+	data, queryInfos := fetcher.Get(context.Background(), &types.Plan{}, &types.Plan{}, nil, nil, nil)
 	assert.Len(t, queryInfos, 1)
 	assert.False(t, queryInfos[0].Success)
 	assert.Nil(t, data)
@@ -129,9 +118,9 @@ func TestBlockTimeFetcherTendermintFailOlderBlock(t *testing.T) {
 	logger := loggerPkg.GetNopLogger()
 	tracer := tracing.InitNoopTracer()
 	client := tendermint.NewRPC(config, *logger, tracer)
-	fetcher := NewBlockTimeFetcher(*logger, client, tracer)
+	fetcher := NewBlockTimeFetcher(*logger, client, []int64{1000}, tracer)
 
-	data, queryInfos := fetcher.Get(context.Background(), &types.Plan{}, &types.Plan{})
+	data, queryInfos := fetcher.Get(context.Background(), &types.Plan{}, &types.Plan{}, nil, nil, nil)
 	assert.Len(t, queryInfos, 1)
 	assert.False(t, queryInfos[0].Success)
 	assert.Nil(t, data)
@@ -142,17 +131,78 @@ func TestBlockTimeFetcherNoUpgrade(t *testing.T) {
 	httpmock.Activate()
 	defer httpmock.DeactivateAndReset()
 
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/block",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("block.json")),
+	)
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/block?height=21076108",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("block2.json")),
+	)
+
 	config := configPkg.TendermintConfig{Address: "https://example.com"}
 	logger := loggerPkg.GetNopLogger()
 	tracer := tracing.InitNoopTracer()
 	client := tendermint.NewRPC(config, *logger, tracer)
-	fetcher := NewBlockTimeFetcher(*logger, client, tracer)
+	fetcher := NewBlockTimeFetcher(*logger, client, []int64{1000}, tracer)
 
 	var upgradePlan *types.Plan
 
-	data, queryInfos := fetcher.Get(context.Background(), upgradePlan, upgradePlan)
+	// block time is fetched even if there's no upgrade coming
+	data, queryInfos := fetcher.Get(context.Background(), upgradePlan, upgradePlan, nil, nil, nil)
+	assert.Len(t, queryInfos, 1)
+	assert.True(t, queryInfos[0].Success)
+
+	blockTimes, ok := data.(tendermint.BlockTimes)
+	require.True(t, ok)
+	require.Len(t, blockTimes, 1)
+
+	// and is taken from cache on the next scrape
+	data, queryInfos = fetcher.Get(context.Background(), upgradePlan, upgradePlan, nil, nil, nil)
 	assert.Empty(t, queryInfos)
-	assert.Nil(t, data)
+	assert.Equal(t, blockTimes, data)
+	assert.Equal(t, 2, httpmock.GetTotalCallCount())
+
+	// unless the cache is outdated
+	fetcher.LastQueriedAt = time.Now().Add(-constants.BlockTimeRefreshInterval)
+	_, queryInfos = fetcher.Get(context.Background(), upgradePlan, upgradePlan, nil, nil, nil)
+	assert.Len(t, queryInfos, 1)
+	assert.Equal(t, 4, httpmock.GetTotalCallCount())
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestBlockTimeFetcherUpgradeNotCached(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/block",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("block.json")),
+	)
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/block?height=21076108",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("block2.json")),
+	)
+
+	config := configPkg.TendermintConfig{Address: "https://example.com"}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := tendermint.NewRPC(config, *logger, tracer)
+	fetcher := NewBlockTimeFetcher(*logger, client, []int64{1000}, tracer)
+
+	// the upgrade time estimate should be as precise as possible, so it's queried each time
+	for i := 0; i < 2; i++ {
+		_, queryInfos := fetcher.Get(context.Background(), &types.Plan{}, nil, nil, nil, nil)
+		assert.Len(t, queryInfos, 1)
+	}
+
+	assert.Equal(t, 4, httpmock.GetTotalCallCount())
 }
 
 //nolint:paralleltest // disabled due to httpmock usage
@@ -176,10 +226,174 @@ func TestBlockTimeFetcherTendermintOk(t *testing.T) {
 	logger := loggerPkg.GetNopLogger()
 	tracer := tracing.InitNoopTracer()
 	client := tendermint.NewRPC(config, *logger, tracer)
-	fetcher := NewBlockTimeFetcher(*logger, client, tracer)
+	fetcher := NewBlockTimeFetcher(*logger, client, []int64{1000}, tracer)
 
-	data, queryInfos := fetcher.Get(context.Background(), &types.Plan{}, &types.Plan{})
+	data, queryInfos := fetcher.Get(context.Background(), &types.Plan{}, &types.Plan{}, nil, nil, nil)
 	assert.Len(t, queryInfos, 1)
 	assert.True(t, queryInfos[0].Success)
 	assert.NotNil(t, data)
 }
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestBlockTimeFetcherWebsocketNotEnoughBlocks(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/block",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("block.json")),
+	)
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/block?height=21076108",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("block2.json")),
+	)
+
+	config := configPkg.TendermintConfig{Address: "https://example.com"}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := tendermint.NewRPC(config, *logger, tracer)
+	fetcher := NewBlockTimeFetcher(*logger, client, []int64{1000}, tracer)
+
+	snapshot := &tendermint.WebsocketSnapshot{
+		Headers: []tendermint.BlockHeader{{Height: 1}, {Height: 2}},
+	}
+
+	data, queryInfos := fetcher.Get(context.Background(), &types.Plan{}, &types.Plan{}, snapshot, nil, nil)
+	assert.Len(t, queryInfos, 1)
+	assert.True(t, queryInfos[0].Success)
+	assert.NotNil(t, data)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestBlockTimeFetcherWebsocketOk(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	config := configPkg.TendermintConfig{Address: "https://example.com"}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := tendermint.NewRPC(config, *logger, tracer)
+	fetcher := NewBlockTimeFetcher(*logger, client, []int64{1000}, tracer)
+
+	start := time.Now()
+	headers := make([]tendermint.BlockHeader, 1001)
+	for index := range headers {
+		headers[index] = tendermint.BlockHeader{
+			Height: int64(index + 1),
+			Time:   start.Add(time.Duration(index) * 5 * time.Second),
+		}
+	}
+
+	snapshot := &tendermint.WebsocketSnapshot{Headers: headers}
+
+	data, queryInfos := fetcher.Get(context.Background(), &types.Plan{}, &types.Plan{}, snapshot, nil, nil)
+	assert.Empty(t, queryInfos)
+
+	blockTimes, ok := data.(tendermint.BlockTimes)
+	require.True(t, ok)
+	require.Len(t, blockTimes, 1)
+	assert.InDelta(t, 5, blockTimes[0].BlocksInfo.BlockTime(), 0.01)
+}
+
+func TestBlockTimeFetcherHaltHeightOnly(t *testing.T) {
+	t.Parallel()
+
+	config := configPkg.TendermintConfig{Address: "https://example.com"}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := tendermint.NewRPC(config, *logger, tracer)
+	fetcher := NewBlockTimeFetcher(*logger, client, []int64{100}, tracer)
+
+	start := time.Now()
+	headers := make([]tendermint.BlockHeader, 101)
+	for index := range headers {
+		headers[index] = tendermint.BlockHeader{
+			Height: int64(index + 1),
+			Time:   start.Add(time.Duration(index) * 6 * time.Second),
+		}
+	}
+
+	snapshot := &tendermint.WebsocketSnapshot{Headers: headers}
+
+	var upgradePlan *types.Plan
+
+	data, queryInfos := fetcher.Get(
+		context.Background(),
+		upgradePlan,
+		upgradePlan,
+		snapshot,
+		nil,
+		&node.ConfigResponse{HaltHeight: 1000},
+	)
+	assert.Empty(t, queryInfos)
+
+	blockTimes, ok := data.(tendermint.BlockTimes)
+	require.True(t, ok)
+	require.Len(t, blockTimes, 1)
+	assert.InDelta(t, 6, blockTimes[0].BlocksInfo.BlockTime(), 0.01)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestBlockTimeFetcherMultipleWindows(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/block",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("block.json")),
+	)
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/block?height=21076108",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("block2.json")),
+	)
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/block?height=21065923",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("block2.json")),
+	)
+
+	config := configPkg.TendermintConfig{Address: "https://example.com"}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := tendermint.NewRPC(config, *logger, tracer)
+	fetcher := NewBlockTimeFetcher(*logger, client, []int64{100000, 100, 1000}, tracer)
+
+	start := time.Now()
+	headers := make([]tendermint.BlockHeader, 101)
+	for index := range headers {
+		headers[index] = tendermint.BlockHeader{
+			Height: int64(index + 1),
+			Time:   start.Add(time.Duration(index) * 5 * time.Second),
+		}
+	}
+
+	snapshot := &tendermint.WebsocketSnapshot{Headers: headers}
+	status := tendermint.StatusResponse{
+		Result: tendermint.StatusResult{
+			SyncInfo: tendermint.SyncInfo{EarliestBlockHeight: 21065923},
+		},
+	}
+
+	data, queryInfos := fetcher.Get(context.Background(), &types.Plan{}, &types.Plan{}, snapshot, status, nil)
+	assert.Len(t, queryInfos, 1)
+	assert.True(t, queryInfos[0].Success)
+
+	blockTimes, ok := data.(tendermint.BlockTimes)
+	require.True(t, ok)
+	require.Len(t, blockTimes, 3)
+	assert.Equal(t, int64(100), blockTimes[0].Window)
+	assert.InDelta(t, 5, blockTimes[0].BlocksInfo.BlockTime(), 0.01)
+	assert.Equal(t, int64(1000), blockTimes[1].Window)
+	assert.Equal(t, int64(100000), blockTimes[2].Window)
+
+	// 100000 blocks behind is below the earliest block, so it's clamped
+	info := httpmock.GetCallCountInfo()
+	assert.Equal(t, 1, info["GET https://example.com/block?height=21065923"])
+}
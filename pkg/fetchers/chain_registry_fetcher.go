@@ -0,0 +1,58 @@
+package fetchers
+
+import (
+	"context"
+	"main/pkg/clients/chain_registry"
+	"main/pkg/constants"
+	"main/pkg/query_info"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/rs/zerolog"
+)
+
+type ChainRegistryFetcher struct {
+	ChainRegistry *chain_registry.Client
+	Logger        zerolog.Logger
+	Tracer        trace.Tracer
+}
+
+func NewChainRegistryFetcher(
+	logger zerolog.Logger,
+	chainRegistry *chain_registry.Client,
+	tracer trace.Tracer,
+) *ChainRegistryFetcher {
+	return &ChainRegistryFetcher{
+		Logger:        logger.With().Str("component", "chain_registry_fetcher").Logger(),
+		ChainRegistry: chainRegistry,
+		Tracer:        tracer,
+	}
+}
+
+func (n *ChainRegistryFetcher) Enabled() bool {
+	return n.ChainRegistry != nil
+}
+
+func (n *ChainRegistryFetcher) Name() constants.FetcherName {
+	return constants.FetcherNameChainRegistry
+}
+
+func (n *ChainRegistryFetcher) Dependencies() []constants.FetcherName {
+	return []constants.FetcherName{}
+}
+
+func (n *ChainRegistryFetcher) Get(ctx context.Context, data ...interface{}) (interface{}, []query_info.QueryInfo) {
+	childCtx, span := n.Tracer.Start(
+		ctx,
+		"Fetcher "+string(n.Name()),
+	)
+	defer span.End()
+
+	chainInfo, queryInfo, err := n.ChainRegistry.GetChainInfo(childCtx)
+	if err != nil {
+		n.Logger.Error().Err(err).Msg("Could not fetch chain-registry info")
+		return nil, []query_info.QueryInfo{queryInfo}
+	}
+
+	return chainInfo, []query_info.QueryInfo{queryInfo}
+}
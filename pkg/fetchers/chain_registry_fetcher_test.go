@@ -0,0 +1,72 @@
+package fetchers
+
+import (
+	"context"
+	"main/pkg/clients/chain_registry"
+	configPkg "main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/fs"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/tracing"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChainRegistryFetcherBase(t *testing.T) {
+	t.Parallel()
+
+	config := configPkg.ChainRegistryConfig{Path: "chain-registry.json"}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := chain_registry.NewClient(config, *logger, tracer)
+	fetcher := NewChainRegistryFetcher(*logger, client, tracer)
+	assert.True(t, fetcher.Enabled())
+	assert.Equal(t, constants.FetcherNameChainRegistry, fetcher.Name())
+	assert.Empty(t, fetcher.Dependencies())
+}
+
+func TestChainRegistryFetcherDisabled(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	fetcher := NewChainRegistryFetcher(*logger, nil, tracer)
+	assert.False(t, fetcher.Enabled())
+}
+
+func TestChainRegistryFetcherFail(t *testing.T) {
+	t.Parallel()
+
+	config := configPkg.ChainRegistryConfig{Path: "not-found.json"}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := chain_registry.NewClient(config, *logger, tracer)
+	client.Filesystem = &fs.TestFS{}
+	fetcher := NewChainRegistryFetcher(*logger, client, tracer)
+
+	data, queryInfos := fetcher.Get(context.Background())
+	assert.Len(t, queryInfos, 1)
+	assert.False(t, queryInfos[0].Success)
+	assert.Nil(t, data)
+}
+
+func TestChainRegistryFetcherOk(t *testing.T) {
+	t.Parallel()
+
+	config := configPkg.ChainRegistryConfig{Path: "chain-registry.json"}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := chain_registry.NewClient(config, *logger, tracer)
+	client.Filesystem = &fs.TestFS{}
+	fetcher := NewChainRegistryFetcher(*logger, client, tracer)
+
+	data, queryInfos := fetcher.Get(context.Background())
+	assert.Len(t, queryInfos, 1)
+	assert.True(t, queryInfos[0].Success)
+
+	chainInfo, ok := data.(*chain_registry.ChainInfo)
+	require.True(t, ok)
+	assert.Equal(t, "v17.2.0", chainInfo.Codebase.RecommendedVersion)
+}
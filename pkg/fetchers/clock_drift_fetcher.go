@@ -0,0 +1,127 @@
+package fetchers
+
+import (
+	"context"
+	"main/pkg/clients/ntp"
+	"main/pkg/clients/tendermint"
+	"main/pkg/constants"
+	"main/pkg/query_info"
+	"main/pkg/types"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/rs/zerolog"
+)
+
+type ClockDriftFetcher struct {
+	TendermintRPC *tendermint.RPC
+	NtpClient     *ntp.Client
+	Logger        zerolog.Logger
+	Tracer        trace.Tracer
+
+	// the latest height is sampled across scrapes to calculate the expected block interval
+	LastSample       types.HeightSample
+	ExpectedInterval time.Duration
+	Mutex            sync.Mutex
+}
+
+func NewClockDriftFetcher(
+	logger zerolog.Logger,
+	tendermintRPC *tendermint.RPC,
+	ntpClient *ntp.Client,
+	tracer trace.Tracer,
+) *ClockDriftFetcher {
+	return &ClockDriftFetcher{
+		Logger:        logger.With().Str("component", "clock_drift_fetcher").Logger(),
+		TendermintRPC: tendermintRPC,
+		NtpClient:     ntpClient,
+		Tracer:        tracer,
+	}
+}
+
+func (n *ClockDriftFetcher) Enabled() bool {
+	return n.TendermintRPC != nil || n.NtpClient != nil
+}
+
+func (n *ClockDriftFetcher) Name() constants.FetcherName {
+	return constants.FetcherNameClockDrift
+}
+
+func (n *ClockDriftFetcher) Dependencies() []constants.FetcherName {
+	return []constants.FetcherName{
+		constants.FetcherNameNodeStatus,
+	}
+}
+
+func (n *ClockDriftFetcher) Get(ctx context.Context, data ...interface{}) (interface{}, []query_info.QueryInfo) {
+	if len(data) < 1 {
+		panic("data is empty")
+	}
+
+	status, statusConverted := Convert[tendermint.StatusResponse](data[0])
+
+	childCtx, span := n.Tracer.Start(ctx, "Fetcher "+string(n.Name()))
+	defer span.End()
+
+	clockDrift := types.ClockDrift{}
+	queryInfos := []query_info.QueryInfo{}
+
+	// the latest block time is stale while catching up, so it can't be compared to the local clock
+	if statusConverted && !status.Result.SyncInfo.CatchingUp {
+		expectedInterval := n.updateExpectedInterval(types.HeightSample{
+			Height: status.Result.SyncInfo.LatestBlockHeight,
+			Time:   status.Result.SyncInfo.LatestBlockTime,
+		})
+
+		clockDrift.BlockTimeOffset = time.Since(status.Result.SyncInfo.LatestBlockTime) - expectedInterval
+		clockDrift.HasBlockTimeOffset = true
+	} else if statusConverted {
+		n.updateExpectedInterval(types.HeightSample{})
+	}
+
+	if n.NtpClient != nil {
+		offset, queryInfo, err := n.NtpClient.GetClockOffset(childCtx)
+		queryInfos = append(queryInfos, queryInfo)
+
+		if err != nil {
+			n.Logger.Error().Err(err).Msg("Could not fetch NTP clock offset")
+		} else {
+			clockDrift.NtpOffset = offset
+			clockDrift.HasNtpOffset = true
+		}
+	}
+
+	if !clockDrift.HasBlockTimeOffset && !clockDrift.HasNtpOffset {
+		return nil, queryInfos
+	}
+
+	return &clockDrift, queryInfos
+}
+
+// updateExpectedInterval calculates the interval between blocks from the latest block
+// and the one seen on a previous scrape, keeping the last known interval until the height changes.
+// An empty sample resets it, as the blocks seen while catching up are not produced in real time.
+func (n *ClockDriftFetcher) updateExpectedInterval(sample types.HeightSample) time.Duration {
+	n.Mutex.Lock()
+	defer n.Mutex.Unlock()
+
+	if sample.Height == 0 {
+		n.LastSample = types.HeightSample{}
+		n.ExpectedInterval = 0
+		return 0
+	}
+
+	if n.LastSample.Height > 0 &&
+		sample.Height > n.LastSample.Height &&
+		sample.Time.After(n.LastSample.Time) {
+		n.ExpectedInterval = sample.Time.Sub(n.LastSample.Time) / time.Duration(sample.Height-n.LastSample.Height)
+	}
+
+	if sample.Height != n.LastSample.Height {
+		n.LastSample = sample
+	}
+
+	return n.ExpectedInterval
+}
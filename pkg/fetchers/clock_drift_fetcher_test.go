@@ -0,0 +1,215 @@
+package fetchers
+
+import (
+	"context"
+	"main/pkg/clients/ntp"
+	"main/pkg/clients/tendermint"
+	configPkg "main/pkg/config"
+	"main/pkg/constants"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/tracing"
+	"main/pkg/types"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClockDriftFetcherBase(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+
+	fetcher := NewClockDriftFetcher(*logger, nil, nil, tracer)
+	assert.False(t, fetcher.Enabled())
+	assert.Equal(t, constants.FetcherNameClockDrift, fetcher.Name())
+	assert.Equal(t, []constants.FetcherName{constants.FetcherNameNodeStatus}, fetcher.Dependencies())
+
+	fetcher = NewClockDriftFetcher(*logger, nil, ntp.NewClient("localhost:123", *logger, tracer), tracer)
+	assert.True(t, fetcher.Enabled())
+
+	config := configPkg.TendermintConfig{Address: "https://example.com"}
+	client := tendermint.NewRPC(config, *logger, tracer)
+	fetcher = NewClockDriftFetcher(*logger, client, nil, tracer)
+	assert.True(t, fetcher.Enabled())
+}
+
+func TestClockDriftFetcherDataEmpty(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if r := recover(); r == nil {
+			require.Fail(t, "Expected to have a panic here!")
+		}
+	}()
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	fetcher := NewClockDriftFetcher(*logger, nil, nil, tracer)
+	fetcher.Get(context.Background())
+}
+
+func TestClockDriftFetcherNoStatus(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	fetcher := NewClockDriftFetcher(*logger, nil, nil, tracer)
+
+	data, queryInfos := fetcher.Get(context.Background(), nil)
+	assert.Empty(t, queryInfos)
+	assert.Nil(t, data)
+}
+
+func TestClockDriftFetcherCatchingUp(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	fetcher := NewClockDriftFetcher(*logger, nil, nil, tracer)
+
+	data, queryInfos := fetcher.Get(context.Background(), tendermint.StatusResponse{
+		Result: tendermint.StatusResult{
+			SyncInfo: tendermint.SyncInfo{
+				LatestBlockTime: time.Now().Add(-time.Hour),
+				CatchingUp:      true,
+			},
+		},
+	})
+	assert.Empty(t, queryInfos)
+	assert.Nil(t, data)
+}
+
+func TestClockDriftFetcherWithoutBlockTime(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	fetcher := NewClockDriftFetcher(*logger, nil, nil, tracer)
+
+	data, queryInfos := fetcher.Get(context.Background(), tendermint.StatusResponse{
+		Result: tendermint.StatusResult{
+			SyncInfo: tendermint.SyncInfo{LatestBlockTime: time.Now().Add(-10 * time.Second)},
+		},
+	})
+	assert.Empty(t, queryInfos)
+
+	clockDrift, ok := data.(*types.ClockDrift)
+	require.True(t, ok)
+	assert.True(t, clockDrift.HasBlockTimeOffset)
+	assert.InDelta(t, 10, clockDrift.BlockTimeOffset.Seconds(), 0.5)
+	assert.False(t, clockDrift.HasNtpOffset)
+}
+
+func TestClockDriftFetcherWithExpectedInterval(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	fetcher := NewClockDriftFetcher(*logger, nil, nil, tracer)
+
+	latestBlockTime := time.Now().Add(-10 * time.Second)
+	statusAt := func(height int64, blockTime time.Time, catchingUp bool) tendermint.StatusResponse {
+		return tendermint.StatusResponse{
+			Result: tendermint.StatusResult{
+				SyncInfo: tendermint.SyncInfo{
+					LatestBlockHeight: height,
+					LatestBlockTime:   blockTime,
+					CatchingUp:        catchingUp,
+				},
+			},
+		}
+	}
+
+	// no earlier sample, so the interval is not known yet
+	data, _ := fetcher.Get(context.Background(), statusAt(1000, latestBlockTime.Add(-12*time.Second), false))
+	clockDrift, ok := data.(*types.ClockDrift)
+	require.True(t, ok)
+	assert.InDelta(t, 22, clockDrift.BlockTimeOffset.Seconds(), 0.5)
+
+	// 2 blocks in 12 seconds
+	data, _ = fetcher.Get(context.Background(), statusAt(1002, latestBlockTime, false))
+	clockDrift, ok = data.(*types.ClockDrift)
+	require.True(t, ok)
+	assert.True(t, clockDrift.HasBlockTimeOffset)
+	assert.InDelta(t, 4, clockDrift.BlockTimeOffset.Seconds(), 0.5)
+
+	// same height, the interval is kept
+	data, _ = fetcher.Get(context.Background(), statusAt(1002, latestBlockTime, false))
+	clockDrift, ok = data.(*types.ClockDrift)
+	require.True(t, ok)
+	assert.InDelta(t, 4, clockDrift.BlockTimeOffset.Seconds(), 0.5)
+
+	// catching up resets the samples
+	data, _ = fetcher.Get(context.Background(), statusAt(1003, latestBlockTime, true))
+	assert.Nil(t, data)
+	assert.Zero(t, fetcher.ExpectedInterval)
+
+	data, _ = fetcher.Get(context.Background(), statusAt(1004, latestBlockTime, false))
+	clockDrift, ok = data.(*types.ClockDrift)
+	require.True(t, ok)
+	assert.InDelta(t, 10, clockDrift.BlockTimeOffset.Seconds(), 0.5)
+}
+
+func TestClockDriftFetcherNtpFail(t *testing.T) {
+	t.Parallel()
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	ntpClient := ntp.NewClient(conn.LocalAddr().String(), *logger, tracer)
+	ntpClient.Timeout = 100 * time.Millisecond
+	fetcher := NewClockDriftFetcher(*logger, nil, ntpClient, tracer)
+
+	data, queryInfos := fetcher.Get(context.Background(), nil)
+	assert.Len(t, queryInfos, 1)
+	assert.False(t, queryInfos[0].Success)
+	assert.Nil(t, data)
+}
+
+func TestClockDriftFetcherNtpOk(t *testing.T) {
+	t.Parallel()
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	// echoing the request back as a server response with the same timestamps,
+	// so the offset is close to zero
+	go func() {
+		packet := make([]byte, 48)
+		_, addr, err := conn.ReadFrom(packet)
+		if err != nil {
+			return
+		}
+
+		response := make([]byte, 48)
+		response[0] = 0x24
+		response[1] = 1
+		copy(response[24:32], packet[40:48])
+		copy(response[32:40], packet[40:48])
+		copy(response[40:48], packet[40:48])
+		_, _ = conn.WriteTo(response, addr)
+	}()
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	ntpClient := ntp.NewClient(conn.LocalAddr().String(), *logger, tracer)
+	fetcher := NewClockDriftFetcher(*logger, nil, ntpClient, tracer)
+
+	data, queryInfos := fetcher.Get(context.Background(), nil)
+	assert.Len(t, queryInfos, 1)
+	assert.True(t, queryInfos[0].Success)
+
+	clockDrift, ok := data.(*types.ClockDrift)
+	require.True(t, ok)
+	assert.False(t, clockDrift.HasBlockTimeOffset)
+	assert.True(t, clockDrift.HasNtpOffset)
+	assert.InDelta(t, 0, clockDrift.NtpOffset.Seconds(), 0.1)
+}
@@ -0,0 +1,71 @@
+package fetchers
+
+import (
+	"context"
+	"main/pkg/clients/config_files"
+	"main/pkg/constants"
+	"main/pkg/query_info"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/rs/zerolog"
+)
+
+type ConfigFilesFetcher struct {
+	ConfigFiles *config_files.Client
+	Logger      zerolog.Logger
+	Tracer      trace.Tracer
+}
+
+func NewConfigFilesFetcher(
+	logger zerolog.Logger,
+	configFiles *config_files.Client,
+	tracer trace.Tracer,
+) *ConfigFilesFetcher {
+	return &ConfigFilesFetcher{
+		Logger:      logger.With().Str("component", "config_files_fetcher").Logger(),
+		ConfigFiles: configFiles,
+		Tracer:      tracer,
+	}
+}
+
+func (n *ConfigFilesFetcher) Enabled() bool {
+	return n.ConfigFiles != nil
+}
+
+func (n *ConfigFilesFetcher) Name() constants.FetcherName {
+	return constants.FetcherNameConfigFiles
+}
+
+func (n *ConfigFilesFetcher) Dependencies() []constants.FetcherName {
+	return []constants.FetcherName{}
+}
+
+func (n *ConfigFilesFetcher) Get(ctx context.Context, data ...interface{}) (interface{}, []query_info.QueryInfo) {
+	childCtx, span := n.Tracer.Start(
+		ctx,
+		"Fetcher "+string(n.Name()),
+	)
+	defer span.End()
+
+	configToml, configQueryInfo, configErr := n.ConfigFiles.GetConfigToml(childCtx)
+	if configErr != nil {
+		n.Logger.Error().Err(configErr).Msg("Could not get node config.toml")
+	}
+
+	appToml, appQueryInfo, appErr := n.ConfigFiles.GetAppToml(childCtx)
+	if appErr != nil {
+		n.Logger.Error().Err(appErr).Msg("Could not get node app.toml")
+	}
+
+	queryInfos := []query_info.QueryInfo{configQueryInfo, appQueryInfo}
+
+	if configToml == nil && appToml == nil {
+		return nil, queryInfos
+	}
+
+	return &config_files.NodeConfigFiles{
+		Config: configToml,
+		App:    appToml,
+	}, queryInfos
+}
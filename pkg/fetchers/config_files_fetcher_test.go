@@ -0,0 +1,92 @@
+package fetchers
+
+import (
+	"context"
+	"main/pkg/clients/config_files"
+	"main/pkg/constants"
+	"main/pkg/fs"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/tracing"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigFilesFetcherBase(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+
+	fetcher := NewConfigFilesFetcher(*logger, nil, tracer)
+	assert.False(t, fetcher.Enabled())
+	assert.Equal(t, constants.FetcherNameConfigFiles, fetcher.Name())
+	assert.Empty(t, fetcher.Dependencies())
+}
+
+func TestConfigFilesFetcherFail(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := config_files.NewClient(t.TempDir(), *logger, tracer)
+
+	fetcher := NewConfigFilesFetcher(*logger, client, tracer)
+	assert.True(t, fetcher.Enabled())
+
+	data, queryInfos := fetcher.Get(context.Background())
+	assert.Len(t, queryInfos, 2)
+	assert.False(t, queryInfos[0].Success)
+	assert.False(t, queryInfos[1].Success)
+	assert.Nil(t, data)
+}
+
+func TestConfigFilesFetcherPartial(t *testing.T) {
+	t.Parallel()
+
+	chainFolder := t.TempDir()
+	require.NoError(t, os.MkdirAll(chainFolder+"/config", 0o755))
+	require.NoError(t, os.WriteFile(chainFolder+"/config/app.toml", []byte("pruning = \"nothing\""), 0o600))
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := config_files.NewClient(chainFolder, *logger, tracer)
+
+	fetcher := NewConfigFilesFetcher(*logger, client, tracer)
+
+	data, queryInfos := fetcher.Get(context.Background())
+	assert.Len(t, queryInfos, 2)
+	assert.False(t, queryInfos[0].Success)
+	assert.True(t, queryInfos[1].Success)
+
+	configFiles, ok := data.(*config_files.NodeConfigFiles)
+	require.True(t, ok)
+	assert.Nil(t, configFiles.Config)
+	require.NotNil(t, configFiles.App)
+	assert.Equal(t, "nothing", configFiles.App.Pruning)
+}
+
+func TestConfigFilesFetcherOk(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := config_files.NewClient("node-home", *logger, tracer)
+	client.Filesystem = &fs.TestFS{}
+
+	fetcher := NewConfigFilesFetcher(*logger, client, tracer)
+
+	data, queryInfos := fetcher.Get(context.Background())
+	assert.Len(t, queryInfos, 2)
+	assert.True(t, queryInfos[0].Success)
+	assert.True(t, queryInfos[1].Success)
+
+	configFiles, ok := data.(*config_files.NodeConfigFiles)
+	require.True(t, ok)
+	require.NotNil(t, configFiles.Config)
+	require.NotNil(t, configFiles.App)
+	assert.Equal(t, "kv", configFiles.Config.TxIndex.Indexer)
+	assert.Equal(t, "custom", configFiles.App.Pruning)
+}
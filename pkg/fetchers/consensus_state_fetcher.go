@@ -0,0 +1,100 @@
+package fetchers
+
+import (
+	"context"
+	"main/pkg/clients/tendermint"
+	"main/pkg/constants"
+	"main/pkg/query_info"
+	"main/pkg/types"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/rs/zerolog"
+)
+
+type ConsensusStateFetcher struct {
+	TendermintRPC *tendermint.RPC
+	Logger        zerolog.Logger
+	Tracer        trace.Tracer
+
+	// the height is tracked across scrapes to detect consensus stalls
+	LastHeight           int64
+	LastHeightChangeTime time.Time
+	Mutex                sync.Mutex
+}
+
+func NewConsensusStateFetcher(
+	logger zerolog.Logger,
+	tendermintRPC *tendermint.RPC,
+	tracer trace.Tracer,
+) *ConsensusStateFetcher {
+	return &ConsensusStateFetcher{
+		Logger:        logger.With().Str("component", "consensus_state_fetcher").Logger(),
+		TendermintRPC: tendermintRPC,
+		Tracer:        tracer,
+	}
+}
+
+func (n *ConsensusStateFetcher) Enabled() bool {
+	return n.TendermintRPC != nil
+}
+
+func (n *ConsensusStateFetcher) Name() constants.FetcherName {
+	return constants.FetcherNameConsensusState
+}
+
+func (n *ConsensusStateFetcher) Dependencies() []constants.FetcherName {
+	return []constants.FetcherName{constants.FetcherNameWebsocketBlocks}
+}
+
+func (n *ConsensusStateFetcher) Get(ctx context.Context, data ...interface{}) (interface{}, []query_info.QueryInfo) {
+	if len(data) < 1 {
+		panic("data is empty")
+	}
+
+	websocketSnapshot, websocketSnapshotConverted := Convert[*tendermint.WebsocketSnapshot](data[0])
+
+	childCtx, span := n.Tracer.Start(
+		ctx,
+		"Fetcher "+string(n.Name()),
+		trace.WithAttributes(attribute.String("node", n.TendermintRPC.Address)),
+	)
+	defer span.End()
+
+	consensusState, queryInfo, err := n.TendermintRPC.GetConsensusState(childCtx)
+	if err != nil {
+		n.Logger.Error().Err(err).Msg("Could not fetch consensus state")
+		return nil, []query_info.QueryInfo{queryInfo}
+	}
+
+	n.Mutex.Lock()
+	defer n.Mutex.Unlock()
+
+	now := time.Now()
+
+	if consensusState.Height != n.LastHeight {
+		n.Logger.Trace().
+			Int64("old_height", n.LastHeight).
+			Int64("new_height", consensusState.Height).
+			Msg("Consensus height has changed")
+
+		n.LastHeight = consensusState.Height
+		n.LastHeightChangeTime = now
+	}
+
+	timeSinceHeightChange := now.Sub(n.LastHeightChangeTime)
+
+	// the live subscription knows exactly when the latest block came in, while polling
+	// only notices a height change on the next scrape
+	if websocketSnapshotConverted && websocketSnapshot.Live() {
+		timeSinceHeightChange = websocketSnapshot.TimeSinceLastBlock
+	}
+
+	return &types.ConsensusStateInfo{
+		State:                 consensusState,
+		TimeSinceHeightChange: timeSinceHeightChange,
+	}, []query_info.QueryInfo{queryInfo}
+}
@@ -0,0 +1,185 @@
+package fetchers
+
+import (
+	"context"
+	"errors"
+	"main/assets"
+	"main/pkg/clients/tendermint"
+	configPkg "main/pkg/config"
+	"main/pkg/constants"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/tracing"
+	"main/pkg/types"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConsensusStateFetcherBase(t *testing.T) {
+	t.Parallel()
+
+	config := configPkg.TendermintConfig{
+		Address: "https://example.com",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := tendermint.NewRPC(config, *logger, tracer)
+	fetcher := NewConsensusStateFetcher(*logger, client, tracer)
+	assert.True(t, fetcher.Enabled())
+	assert.Equal(t, constants.FetcherNameConsensusState, fetcher.Name())
+	assert.Equal(t, []constants.FetcherName{constants.FetcherNameWebsocketBlocks}, fetcher.Dependencies())
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestConsensusStateFetcherFail(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/consensus_state",
+		httpmock.NewErrorResponder(errors.New("custom error")),
+	)
+
+	config := configPkg.TendermintConfig{
+		Address: "https://example.com",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := tendermint.NewRPC(config, *logger, tracer)
+	fetcher := NewConsensusStateFetcher(*logger, client, tracer)
+
+	data, queryInfos := fetcher.Get(context.Background(), nil)
+	assert.Len(t, queryInfos, 1)
+	assert.False(t, queryInfos[0].Success)
+	assert.Nil(t, data)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestConsensusStateFetcherOk(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/consensus_state",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("consensus-state.json")),
+	)
+
+	config := configPkg.TendermintConfig{
+		Address: "https://example.com",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := tendermint.NewRPC(config, *logger, tracer)
+	fetcher := NewConsensusStateFetcher(*logger, client, tracer)
+
+	data, queryInfos := fetcher.Get(context.Background(), nil)
+	assert.Len(t, queryInfos, 1)
+	assert.True(t, queryInfos[0].Success)
+
+	consensusState, ok := data.(*types.ConsensusStateInfo)
+	require.True(t, ok)
+	assert.Equal(t, int64(21077109), consensusState.State.Height)
+	assert.Equal(t, int64(21077109), fetcher.LastHeight)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestConsensusStateFetcherHeightNotChanged(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/consensus_state",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("consensus-state.json")),
+	)
+
+	config := configPkg.TendermintConfig{
+		Address: "https://example.com",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := tendermint.NewRPC(config, *logger, tracer)
+	fetcher := NewConsensusStateFetcher(*logger, client, tracer)
+
+	// simulating the node being stuck at the same height for a minute
+	fetcher.LastHeight = 21077109
+	fetcher.LastHeightChangeTime = time.Now().Add(-time.Minute)
+
+	data, queryInfos := fetcher.Get(context.Background(), nil)
+	assert.Len(t, queryInfos, 1)
+	assert.True(t, queryInfos[0].Success)
+
+	consensusState, ok := data.(*types.ConsensusStateInfo)
+	require.True(t, ok)
+	assert.GreaterOrEqual(t, consensusState.TimeSinceHeightChange, time.Minute)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestConsensusStateFetcherHeightChanged(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/consensus_state",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("consensus-state.json")),
+	)
+
+	config := configPkg.TendermintConfig{
+		Address: "https://example.com",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := tendermint.NewRPC(config, *logger, tracer)
+	fetcher := NewConsensusStateFetcher(*logger, client, tracer)
+
+	fetcher.LastHeight = 21077108
+	fetcher.LastHeightChangeTime = time.Now().Add(-time.Minute)
+
+	data, queryInfos := fetcher.Get(context.Background(), nil)
+	assert.Len(t, queryInfos, 1)
+	assert.True(t, queryInfos[0].Success)
+
+	consensusState, ok := data.(*types.ConsensusStateInfo)
+	require.True(t, ok)
+	assert.Less(t, consensusState.TimeSinceHeightChange, time.Minute)
+	assert.Equal(t, int64(21077109), fetcher.LastHeight)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestConsensusStateFetcherWebsocket(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/consensus_state",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("consensus-state.json")),
+	)
+
+	config := configPkg.TendermintConfig{
+		Address: "https://example.com",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := tendermint.NewRPC(config, *logger, tracer)
+	fetcher := NewConsensusStateFetcher(*logger, client, tracer)
+
+	// polling has just noticed the height change, but the block came in a while ago
+	data, queryInfos := fetcher.Get(context.Background(), &tendermint.WebsocketSnapshot{
+		Connected:          true,
+		Headers:            []tendermint.BlockHeader{{Height: 21077108}},
+		TimeSinceLastBlock: 2 * time.Minute,
+	})
+	assert.Len(t, queryInfos, 1)
+	assert.True(t, queryInfos[0].Success)
+
+	consensusState, ok := data.(*types.ConsensusStateInfo)
+	require.True(t, ok)
+	assert.Equal(t, 2*time.Minute, consensusState.TimeSinceHeightChange)
+}
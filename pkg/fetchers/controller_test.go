@@ -19,8 +19,9 @@ func TestControllerFetcherNotEnabled(t *testing.T) {
 	logger := loggerPkg.GetDefaultLogger()
 	tracer := tracing.InitNoopTracer()
 	fetcher := NewNodeStatusFetcher(*logger, nil, tracer)
+	websocketFetcher := NewWebsocketBlocksFetcher(*logger, nil, tracer)
 
-	controller := NewController(Fetchers{fetcher}, *logger, "chain")
+	controller := NewController(Fetchers{fetcher, websocketFetcher}, *logger, "chain")
 
 	data, queryInfos := controller.Fetch(context.Background())
 	assert.Empty(t, queryInfos)
@@ -46,7 +47,8 @@ func TestControllerFetcherEnabled(t *testing.T) {
 	tracer := tracing.InitNoopTracer()
 	client := tendermint.NewRPC(config, *logger, tracer)
 	fetcher := NewNodeStatusFetcher(*logger, client, tracer)
-	controller := NewController(Fetchers{fetcher}, *logger, "chain")
+	websocketFetcher := NewWebsocketBlocksFetcher(*logger, nil, tracer)
+	controller := NewController(Fetchers{fetcher, websocketFetcher}, *logger, "chain")
 
 	data, queryInfos := controller.Fetch(context.Background())
 	assert.Len(t, queryInfos, 1)
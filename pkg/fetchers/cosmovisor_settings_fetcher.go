@@ -0,0 +1,64 @@
+package fetchers
+
+import (
+	"context"
+	cosmovisorPkg "main/pkg/clients/cosmovisor"
+	"main/pkg/constants"
+	"main/pkg/query_info"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/rs/zerolog"
+)
+
+type CosmovisorSettingsFetcher struct {
+	Logger     zerolog.Logger
+	Cosmovisor *cosmovisorPkg.Cosmovisor
+	Tracer     trace.Tracer
+}
+
+func NewCosmovisorSettingsFetcher(
+	logger zerolog.Logger,
+	cosmovisor *cosmovisorPkg.Cosmovisor,
+	tracer trace.Tracer,
+) *CosmovisorSettingsFetcher {
+	return &CosmovisorSettingsFetcher{
+		Logger:     logger.With().Str("component", "cosmovisor_settings").Logger(),
+		Cosmovisor: cosmovisor,
+		Tracer:     tracer,
+	}
+}
+
+func (v *CosmovisorSettingsFetcher) Enabled() bool {
+	return v.Cosmovisor != nil
+}
+
+func (v *CosmovisorSettingsFetcher) Name() constants.FetcherName {
+	return constants.FetcherNameCosmovisorSettings
+}
+
+func (v *CosmovisorSettingsFetcher) Dependencies() []constants.FetcherName {
+	return []constants.FetcherName{}
+}
+
+func (v *CosmovisorSettingsFetcher) Get(ctx context.Context, data ...interface{}) (interface{}, []query_info.QueryInfo) {
+	childCtx, span := v.Tracer.Start(
+		ctx,
+		"CosmovisorSettingsFetcher "+string(v.Name()),
+		trace.WithAttributes(attribute.String("node", string(v.Name()))),
+	)
+	defer span.End()
+
+	cosmovisorSettings, queryInfo, err := v.Cosmovisor.GetSettings(childCtx)
+	if err != nil {
+		v.Logger.Err(err).Msg("Could not get Cosmovisor settings")
+		return nil, []query_info.QueryInfo{queryInfo}
+	}
+
+	if cosmovisorSettings == nil {
+		return nil, []query_info.QueryInfo{queryInfo}
+	}
+
+	return cosmovisorSettings, []query_info.QueryInfo{queryInfo}
+}
@@ -0,0 +1,108 @@
+package fetchers
+
+import (
+	"context"
+	"main/pkg/clients/cosmovisor"
+	configPkg "main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/fs"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/tracing"
+	"main/pkg/types"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestCosmovisorSettingsFetcherBase(t *testing.T) {
+	t.Parallel()
+
+	config := configPkg.CosmovisorConfig{
+		Enabled:         null.BoolFrom(true),
+		ChainBinaryName: "gaiad",
+		ChainFolder:     "",
+		CosmovisorPath:  "/home/validator/go/bin/cosmovisor",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := cosmovisor.NewCosmovisor(config, *logger, tracer)
+
+	fetcher := NewCosmovisorSettingsFetcher(*logger, client, tracer)
+	assert.True(t, fetcher.Enabled())
+	assert.Equal(t, constants.FetcherNameCosmovisorSettings, fetcher.Name())
+	assert.Empty(t, fetcher.Dependencies())
+}
+
+func TestCosmovisorSettingsFetcherFail(t *testing.T) {
+	t.Parallel()
+
+	chainFolder := t.TempDir()
+	require.NoError(t, os.MkdirAll(chainFolder+"/cosmovisor", 0o755))
+	require.NoError(t, os.WriteFile(chainFolder+"/cosmovisor/config.toml", []byte("invalid"), 0o600))
+
+	config := configPkg.CosmovisorConfig{
+		Enabled:         null.BoolFrom(true),
+		ChainBinaryName: "gaiad",
+		ChainFolder:     chainFolder,
+		CosmovisorPath:  "/home/validator/go/bin/cosmovisor",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := cosmovisor.NewCosmovisor(config, *logger, tracer)
+
+	fetcher := NewCosmovisorSettingsFetcher(*logger, client, tracer)
+	data, queryInfos := fetcher.Get(context.Background())
+	assert.Len(t, queryInfos, 1)
+	assert.False(t, queryInfos[0].Success)
+	assert.Nil(t, data)
+}
+
+func TestCosmovisorSettingsFetcherNotPresent(t *testing.T) {
+	t.Parallel()
+
+	config := configPkg.CosmovisorConfig{
+		Enabled:         null.BoolFrom(true),
+		ChainBinaryName: "gaiad",
+		ChainFolder:     t.TempDir(),
+		CosmovisorPath:  "/home/validator/go/bin/cosmovisor",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := cosmovisor.NewCosmovisor(config, *logger, tracer)
+
+	fetcher := NewCosmovisorSettingsFetcher(*logger, client, tracer)
+	data, queryInfos := fetcher.Get(context.Background())
+	assert.Len(t, queryInfos, 1)
+	assert.True(t, queryInfos[0].Success)
+	assert.Nil(t, data)
+}
+
+func TestCosmovisorSettingsFetcherOk(t *testing.T) {
+	t.Parallel()
+
+	config := configPkg.CosmovisorConfig{
+		Enabled:         null.BoolFrom(true),
+		ChainBinaryName: "gaiad",
+		ChainFolder:     "",
+		CosmovisorPath:  "/home/validator/go/bin/cosmovisor",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := cosmovisor.NewCosmovisor(config, *logger, tracer)
+	client.UpgradeSubfolderPath = "cosmovisor/upgrades"
+	client.Filesystem = &fs.TestFS{}
+
+	fetcher := NewCosmovisorSettingsFetcher(*logger, client, tracer)
+	data, queryInfos := fetcher.Get(context.Background())
+	assert.Len(t, queryInfos, 1)
+	assert.True(t, queryInfos[0].Success)
+
+	settings, ok := data.(*types.CosmovisorSettings)
+	require.True(t, ok)
+	assert.False(t, settings.RestartAfterUpgrade)
+	assert.Equal(t, 300*time.Millisecond, settings.PollInterval)
+}
@@ -0,0 +1,60 @@
+package fetchers
+
+import (
+	"context"
+	cosmovisorPkg "main/pkg/clients/cosmovisor"
+	"main/pkg/constants"
+	"main/pkg/query_info"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/rs/zerolog"
+)
+
+type CosmovisorStateFetcher struct {
+	Logger     zerolog.Logger
+	Cosmovisor *cosmovisorPkg.Cosmovisor
+	Tracer     trace.Tracer
+}
+
+func NewCosmovisorStateFetcher(
+	logger zerolog.Logger,
+	cosmovisor *cosmovisorPkg.Cosmovisor,
+	tracer trace.Tracer,
+) *CosmovisorStateFetcher {
+	return &CosmovisorStateFetcher{
+		Logger:     logger.With().Str("component", "cosmovisor_state").Logger(),
+		Cosmovisor: cosmovisor,
+		Tracer:     tracer,
+	}
+}
+
+func (v *CosmovisorStateFetcher) Enabled() bool {
+	return v.Cosmovisor != nil
+}
+
+func (v *CosmovisorStateFetcher) Name() constants.FetcherName {
+	return constants.FetcherNameCosmovisorState
+}
+
+func (v *CosmovisorStateFetcher) Dependencies() []constants.FetcherName {
+	return []constants.FetcherName{}
+}
+
+func (v *CosmovisorStateFetcher) Get(ctx context.Context, data ...interface{}) (interface{}, []query_info.QueryInfo) {
+	childCtx, span := v.Tracer.Start(
+		ctx,
+		"CosmovisorStateFetcher "+string(v.Name()),
+		trace.WithAttributes(attribute.String("node", string(v.Name()))),
+	)
+	defer span.End()
+
+	cosmovisorState, queryInfo, err := v.Cosmovisor.GetState(childCtx)
+	if err != nil {
+		v.Logger.Err(err).Msg("Could not get Cosmovisor state")
+		return nil, []query_info.QueryInfo{queryInfo}
+	}
+
+	return cosmovisorState, []query_info.QueryInfo{queryInfo}
+}
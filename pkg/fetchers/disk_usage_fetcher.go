@@ -0,0 +1,96 @@
+package fetchers
+
+import (
+	"context"
+	"main/pkg/clients/disk"
+	"main/pkg/constants"
+	"main/pkg/query_info"
+	"main/pkg/types"
+	"sync"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/rs/zerolog"
+)
+
+type DiskUsageFetcher struct {
+	Disk   *disk.Client
+	Logger zerolog.Logger
+	Tracer trace.Tracer
+
+	// directory sizes are sampled across walks to calculate the growth rate
+	Samples map[string][]types.SizeSample
+	Mutex   sync.Mutex
+}
+
+func NewDiskUsageFetcher(
+	logger zerolog.Logger,
+	diskClient *disk.Client,
+	tracer trace.Tracer,
+) *DiskUsageFetcher {
+	return &DiskUsageFetcher{
+		Logger:  logger.With().Str("component", "disk_usage_fetcher").Logger(),
+		Disk:    diskClient,
+		Tracer:  tracer,
+		Samples: map[string][]types.SizeSample{},
+	}
+}
+
+func (n *DiskUsageFetcher) Enabled() bool {
+	return n.Disk != nil
+}
+
+func (n *DiskUsageFetcher) Name() constants.FetcherName {
+	return constants.FetcherNameDiskUsage
+}
+
+func (n *DiskUsageFetcher) Dependencies() []constants.FetcherName {
+	return []constants.FetcherName{}
+}
+
+func (n *DiskUsageFetcher) Get(ctx context.Context, data ...interface{}) (interface{}, []query_info.QueryInfo) {
+	childCtx, span := n.Tracer.Start(
+		ctx,
+		"Fetcher "+string(n.Name()),
+	)
+	defer span.End()
+
+	directories, queryInfo, err := n.Disk.GetUsage(childCtx)
+	if err != nil {
+		n.Logger.Error().Err(err).Msg("Could not get disk usage")
+		return nil, []query_info.QueryInfo{queryInfo}
+	}
+
+	n.Mutex.Lock()
+	defer n.Mutex.Unlock()
+
+	for index, directory := range directories {
+		if !directory.Present {
+			delete(n.Samples, directory.Name)
+			continue
+		}
+
+		// the first walk has not finished yet
+		if !directory.HasSize {
+			continue
+		}
+
+		samples := n.Samples[directory.Name]
+
+		// sizes are cached between walks, so only a new walk gives a new sample
+		if len(samples) == 0 || directory.WalkedAt.After(samples[len(samples)-1].Time) {
+			samples = append(samples, types.SizeSample{Size: directory.Size, Time: directory.WalkedAt})
+		}
+
+		if len(samples) > constants.DiskUsageSamplesCount {
+			samples = samples[len(samples)-constants.DiskUsageSamplesCount:]
+		}
+
+		n.Samples[directory.Name] = samples
+		directories[index].GrowthPerDay, directories[index].HasGrowth = types.NewGrowthPerDay(samples)
+	}
+
+	types.EstimateTimeTillFull(directories)
+
+	return directories, []query_info.QueryInfo{queryInfo}
+}
@@ -0,0 +1,105 @@
+package fetchers
+
+import (
+	"context"
+	"main/pkg/clients/disk"
+	"main/pkg/constants"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/tracing"
+	"main/pkg/types"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiskUsageFetcherBase(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+
+	fetcher := NewDiskUsageFetcher(*logger, nil, tracer)
+	assert.False(t, fetcher.Enabled())
+	assert.Equal(t, constants.FetcherNameDiskUsage, fetcher.Name())
+	assert.Empty(t, fetcher.Dependencies())
+}
+
+func TestDiskUsageFetcherFail(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := disk.NewClient("invalid\x00path", *logger, tracer)
+
+	fetcher := NewDiskUsageFetcher(*logger, client, tracer)
+	assert.True(t, fetcher.Enabled())
+
+	data, queryInfos := fetcher.Get(context.Background())
+	assert.Len(t, queryInfos, 1)
+	assert.False(t, queryInfos[0].Success)
+	assert.Nil(t, data)
+}
+
+func TestDiskUsageFetcherOk(t *testing.T) {
+	t.Parallel()
+
+	chainFolder := t.TempDir()
+	require.NoError(t, os.MkdirAll(chainFolder+"/data", 0o755))
+	require.NoError(t, os.WriteFile(chainFolder+"/data/blockstore.db", make([]byte, 1000), 0o600))
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := disk.NewClient(chainFolder, *logger, tracer)
+	client.Directories = []string{"data", "wasm"}
+
+	fetcher := NewDiskUsageFetcher(*logger, client, tracer)
+
+	// the first walk has not finished yet, so there's no sample
+	data, queryInfos := fetcher.Get(context.Background())
+	assert.Len(t, queryInfos, 1)
+	assert.True(t, queryInfos[0].Success)
+
+	directories, ok := data.([]types.DirectoryUsage)
+	require.True(t, ok)
+	require.Len(t, directories, 2)
+
+	// the first walk, there's nothing to compare the size with
+	require.Eventually(t, func() bool {
+		data, _ = fetcher.Get(context.Background())
+		directories, ok = data.([]types.DirectoryUsage)
+		return ok && directories[0].HasSize
+	}, time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, int64(1000), directories[0].Size)
+	assert.False(t, directories[0].HasGrowth)
+	assert.False(t, directories[0].HasSecondsTillFull)
+	assert.False(t, directories[1].Present)
+
+	// the size is cached, so no new sample is taken
+	data, _ = fetcher.Get(context.Background())
+	directories, ok = data.([]types.DirectoryUsage)
+	require.True(t, ok)
+	assert.False(t, directories[0].HasGrowth)
+	assert.Len(t, fetcher.Samples["data"], 1)
+
+	// pretending the first walk was done a day ago
+	fetcher.Samples["data"][0].Time = time.Now().Add(-24 * time.Hour)
+	client.WalkInterval = 0
+	require.NoError(t, os.WriteFile(chainFolder+"/data/state.db", make([]byte, 500), 0o600))
+
+	require.Eventually(t, func() bool {
+		data, _ = fetcher.Get(context.Background())
+		directories, ok = data.([]types.DirectoryUsage)
+		return ok && directories[0].Size == 1500
+	}, time.Second, 10*time.Millisecond)
+
+	assert.GreaterOrEqual(t, len(fetcher.Samples["data"]), 2)
+	assert.True(t, directories[0].HasGrowth)
+	assert.InDelta(t, 500, directories[0].GrowthPerDay, 1)
+	assert.True(t, directories[0].HasSecondsTillFull)
+	assert.Positive(t, directories[0].SecondsTillFull)
+	assert.False(t, directories[1].HasGrowth)
+}
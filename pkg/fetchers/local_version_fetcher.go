@@ -2,9 +2,11 @@ package fetchers
 
 import (
 	"context"
+	binaryPkg "main/pkg/clients/binary"
 	cosmovisorPkg "main/pkg/clients/cosmovisor"
 	"main/pkg/constants"
 	"main/pkg/query_info"
+	"main/pkg/types"
 
 	"go.opentelemetry.io/otel/trace"
 
@@ -13,6 +15,7 @@ import (
 
 type LocalVersionFetcher struct {
 	Cosmovisor *cosmovisorPkg.Cosmovisor
+	Binary     *binaryPkg.Binary
 	Logger     zerolog.Logger
 	Tracer     trace.Tracer
 }
@@ -20,17 +23,19 @@ type LocalVersionFetcher struct {
 func NewLocalVersionFetcher(
 	logger zerolog.Logger,
 	cosmovisor *cosmovisorPkg.Cosmovisor,
+	binary *binaryPkg.Binary,
 	tracer trace.Tracer,
 ) *LocalVersionFetcher {
 	return &LocalVersionFetcher{
 		Logger:     logger.With().Str("component", "local_version_fetcher").Logger(),
 		Cosmovisor: cosmovisor,
+		Binary:     binary,
 		Tracer:     tracer,
 	}
 }
 
 func (n *LocalVersionFetcher) Enabled() bool {
-	return n.Cosmovisor != nil
+	return n.Cosmovisor != nil || n.Binary != nil
 }
 
 func (n *LocalVersionFetcher) Name() constants.FetcherName {
@@ -48,9 +53,22 @@ func (f *LocalVersionFetcher) Get(ctx context.Context, data ...interface{}) (int
 	)
 	defer span.End()
 
-	versionInfo, queryInfo, cosmovisorErr := f.Cosmovisor.GetVersion(childCtx)
-	if cosmovisorErr != nil {
-		f.Logger.Err(cosmovisorErr).Msg("Could not get app version")
+	var (
+		versionInfo types.VersionInfo
+		queryInfo   query_info.QueryInfo
+		err         error
+	)
+
+	// Cosmovisor is enabled by default, so the binary, which has to be configured
+	// explicitly, takes precedence over it
+	if f.Binary != nil {
+		versionInfo, queryInfo, err = f.Binary.GetVersion(childCtx)
+	} else {
+		versionInfo, queryInfo, err = f.Cosmovisor.GetVersion(childCtx)
+	}
+
+	if err != nil {
+		f.Logger.Err(err).Msg("Could not get app version")
 		return nil, []query_info.QueryInfo{queryInfo}
 	}
 
@@ -3,6 +3,7 @@ package fetchers
 import (
 	"context"
 	"main/assets"
+	binaryPkg "main/pkg/clients/binary"
 	cosmovisorPkg "main/pkg/clients/cosmovisor"
 	configPkg "main/pkg/config"
 	"main/pkg/constants"
@@ -21,7 +22,7 @@ func TestLocalVersionFetcherBase(t *testing.T) {
 
 	logger := loggerPkg.GetNopLogger()
 	tracer := tracing.InitNoopTracer()
-	fetcher := NewLocalVersionFetcher(*logger, nil, tracer)
+	fetcher := NewLocalVersionFetcher(*logger, nil, nil, tracer)
 	assert.False(t, fetcher.Enabled())
 	assert.Equal(t, constants.FetcherNameLocalVersion, fetcher.Name())
 	assert.Empty(t, fetcher.Dependencies())
@@ -41,7 +42,7 @@ func TestLocalVersionFetcherCosmovisorFail(t *testing.T) {
 	cosmovisor := cosmovisorPkg.NewCosmovisor(config, *logger, tracer)
 	cosmovisor.CommandExecutor = &exec.TestCommandExecutor{Expected: assets.GetBytesOrPanic("invalid.toml")}
 
-	fetcher := NewLocalVersionFetcher(*logger, cosmovisor, tracer)
+	fetcher := NewLocalVersionFetcher(*logger, cosmovisor, nil, tracer)
 
 	data, queryInfos := fetcher.Get(context.Background())
 	assert.Len(t, queryInfos, 1)
@@ -63,7 +64,50 @@ func TestLocalVersionFetcherCosmovisorOk(t *testing.T) {
 	cosmovisor := cosmovisorPkg.NewCosmovisor(config, *logger, tracer)
 	cosmovisor.CommandExecutor = &exec.TestCommandExecutor{Expected: assets.GetBytesOrPanic("cosmovisor-app-version-ok.txt")}
 
-	fetcher := NewLocalVersionFetcher(*logger, cosmovisor, tracer)
+	fetcher := NewLocalVersionFetcher(*logger, cosmovisor, nil, tracer)
+
+	data, queryInfos := fetcher.Get(context.Background())
+	assert.Len(t, queryInfos, 1)
+	assert.True(t, queryInfos[0].Success)
+	assert.Equal(t, types.VersionInfo{Name: "decentr", Version: "1.6.4"}, data)
+}
+
+func TestLocalVersionFetcherBinaryFail(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	binary := binaryPkg.NewBinary(configPkg.BinaryConfig{Path: "/usr/bin/gaiad"}, *logger, tracer)
+	binary.CommandExecutor = &exec.TestCommandExecutor{Fail: true}
+
+	fetcher := NewLocalVersionFetcher(*logger, nil, binary, tracer)
+	assert.True(t, fetcher.Enabled())
+
+	data, queryInfos := fetcher.Get(context.Background())
+	assert.Len(t, queryInfos, 1)
+	assert.False(t, queryInfos[0].Success)
+	assert.Empty(t, data)
+}
+
+func TestLocalVersionFetcherBinaryOk(t *testing.T) {
+	t.Parallel()
+
+	config := configPkg.CosmovisorConfig{
+		Enabled:         null.BoolFrom(true),
+		ChainBinaryName: "gaiad",
+		ChainFolder:     "/home/validator/.gaia",
+		CosmovisorPath:  "/home/validator/go/bin/cosmovisor",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	cosmovisor := cosmovisorPkg.NewCosmovisor(config, *logger, tracer)
+	cosmovisor.CommandExecutor = &exec.TestCommandExecutor{Fail: true}
+
+	binary := binaryPkg.NewBinary(configPkg.BinaryConfig{Path: "/usr/bin/gaiad"}, *logger, tracer)
+	binary.CommandExecutor = &exec.TestCommandExecutor{Expected: assets.GetBytesOrPanic("cosmovisor-app-version-ok.txt")}
+
+	// the binary takes precedence over Cosmovisor, which would fail here
+	fetcher := NewLocalVersionFetcher(*logger, cosmovisor, binary, tracer)
 
 	data, queryInfos := fetcher.Get(context.Background())
 	assert.Len(t, queryInfos, 1)
@@ -5,6 +5,7 @@ import (
 	grpcPkg "main/pkg/clients/grpc"
 	configPkg "main/pkg/config"
 	"main/pkg/constants"
+	"main/pkg/failover"
 	loggerPkg "main/pkg/logger"
 	"main/pkg/tracing"
 	"testing"
@@ -48,9 +49,10 @@ func TestNodeConfigFetcherFail(t *testing.T) {
 	)
 	require.NoError(t, err)
 	client := &grpcPkg.Client{
-		Logger: *logger,
-		Client: grpcConn,
-		Tracer: tracer,
+		Logger:      *logger,
+		Connections: map[string]*grpc.ClientConn{"localhost:9090": grpcConn},
+		Failover:    failover.NewFailover([]string{"localhost:9090"}, constants.FailoverPrimaryCooldown),
+		Tracer:      tracer,
 	}
 
 	fetcher := NewNodeConfigFetcher(*logger, client, tracer)
@@ -74,9 +76,10 @@ func TestNodeConfigFetcherError(t *testing.T) {
 	)
 	require.NoError(t, err)
 	client := &grpcPkg.Client{
-		Logger: *logger,
-		Client: grpcConn,
-		Tracer: tracer,
+		Logger:      *logger,
+		Connections: map[string]*grpc.ClientConn{"localhost:9090": grpcConn},
+		Failover:    failover.NewFailover([]string{"localhost:9090"}, constants.FailoverPrimaryCooldown),
+		Tracer:      tracer,
 	}
 
 	fetcher := NewNodeConfigFetcher(*logger, client, tracer)
@@ -100,9 +103,10 @@ func TestNodeConfigFetcherNotImplemented(t *testing.T) {
 	)
 	require.NoError(t, err)
 	client := &grpcPkg.Client{
-		Logger: *logger,
-		Client: grpcConn,
-		Tracer: tracer,
+		Logger:      *logger,
+		Connections: map[string]*grpc.ClientConn{"localhost:9090": grpcConn},
+		Failover:    failover.NewFailover([]string{"localhost:9090"}, constants.FailoverPrimaryCooldown),
+		Tracer:      tracer,
 	}
 
 	fetcher := NewNodeConfigFetcher(*logger, client, tracer)
@@ -138,9 +142,10 @@ func TestNodeConfigFetcherOk(t *testing.T) {
 	)
 	require.NoError(t, err)
 	client := &grpcPkg.Client{
-		Logger: *logger,
-		Client: grpcConn,
-		Tracer: tracer,
+		Logger:      *logger,
+		Connections: map[string]*grpc.ClientConn{"localhost:9090": grpcConn},
+		Failover:    failover.NewFailover([]string{"localhost:9090"}, constants.FailoverPrimaryCooldown),
+		Tracer:      tracer,
 	}
 
 	fetcher := NewNodeConfigFetcher(*logger, client, tracer)
@@ -5,6 +5,7 @@ import (
 	grpcPkg "main/pkg/clients/grpc"
 	configPkg "main/pkg/config"
 	"main/pkg/constants"
+	"main/pkg/failover"
 	loggerPkg "main/pkg/logger"
 	"main/pkg/tracing"
 	"testing"
@@ -47,9 +48,10 @@ func TestNodeInfoFetcherFail(t *testing.T) {
 	)
 	require.NoError(t, err)
 	client := &grpcPkg.Client{
-		Logger: *logger,
-		Client: grpcConn,
-		Tracer: tracer,
+		Logger:      *logger,
+		Connections: map[string]*grpc.ClientConn{"localhost:9090": grpcConn},
+		Failover:    failover.NewFailover([]string{"localhost:9090"}, constants.FailoverPrimaryCooldown),
+		Tracer:      tracer,
 	}
 
 	fetcher := NewNodeInfoFetcher(*logger, client, tracer)
@@ -73,9 +75,10 @@ func TestNodeInfoFetcherError(t *testing.T) {
 	)
 	require.NoError(t, err)
 	client := &grpcPkg.Client{
-		Logger: *logger,
-		Client: grpcConn,
-		Tracer: tracer,
+		Logger:      *logger,
+		Connections: map[string]*grpc.ClientConn{"localhost:9090": grpcConn},
+		Failover:    failover.NewFailover([]string{"localhost:9090"}, constants.FailoverPrimaryCooldown),
+		Tracer:      tracer,
 	}
 
 	fetcher := NewNodeInfoFetcher(*logger, client, tracer)
@@ -121,9 +124,10 @@ func TestNodeInfoFetcherOk(t *testing.T) {
 	)
 	require.NoError(t, err)
 	client := &grpcPkg.Client{
-		Logger: *logger,
-		Client: grpcConn,
-		Tracer: tracer,
+		Logger:      *logger,
+		Connections: map[string]*grpc.ClientConn{"localhost:9090": grpcConn},
+		Failover:    failover.NewFailover([]string{"localhost:9090"}, constants.FailoverPrimaryCooldown),
+		Tracer:      tracer,
 	}
 
 	fetcher := NewNodeInfoFetcher(*logger, client, tracer)
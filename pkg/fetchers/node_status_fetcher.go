@@ -35,10 +35,16 @@ func (n *NodeStatusFetcher) Name() constants.FetcherName {
 }
 
 func (n *NodeStatusFetcher) Dependencies() []constants.FetcherName {
-	return []constants.FetcherName{}
+	return []constants.FetcherName{constants.FetcherNameWebsocketBlocks}
 }
 
 func (n *NodeStatusFetcher) Get(ctx context.Context, data ...interface{}) (interface{}, []query_info.QueryInfo) {
+	if len(data) < 1 {
+		panic("data is empty")
+	}
+
+	websocketSnapshot, websocketSnapshotConverted := Convert[*tendermint.WebsocketSnapshot](data[0])
+
 	childCtx, span := n.Tracer.Start(
 		ctx,
 		"Fetcher "+string(n.Name()),
@@ -52,5 +58,20 @@ func (n *NodeStatusFetcher) Get(ctx context.Context, data ...interface{}) (inter
 		return nil, []query_info.QueryInfo{queryInfo}
 	}
 
+	// the live subscription gets new blocks as soon as they are committed,
+	// so its latest block is preferred over the polled one if it's newer
+	if websocketSnapshotConverted && websocketSnapshot.Live() {
+		header, _ := websocketSnapshot.LatestHeader()
+		if header.Height > status.Result.SyncInfo.LatestBlockHeight {
+			n.Logger.Trace().
+				Int64("polled_height", status.Result.SyncInfo.LatestBlockHeight).
+				Int64("websocket_height", header.Height).
+				Msg("Using latest block from websocket")
+
+			status.Result.SyncInfo.LatestBlockHeight = header.Height
+			status.Result.SyncInfo.LatestBlockTime = header.Time
+		}
+	}
+
 	return status, []query_info.QueryInfo{queryInfo}
 }
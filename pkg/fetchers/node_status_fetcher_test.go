@@ -10,10 +10,12 @@ import (
 	loggerPkg "main/pkg/logger"
 	"main/pkg/tracing"
 	"testing"
+	"time"
 
 	"github.com/jarcoal/httpmock"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNodeStatsFetcherBase(t *testing.T) {
@@ -28,7 +30,7 @@ func TestNodeStatsFetcherBase(t *testing.T) {
 	fetcher := NewNodeStatusFetcher(*logger, client, tracer)
 	assert.True(t, fetcher.Enabled())
 	assert.Equal(t, constants.FetcherNameNodeStatus, fetcher.Name())
-	assert.Empty(t, fetcher.Dependencies())
+	assert.Equal(t, []constants.FetcherName{constants.FetcherNameWebsocketBlocks}, fetcher.Dependencies())
 }
 
 //nolint:paralleltest // disabled due to httpmock usage
@@ -50,7 +52,7 @@ func TestNodeStatsFetcherFail(t *testing.T) {
 	client := tendermint.NewRPC(config, *logger, tracer)
 	fetcher := NewNodeStatusFetcher(*logger, client, tracer)
 
-	data, queryInfos := fetcher.Get(context.Background())
+	data, queryInfos := fetcher.Get(context.Background(), nil)
 	assert.Len(t, queryInfos, 1)
 	assert.False(t, queryInfos[0].Success)
 	assert.Nil(t, data)
@@ -75,8 +77,72 @@ func TestNodeStatsFetcherOk(t *testing.T) {
 	client := tendermint.NewRPC(config, *logger, tracer)
 	fetcher := NewNodeStatusFetcher(*logger, client, tracer)
 
-	data, queryInfos := fetcher.Get(context.Background())
+	data, queryInfos := fetcher.Get(context.Background(), nil)
 	assert.Len(t, queryInfos, 1)
 	assert.True(t, queryInfos[0].Success)
 	assert.NotNil(t, data)
 }
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestNodeStatsFetcherWebsocketNewer(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/status",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("status.json")),
+	)
+
+	config := configPkg.TendermintConfig{
+		Address: "https://example.com",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := tendermint.NewRPC(config, *logger, tracer)
+	fetcher := NewNodeStatusFetcher(*logger, client, tracer)
+
+	blockTime := time.Date(2024, 6, 29, 17, 20, 30, 0, time.UTC)
+
+	data, queryInfos := fetcher.Get(context.Background(), &tendermint.WebsocketSnapshot{
+		Connected: true,
+		Headers:   []tendermint.BlockHeader{{Height: 21076917, Time: blockTime}},
+	})
+	assert.Len(t, queryInfos, 1)
+	assert.True(t, queryInfos[0].Success)
+
+	status, ok := data.(tendermint.StatusResponse)
+	require.True(t, ok)
+	assert.Equal(t, int64(21076917), status.Result.SyncInfo.LatestBlockHeight)
+	assert.Equal(t, blockTime, status.Result.SyncInfo.LatestBlockTime)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestNodeStatsFetcherWebsocketDisconnected(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/status",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("status.json")),
+	)
+
+	config := configPkg.TendermintConfig{
+		Address: "https://example.com",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := tendermint.NewRPC(config, *logger, tracer)
+	fetcher := NewNodeStatusFetcher(*logger, client, tracer)
+
+	// the subscription is dead, so its blocks might be outdated
+	data, _ := fetcher.Get(context.Background(), &tendermint.WebsocketSnapshot{
+		Connected: false,
+		Headers:   []tendermint.BlockHeader{{Height: 21076917}},
+	})
+
+	status, ok := data.(tendermint.StatusResponse)
+	require.True(t, ok)
+	assert.Equal(t, int64(21076916), status.Result.SyncInfo.LatestBlockHeight)
+}
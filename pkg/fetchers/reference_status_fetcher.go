@@ -0,0 +1,91 @@
+package fetchers
+
+import (
+	"context"
+	"main/pkg/clients/tendermint"
+	"main/pkg/constants"
+	"main/pkg/query_info"
+	"main/pkg/types"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/rs/zerolog"
+)
+
+type ReferenceStatusFetcher struct {
+	ReferenceRPCs []*tendermint.RPC
+	Logger        zerolog.Logger
+	Tracer        trace.Tracer
+}
+
+func NewReferenceStatusFetcher(
+	logger zerolog.Logger,
+	referenceRPCs []*tendermint.RPC,
+	tracer trace.Tracer,
+) *ReferenceStatusFetcher {
+	return &ReferenceStatusFetcher{
+		Logger:        logger.With().Str("component", "reference_status_fetcher").Logger(),
+		ReferenceRPCs: referenceRPCs,
+		Tracer:        tracer,
+	}
+}
+
+func (n *ReferenceStatusFetcher) Enabled() bool {
+	return len(n.ReferenceRPCs) > 0
+}
+
+func (n *ReferenceStatusFetcher) Name() constants.FetcherName {
+	return constants.FetcherNameReferenceStatus
+}
+
+func (n *ReferenceStatusFetcher) Dependencies() []constants.FetcherName {
+	return []constants.FetcherName{}
+}
+
+func (n *ReferenceStatusFetcher) Get(ctx context.Context, data ...interface{}) (interface{}, []query_info.QueryInfo) {
+	childCtx, span := n.Tracer.Start(
+		ctx,
+		"Fetcher "+string(n.Name()),
+	)
+	defer span.End()
+
+	statuses := make(types.ReferenceStatuses, len(n.ReferenceRPCs))
+	queryInfos := make([]query_info.QueryInfo, len(n.ReferenceRPCs))
+
+	var wg sync.WaitGroup
+
+	for index, rpc := range n.ReferenceRPCs {
+		wg.Add(1)
+
+		go func(index int, rpc *tendermint.RPC) {
+			defer wg.Done()
+
+			queryStart := time.Now()
+			status, queryInfo, err := rpc.Status(childCtx)
+			latency := time.Since(queryStart)
+
+			queryInfos[index] = queryInfo
+			statuses[index] = types.ReferenceStatus{
+				Address: rpc.Address,
+				Latency: latency,
+				Success: err == nil,
+			}
+
+			if err != nil {
+				n.Logger.Warn().
+					Err(err).
+					Str("address", rpc.Address).
+					Msg("Could not fetch reference node status")
+				return
+			}
+
+			statuses[index].Height = status.Result.SyncInfo.LatestBlockHeight
+		}(index, rpc)
+	}
+
+	wg.Wait()
+
+	return statuses, queryInfos
+}
@@ -0,0 +1,80 @@
+package fetchers
+
+import (
+	"context"
+	"errors"
+	"main/assets"
+	"main/pkg/clients/tendermint"
+	configPkg "main/pkg/config"
+	"main/pkg/constants"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/tracing"
+	"main/pkg/types"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReferenceStatusFetcherBase(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := tendermint.NewRPC(configPkg.TendermintConfig{Address: "https://example.com"}, *logger, tracer)
+	fetcher := NewReferenceStatusFetcher(*logger, []*tendermint.RPC{client}, tracer)
+	assert.True(t, fetcher.Enabled())
+	assert.Equal(t, constants.FetcherNameReferenceStatus, fetcher.Name())
+	assert.Empty(t, fetcher.Dependencies())
+}
+
+func TestReferenceStatusFetcherDisabled(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	fetcher := NewReferenceStatusFetcher(*logger, []*tendermint.RPC{}, tracer)
+	assert.False(t, fetcher.Enabled())
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestReferenceStatusFetcherPartialFail(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://reference1.com/status",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("status.json")),
+	)
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://reference2.com/status",
+		httpmock.NewErrorResponder(errors.New("custom error")),
+	)
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	reference1 := tendermint.NewRPC(configPkg.TendermintConfig{Address: "https://reference1.com"}, *logger, tracer)
+	reference2 := tendermint.NewRPC(configPkg.TendermintConfig{Address: "https://reference2.com"}, *logger, tracer)
+	fetcher := NewReferenceStatusFetcher(*logger, []*tendermint.RPC{reference1, reference2}, tracer)
+
+	data, queryInfos := fetcher.Get(context.Background())
+	assert.Len(t, queryInfos, 2)
+	assert.True(t, queryInfos[0].Success)
+	assert.False(t, queryInfos[1].Success)
+
+	statuses, ok := data.(types.ReferenceStatuses)
+	require.True(t, ok)
+	require.Len(t, statuses, 2)
+
+	assert.Equal(t, "https://reference1.com", statuses[0].Address)
+	assert.True(t, statuses[0].Success)
+	assert.Equal(t, int64(21076916), statuses[0].Height)
+
+	assert.Equal(t, "https://reference2.com", statuses[1].Address)
+	assert.False(t, statuses[1].Success)
+	assert.Zero(t, statuses[1].Height)
+}
@@ -2,9 +2,11 @@ package fetchers
 
 import (
 	"context"
+	"main/pkg/clients/chain_registry"
 	"main/pkg/clients/git"
 	"main/pkg/constants"
 	"main/pkg/query_info"
+	"strings"
 
 	"go.opentelemetry.io/otel/trace"
 
@@ -12,21 +14,28 @@ import (
 )
 
 type RemoteVersionFetcher struct {
-	GitClient git.Client
-	Logger    zerolog.Logger
-	Tracer    trace.Tracer
+	GitClient     git.Client
+	ChainRegistry *chain_registry.Client
+	Logger        zerolog.Logger
+	Tracer        trace.Tracer
 }
 
-func NewRemoteVersionFetcher(logger zerolog.Logger, gitClient git.Client, tracer trace.Tracer) *RemoteVersionFetcher {
+func NewRemoteVersionFetcher(
+	logger zerolog.Logger,
+	gitClient git.Client,
+	chainRegistry *chain_registry.Client,
+	tracer trace.Tracer,
+) *RemoteVersionFetcher {
 	return &RemoteVersionFetcher{
-		Logger:    logger.With().Str("component", "remote_version_fetcher").Logger(),
-		GitClient: gitClient,
-		Tracer:    tracer,
+		Logger:        logger.With().Str("component", "remote_version_fetcher").Logger(),
+		GitClient:     gitClient,
+		ChainRegistry: chainRegistry,
+		Tracer:        tracer,
 	}
 }
 
 func (n *RemoteVersionFetcher) Enabled() bool {
-	return n.GitClient != nil
+	return n.GitClient != nil || n.ChainRegistry != nil
 }
 
 func (n *RemoteVersionFetcher) Name() constants.FetcherName {
@@ -34,7 +43,7 @@ func (n *RemoteVersionFetcher) Name() constants.FetcherName {
 }
 
 func (n *RemoteVersionFetcher) Dependencies() []constants.FetcherName {
-	return []constants.FetcherName{}
+	return []constants.FetcherName{constants.FetcherNameChainRegistry}
 }
 
 func (f *RemoteVersionFetcher) Get(ctx context.Context, data ...interface{}) (interface{}, []query_info.QueryInfo) {
@@ -44,15 +53,26 @@ func (f *RemoteVersionFetcher) Get(ctx context.Context, data ...interface{}) (in
 	)
 	defer span.End()
 
+	if len(data) < 1 {
+		panic("data is empty")
+	}
+
+	// chain-registry knows which version the network actually runs,
+	// so it takes precedence over the latest Git release
+	chainInfo, chainInfoFound := Convert[*chain_registry.ChainInfo](data[0])
+	if chainInfoFound && chainInfo.Codebase.RecommendedVersion != "" {
+		return strings.TrimPrefix(chainInfo.Codebase.RecommendedVersion, "v"), []query_info.QueryInfo{}
+	}
+
+	if f.GitClient == nil {
+		return nil, []query_info.QueryInfo{}
+	}
+
 	latestVersion, queryInfo, latestVersionError := f.GitClient.GetLatestRelease(childCtx)
 	if latestVersionError != nil {
 		f.Logger.Err(latestVersionError).Msg("Could not get latest Git version")
 		return nil, []query_info.QueryInfo{queryInfo}
 	}
 
-	if latestVersion[0] == 'v' {
-		latestVersion = latestVersion[1:]
-	}
-
-	return latestVersion, []query_info.QueryInfo{queryInfo}
+	return strings.TrimPrefix(latestVersion, "v"), []query_info.QueryInfo{queryInfo}
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"main/assets"
+	"main/pkg/clients/chain_registry"
 	"main/pkg/clients/git"
 	configPkg "main/pkg/config"
 	"main/pkg/constants"
@@ -15,6 +16,7 @@ import (
 	"github.com/jarcoal/httpmock"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestRemoteVersionFetcherBase(t *testing.T) {
@@ -24,10 +26,63 @@ func TestRemoteVersionFetcherBase(t *testing.T) {
 	logger := loggerPkg.GetNopLogger()
 	tracer := tracing.InitNoopTracer()
 	client := git.NewGithub(config, *logger, tracer)
-	fetcher := NewRemoteVersionFetcher(*logger, client, tracer)
+	fetcher := NewRemoteVersionFetcher(*logger, client, nil, tracer)
 	assert.True(t, fetcher.Enabled())
 	assert.Equal(t, constants.FetcherNameRemoteVersion, fetcher.Name())
-	assert.Empty(t, fetcher.Dependencies())
+	assert.Equal(t, []constants.FetcherName{constants.FetcherNameChainRegistry}, fetcher.Dependencies())
+}
+
+func TestRemoteVersionFetcherDisabled(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	fetcher := NewRemoteVersionFetcher(*logger, nil, nil, tracer)
+	assert.False(t, fetcher.Enabled())
+}
+
+func TestRemoteVersionFetcherNoData(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if r := recover(); r == nil {
+			require.Fail(t, "Expected to have a panic here!")
+		}
+	}()
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	fetcher := NewRemoteVersionFetcher(*logger, nil, nil, tracer)
+	fetcher.Get(context.Background())
+}
+
+func TestRemoteVersionFetcherChainRegistryOk(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := chain_registry.NewClient(configPkg.ChainRegistryConfig{Path: "chain.json"}, *logger, tracer)
+	fetcher := NewRemoteVersionFetcher(*logger, nil, client, tracer)
+	assert.True(t, fetcher.Enabled())
+
+	data, queryInfos := fetcher.Get(context.Background(), &chain_registry.ChainInfo{
+		Codebase: &chain_registry.Codebase{RecommendedVersion: "v17.2.0"},
+	})
+	assert.Empty(t, queryInfos)
+	assert.Equal(t, "17.2.0", data)
+}
+
+func TestRemoteVersionFetcherChainRegistryAndGitMissing(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := chain_registry.NewClient(configPkg.ChainRegistryConfig{Path: "chain.json"}, *logger, tracer)
+	fetcher := NewRemoteVersionFetcher(*logger, nil, client, tracer)
+
+	data, queryInfos := fetcher.Get(context.Background(), nil)
+	assert.Empty(t, queryInfos)
+	assert.Nil(t, data)
 }
 
 //nolint:paralleltest // disabled due to httpmock usage
@@ -45,9 +100,9 @@ func TestRemoteVersionFetcherFail(t *testing.T) {
 	logger := loggerPkg.GetNopLogger()
 	tracer := tracing.InitNoopTracer()
 	client := git.NewGithub(config, *logger, tracer)
-	fetcher := NewRemoteVersionFetcher(*logger, client, tracer)
+	fetcher := NewRemoteVersionFetcher(*logger, client, nil, tracer)
 
-	data, queryInfos := fetcher.Get(context.Background())
+	data, queryInfos := fetcher.Get(context.Background(), nil)
 	assert.Len(t, queryInfos, 1)
 	assert.False(t, queryInfos[0].Success)
 	assert.Nil(t, data)
@@ -70,9 +125,37 @@ func TestRemoteVersionFetcherOk(t *testing.T) {
 	logger := loggerPkg.GetNopLogger()
 	tracer := tracing.InitNoopTracer()
 	client := git.NewGithub(config, *logger, tracer)
-	fetcher := NewRemoteVersionFetcher(*logger, client, tracer)
+	fetcher := NewRemoteVersionFetcher(*logger, client, nil, tracer)
+
+	data, queryInfos := fetcher.Get(context.Background(), nil)
+	assert.Len(t, queryInfos, 1)
+	assert.True(t, queryInfos[0].Success)
+	assert.Equal(t, "17.2.0", data)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestRemoteVersionFetcherChainRegistryNoRecommendedVersion(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.github.com/repos/cosmos/gaia/releases/latest",
+		httpmock.
+			NewBytesResponder(200, assets.GetBytesOrPanic("github-valid.json")).
+			HeaderAdd(http.Header{"x-ratelimit-reset": []string{"12345"}}),
+	)
+
+	config := configPkg.GitConfig{Repository: "https://github.com/cosmos/gaia"}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := git.NewGithub(config, *logger, tracer)
+	chainRegistry := chain_registry.NewClient(configPkg.ChainRegistryConfig{Path: "chain.json"}, *logger, tracer)
+	fetcher := NewRemoteVersionFetcher(*logger, client, chainRegistry, tracer)
 
-	data, queryInfos := fetcher.Get(context.Background())
+	data, queryInfos := fetcher.Get(context.Background(), &chain_registry.ChainInfo{
+		Codebase: &chain_registry.Codebase{},
+	})
 	assert.Len(t, queryInfos, 1)
 	assert.True(t, queryInfos[0].Success)
 	assert.Equal(t, "17.2.0", data)
@@ -0,0 +1,110 @@
+package fetchers
+
+import (
+	"context"
+	"main/pkg/clients/tendermint"
+	"main/pkg/constants"
+	"main/pkg/query_info"
+	"main/pkg/types"
+	"main/pkg/utils"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/rs/zerolog"
+)
+
+type SyncProgressFetcher struct {
+	TendermintRPC *tendermint.RPC
+	Logger        zerolog.Logger
+	Tracer        trace.Tracer
+
+	// heights are sampled across scrapes to calculate the sync rate
+	Samples []types.HeightSample
+	Mutex   sync.Mutex
+}
+
+func NewSyncProgressFetcher(
+	logger zerolog.Logger,
+	tendermintRPC *tendermint.RPC,
+	tracer trace.Tracer,
+) *SyncProgressFetcher {
+	return &SyncProgressFetcher{
+		Logger:        logger.With().Str("component", "sync_progress_fetcher").Logger(),
+		TendermintRPC: tendermintRPC,
+		Tracer:        tracer,
+	}
+}
+
+func (n *SyncProgressFetcher) Enabled() bool {
+	return n.TendermintRPC != nil
+}
+
+func (n *SyncProgressFetcher) Name() constants.FetcherName {
+	return constants.FetcherNameSyncProgress
+}
+
+func (n *SyncProgressFetcher) Dependencies() []constants.FetcherName {
+	return []constants.FetcherName{
+		constants.FetcherNameNodeStatus,
+		constants.FetcherNameReferenceStatus,
+	}
+}
+
+func (n *SyncProgressFetcher) Get(ctx context.Context, data ...interface{}) (interface{}, []query_info.QueryInfo) {
+	if len(data) < 2 {
+		panic("data is empty")
+	}
+
+	status, statusConverted := Convert[tendermint.StatusResponse](data[0])
+	referenceStatuses, _ := Convert[types.ReferenceStatuses](data[1])
+
+	if !statusConverted {
+		n.Logger.Trace().Msg("Node status is empty, not calculating sync progress.")
+		return nil, []query_info.QueryInfo{}
+	}
+
+	n.Mutex.Lock()
+	defer n.Mutex.Unlock()
+
+	if !status.Result.SyncInfo.CatchingUp {
+		n.Samples = []types.HeightSample{}
+		return &types.SyncProgress{CatchingUp: false}, []query_info.QueryInfo{}
+	}
+
+	n.Samples = append(n.Samples, types.HeightSample{
+		Height: status.Result.SyncInfo.LatestBlockHeight,
+		Time:   time.Now(),
+	})
+
+	if len(n.Samples) > constants.SyncRateSamplesCount {
+		n.Samples = n.Samples[len(n.Samples)-constants.SyncRateSamplesCount:]
+	}
+
+	queryInfos := []query_info.QueryInfo{}
+
+	// reference nodes are preferred, falling back to the heights reported by peers
+	referenceHeight, referenceHeightFound := referenceStatuses.MedianHeight()
+	if !referenceHeightFound {
+		childCtx, span := n.Tracer.Start(
+			ctx,
+			"Fetcher "+string(n.Name()),
+			trace.WithAttributes(attribute.String("node", n.TendermintRPC.Address)),
+		)
+		defer span.End()
+
+		peersHeights, queryInfo, err := n.TendermintRPC.GetPeersHeights(childCtx)
+		queryInfos = append(queryInfos, queryInfo)
+
+		if err != nil {
+			n.Logger.Warn().Err(err).Msg("Could not fetch peers heights")
+		} else {
+			referenceHeight, referenceHeightFound = utils.MedianInt64(peersHeights)
+		}
+	}
+
+	syncProgress := types.NewSyncProgress(n.Samples, referenceHeight, referenceHeightFound)
+	return &syncProgress, queryInfos
+}
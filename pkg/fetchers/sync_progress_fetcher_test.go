@@ -0,0 +1,215 @@
+package fetchers
+
+import (
+	"context"
+	"errors"
+	"main/assets"
+	"main/pkg/clients/tendermint"
+	configPkg "main/pkg/config"
+	"main/pkg/constants"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/tracing"
+	"main/pkg/types"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncProgressFetcherBase(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+
+	fetcher := NewSyncProgressFetcher(*logger, nil, tracer)
+	assert.False(t, fetcher.Enabled())
+	assert.Equal(t, constants.FetcherNameSyncProgress, fetcher.Name())
+	assert.Equal(t, []constants.FetcherName{
+		constants.FetcherNameNodeStatus,
+		constants.FetcherNameReferenceStatus,
+	}, fetcher.Dependencies())
+
+	config := configPkg.TendermintConfig{Address: "https://example.com"}
+	client := tendermint.NewRPC(config, *logger, tracer)
+	fetcher = NewSyncProgressFetcher(*logger, client, tracer)
+	assert.True(t, fetcher.Enabled())
+}
+
+func TestSyncProgressFetcherDataEmpty(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if r := recover(); r == nil {
+			require.Fail(t, "Expected to have a panic here!")
+		}
+	}()
+
+	config := configPkg.TendermintConfig{Address: "https://example.com"}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := tendermint.NewRPC(config, *logger, tracer)
+	fetcher := NewSyncProgressFetcher(*logger, client, tracer)
+	fetcher.Get(context.Background())
+}
+
+func TestSyncProgressFetcherNoStatus(t *testing.T) {
+	t.Parallel()
+
+	config := configPkg.TendermintConfig{Address: "https://example.com"}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := tendermint.NewRPC(config, *logger, tracer)
+	fetcher := NewSyncProgressFetcher(*logger, client, tracer)
+
+	data, queryInfos := fetcher.Get(context.Background(), nil, nil)
+	assert.Empty(t, queryInfos)
+	assert.Nil(t, data)
+}
+
+func TestSyncProgressFetcherSynced(t *testing.T) {
+	t.Parallel()
+
+	config := configPkg.TendermintConfig{Address: "https://example.com"}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := tendermint.NewRPC(config, *logger, tracer)
+	fetcher := NewSyncProgressFetcher(*logger, client, tracer)
+	fetcher.Samples = []types.HeightSample{{Height: 100, Time: time.Now()}}
+
+	data, queryInfos := fetcher.Get(context.Background(), tendermint.StatusResponse{
+		Result: tendermint.StatusResult{
+			SyncInfo: tendermint.SyncInfo{LatestBlockHeight: 200, CatchingUp: false},
+		},
+	}, nil)
+	assert.Empty(t, queryInfos)
+	assert.Empty(t, fetcher.Samples)
+
+	dataConverted, ok := data.(*types.SyncProgress)
+	require.True(t, ok)
+	assert.False(t, dataConverted.CatchingUp)
+}
+
+func TestSyncProgressFetcherWithReferenceStatuses(t *testing.T) {
+	t.Parallel()
+
+	config := configPkg.TendermintConfig{Address: "https://example.com"}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := tendermint.NewRPC(config, *logger, tracer)
+	fetcher := NewSyncProgressFetcher(*logger, client, tracer)
+	fetcher.Samples = []types.HeightSample{{Height: 100, Time: time.Now().Add(-10 * time.Second)}}
+
+	data, queryInfos := fetcher.Get(context.Background(), tendermint.StatusResponse{
+		Result: tendermint.StatusResult{
+			SyncInfo: tendermint.SyncInfo{LatestBlockHeight: 200, CatchingUp: true},
+		},
+	}, types.ReferenceStatuses{
+		{Address: "https://ref1.example.com", Height: 1200, Success: true},
+		{Address: "https://ref2.example.com", Height: 1000, Success: false},
+	})
+	assert.Empty(t, queryInfos)
+	assert.Len(t, fetcher.Samples, 2)
+
+	dataConverted, ok := data.(*types.SyncProgress)
+	require.True(t, ok)
+	assert.True(t, dataConverted.CatchingUp)
+	assert.True(t, dataConverted.HasRate)
+	assert.InDelta(t, 10, dataConverted.Rate, 0.1)
+	assert.True(t, dataConverted.HasReferenceHeight)
+	assert.Equal(t, int64(1000), dataConverted.BlocksRemaining)
+	assert.False(t, dataConverted.EstimatedCompletion.IsZero())
+}
+
+func TestSyncProgressFetcherSamplesCapped(t *testing.T) {
+	t.Parallel()
+
+	config := configPkg.TendermintConfig{Address: "https://example.com"}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := tendermint.NewRPC(config, *logger, tracer)
+	fetcher := NewSyncProgressFetcher(*logger, client, tracer)
+
+	for i := 0; i < constants.SyncRateSamplesCount; i++ {
+		fetcher.Samples = append(fetcher.Samples, types.HeightSample{
+			Height: int64(i),
+			Time:   time.Now(),
+		})
+	}
+
+	fetcher.Get(context.Background(), tendermint.StatusResponse{
+		Result: tendermint.StatusResult{
+			SyncInfo: tendermint.SyncInfo{LatestBlockHeight: 200, CatchingUp: true},
+		},
+	}, types.ReferenceStatuses{
+		{Address: "https://ref1.example.com", Height: 1200, Success: true},
+	})
+	assert.Len(t, fetcher.Samples, constants.SyncRateSamplesCount)
+	assert.Equal(t, int64(1), fetcher.Samples[0].Height)
+	assert.Equal(t, int64(200), fetcher.Samples[len(fetcher.Samples)-1].Height)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestSyncProgressFetcherPeersFail(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/dump_consensus_state",
+		httpmock.NewErrorResponder(errors.New("custom error")),
+	)
+
+	config := configPkg.TendermintConfig{Address: "https://example.com"}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := tendermint.NewRPC(config, *logger, tracer)
+	fetcher := NewSyncProgressFetcher(*logger, client, tracer)
+
+	data, queryInfos := fetcher.Get(context.Background(), tendermint.StatusResponse{
+		Result: tendermint.StatusResult{
+			SyncInfo: tendermint.SyncInfo{LatestBlockHeight: 200, CatchingUp: true},
+		},
+	}, nil)
+	assert.Len(t, queryInfos, 1)
+	assert.False(t, queryInfos[0].Success)
+
+	dataConverted, ok := data.(*types.SyncProgress)
+	require.True(t, ok)
+	assert.True(t, dataConverted.CatchingUp)
+	assert.False(t, dataConverted.HasRate)
+	assert.False(t, dataConverted.HasReferenceHeight)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestSyncProgressFetcherPeersOk(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/dump_consensus_state",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("dump-consensus-state.json")),
+	)
+
+	config := configPkg.TendermintConfig{Address: "https://example.com"}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := tendermint.NewRPC(config, *logger, tracer)
+	fetcher := NewSyncProgressFetcher(*logger, client, tracer)
+
+	data, queryInfos := fetcher.Get(context.Background(), tendermint.StatusResponse{
+		Result: tendermint.StatusResult{
+			SyncInfo: tendermint.SyncInfo{LatestBlockHeight: 21077100, CatchingUp: true},
+		},
+	}, types.ReferenceStatuses{})
+	assert.Len(t, queryInfos, 1)
+	assert.True(t, queryInfos[0].Success)
+
+	dataConverted, ok := data.(*types.SyncProgress)
+	require.True(t, ok)
+	assert.True(t, dataConverted.HasReferenceHeight)
+	assert.Equal(t, int64(11), dataConverted.BlocksRemaining)
+}
@@ -0,0 +1,125 @@
+package fetchers
+
+import (
+	"context"
+	cosmovisorPkg "main/pkg/clients/cosmovisor"
+	"main/pkg/constants"
+	"main/pkg/query_info"
+	"main/pkg/types"
+	"main/pkg/utils"
+	"runtime"
+
+	upgradeTypes "cosmossdk.io/x/upgrade/types"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/rs/zerolog"
+)
+
+type UpgradeBinariesFetcher struct {
+	Cosmovisor *cosmovisorPkg.Cosmovisor
+	Platform   string
+	Logger     zerolog.Logger
+	Tracer     trace.Tracer
+}
+
+func NewUpgradeBinariesFetcher(
+	logger zerolog.Logger,
+	cosmovisor *cosmovisorPkg.Cosmovisor,
+	tracer trace.Tracer,
+) *UpgradeBinariesFetcher {
+	return &UpgradeBinariesFetcher{
+		Logger:     logger.With().Str("component", "upgrade_binaries_fetcher").Logger(),
+		Cosmovisor: cosmovisor,
+		Platform:   runtime.GOOS + "/" + runtime.GOARCH,
+		Tracer:     tracer,
+	}
+}
+
+func (n *UpgradeBinariesFetcher) Enabled() bool {
+	return n.Cosmovisor != nil
+}
+
+func (n *UpgradeBinariesFetcher) Name() constants.FetcherName {
+	return constants.FetcherNameUpgradeBinaries
+}
+
+func (n *UpgradeBinariesFetcher) Dependencies() []constants.FetcherName {
+	return []constants.FetcherName{
+		constants.FetcherNameUpgrades,
+		constants.FetcherNameCosmovisorUpgradeInfo,
+		constants.FetcherNameCosmovisorUpgrades,
+	}
+}
+
+func (n *UpgradeBinariesFetcher) Get(ctx context.Context, data ...interface{}) (interface{}, []query_info.QueryInfo) {
+	if len(data) < 3 {
+		panic("data is empty")
+	}
+
+	governancePlan, _ := Convert[*upgradeTypes.Plan](data[0])
+	upgradeInfoPlan, _ := Convert[*upgradeTypes.Plan](data[1])
+	cosmovisorUpgrades, cosmovisorUpgradesConverted := Convert[*types.UpgradesPresent](data[2])
+
+	childCtx, span := n.Tracer.Start(
+		ctx,
+		"Fetcher "+string(n.Name()),
+	)
+	defer span.End()
+
+	binariesInfo := []types.UpgradeBinaryInfo{}
+	queryInfos := []query_info.QueryInfo{}
+
+	plans := []struct {
+		Source string
+		Plan   *upgradeTypes.Plan
+	}{
+		{Source: constants.UpgradeSourceGovernance, Plan: governancePlan},
+		{Source: constants.UpgradeSourceUpgradeInfo, Plan: upgradeInfoPlan},
+	}
+
+	for _, plan := range plans {
+		if plan.Plan == nil {
+			continue
+		}
+
+		// nothing to check if the plan does not declare binaries,
+		// or only has a link to a file with them
+		binaries, ok := types.ParseUpgradeBinaries(plan.Plan.Info)
+		if !ok {
+			continue
+		}
+
+		binaryInfo := types.UpgradeBinaryInfo{
+			Name:     plan.Plan.Name,
+			Source:   plan.Source,
+			Platform: n.Platform,
+		}
+
+		binaryUrl, platformAvailable := binaries.ForPlatform(n.Platform)
+		binaryInfo.PlatformAvailable = platformAvailable
+
+		algorithm, expectedChecksum, hasChecksum := types.ParseBinaryChecksum(binaryUrl)
+		binaryPresent := cosmovisorUpgradesConverted &&
+			cosmovisorUpgrades.HasUpgrade(utils.NormalizeUpgradeName(plan.Plan.Name))
+
+		if platformAvailable && hasChecksum && binaryPresent {
+			checksum, queryInfo, err := n.Cosmovisor.GetUpgradeBinaryChecksum(childCtx, plan.Plan.Name, algorithm)
+			queryInfos = append(queryInfos, queryInfo)
+
+			if err != nil {
+				n.Logger.Error().
+					Err(err).
+					Str("name", plan.Plan.Name).
+					Msg("Could not calculate upgrade binary checksum")
+			} else {
+				binaryInfo.HasChecksumMatch = true
+				binaryInfo.ChecksumMatch = checksum == expectedChecksum
+			}
+		}
+
+		binariesInfo = append(binariesInfo, binaryInfo)
+	}
+
+	return binariesInfo, queryInfos
+}
@@ -0,0 +1,174 @@
+package fetchers
+
+import (
+	"context"
+	cosmovisorPkg "main/pkg/clients/cosmovisor"
+	configPkg "main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/fs"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/tracing"
+	"main/pkg/types"
+	"testing"
+
+	upgradeTypes "cosmossdk.io/x/upgrade/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+const testBinaryChecksum = "447681e38a81da27bf0ed433ac72e62742f53506b8f16264552041f5e8a6e94e"
+
+func getTestUpgradeBinariesFetcher(fileError bool) *UpgradeBinariesFetcher {
+	config := configPkg.CosmovisorConfig{
+		Enabled:         null.BoolFrom(true),
+		ChainBinaryName: "gaiad",
+		ChainFolder:     "",
+		CosmovisorPath:  "/home/validator/go/bin/cosmovisor",
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := cosmovisorPkg.NewCosmovisor(config, *logger, tracer)
+	client.Filesystem = &fs.TestFS{FileError: fileError}
+	client.UpgradeSubfolderPath = "cosmovisor-binaries"
+
+	fetcher := NewUpgradeBinariesFetcher(*logger, client, tracer)
+	fetcher.Platform = "linux/amd64"
+	return fetcher
+}
+
+func TestUpgradeBinariesFetcherBase(t *testing.T) {
+	t.Parallel()
+
+	fetcher := getTestUpgradeBinariesFetcher(false)
+	assert.True(t, fetcher.Enabled())
+	assert.Equal(t, constants.FetcherNameUpgradeBinaries, fetcher.Name())
+	assert.Equal(t, []constants.FetcherName{
+		constants.FetcherNameUpgrades,
+		constants.FetcherNameCosmovisorUpgradeInfo,
+		constants.FetcherNameCosmovisorUpgrades,
+	}, fetcher.Dependencies())
+}
+
+func TestUpgradeBinariesFetcherNoData(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if r := recover(); r == nil {
+			require.Fail(t, "Expected to have a panic here!")
+		}
+	}()
+
+	fetcher := getTestUpgradeBinariesFetcher(false)
+	fetcher.Get(context.Background())
+}
+
+func TestUpgradeBinariesFetcherNoPlans(t *testing.T) {
+	t.Parallel()
+
+	fetcher := getTestUpgradeBinariesFetcher(false)
+	data, queryInfos := fetcher.Get(context.Background(), nil, nil, nil)
+	assert.Empty(t, queryInfos)
+	assert.Empty(t, data)
+}
+
+func TestUpgradeBinariesFetcherNoBinariesInPlan(t *testing.T) {
+	t.Parallel()
+
+	fetcher := getTestUpgradeBinariesFetcher(false)
+	data, queryInfos := fetcher.Get(
+		context.Background(),
+		&upgradeTypes.Plan{Name: "v15", Info: "https://example.com/upgrade-info.json"},
+		nil,
+		&types.UpgradesPresent{"v15": true},
+	)
+	assert.Empty(t, queryInfos)
+	assert.Empty(t, data)
+}
+
+func TestUpgradeBinariesFetcherChecksumError(t *testing.T) {
+	t.Parallel()
+
+	fetcher := getTestUpgradeBinariesFetcher(true)
+	data, queryInfos := fetcher.Get(
+		context.Background(),
+		&upgradeTypes.Plan{
+			Name: "v15",
+			Info: `{"binaries":{"linux/amd64":"https://example.com/gaiad?checksum=sha256:` + testBinaryChecksum + `"}}`,
+		},
+		nil,
+		&types.UpgradesPresent{"v15": true},
+	)
+	require.Len(t, queryInfos, 1)
+	assert.False(t, queryInfos[0].Success)
+
+	binariesInfo, ok := data.([]types.UpgradeBinaryInfo)
+	require.True(t, ok)
+	require.Len(t, binariesInfo, 1)
+	assert.True(t, binariesInfo[0].PlatformAvailable)
+	assert.False(t, binariesInfo[0].HasChecksumMatch)
+}
+
+func TestUpgradeBinariesFetcherOk(t *testing.T) {
+	t.Parallel()
+
+	fetcher := getTestUpgradeBinariesFetcher(false)
+	data, queryInfos := fetcher.Get(
+		context.Background(),
+		&upgradeTypes.Plan{
+			Name: "v15",
+			Info: `{"binaries":{"linux/amd64":"https://example.com/gaiad?checksum=sha256:` + testBinaryChecksum + `"}}`,
+		},
+		&upgradeTypes.Plan{
+			Name: "v15",
+			Info: `{"binaries":{"any":"https://example.com/gaiad?checksum=sha256:deadbeef"}}`,
+		},
+		&types.UpgradesPresent{"v15": true},
+	)
+	require.Len(t, queryInfos, 2)
+	assert.True(t, queryInfos[0].Success)
+	assert.True(t, queryInfos[1].Success)
+
+	binariesInfo, ok := data.([]types.UpgradeBinaryInfo)
+	require.True(t, ok)
+	assert.Equal(t, []types.UpgradeBinaryInfo{
+		{
+			Name:              "v15",
+			Source:            constants.UpgradeSourceGovernance,
+			Platform:          "linux/amd64",
+			PlatformAvailable: true,
+			HasChecksumMatch:  true,
+			ChecksumMatch:     true,
+		},
+		{
+			Name:              "v15",
+			Source:            constants.UpgradeSourceUpgradeInfo,
+			Platform:          "linux/amd64",
+			PlatformAvailable: true,
+			HasChecksumMatch:  true,
+			ChecksumMatch:     false,
+		},
+	}, binariesInfo)
+}
+
+func TestUpgradeBinariesFetcherPlatformNotAvailable(t *testing.T) {
+	t.Parallel()
+
+	fetcher := getTestUpgradeBinariesFetcher(false)
+	data, queryInfos := fetcher.Get(
+		context.Background(),
+		&upgradeTypes.Plan{
+			Name: "v15",
+			Info: `{"binaries":{"darwin/arm64":"https://example.com/gaiad?checksum=sha256:` + testBinaryChecksum + `"}}`,
+		},
+		nil,
+		&types.UpgradesPresent{"v15": true},
+	)
+	assert.Empty(t, queryInfos)
+
+	binariesInfo, ok := data.([]types.UpgradeBinaryInfo)
+	require.True(t, ok)
+	require.Len(t, binariesInfo, 1)
+	assert.False(t, binariesInfo[0].PlatformAvailable)
+	assert.False(t, binariesInfo[0].HasChecksumMatch)
+}
@@ -0,0 +1,63 @@
+package fetchers
+
+import (
+	"context"
+	"main/pkg/clients/tendermint"
+	"main/pkg/constants"
+	"main/pkg/query_info"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/rs/zerolog"
+)
+
+type UpgradeProposalsFetcher struct {
+	TendermintRPC         *tendermint.RPC
+	QueryUpgradeProposals bool
+	Logger                zerolog.Logger
+	Tracer                trace.Tracer
+}
+
+func NewUpgradeProposalsFetcher(
+	logger zerolog.Logger,
+	tendermintRPC *tendermint.RPC,
+	queryUpgradeProposals bool,
+	tracer trace.Tracer,
+) *UpgradeProposalsFetcher {
+	return &UpgradeProposalsFetcher{
+		Logger:                logger.With().Str("component", "upgrade_proposals_fetcher").Logger(),
+		TendermintRPC:         tendermintRPC,
+		QueryUpgradeProposals: queryUpgradeProposals,
+		Tracer:                tracer,
+	}
+}
+
+func (n *UpgradeProposalsFetcher) Enabled() bool {
+	return n.TendermintRPC != nil && n.QueryUpgradeProposals
+}
+
+func (n *UpgradeProposalsFetcher) Name() constants.FetcherName {
+	return constants.FetcherNameUpgradeProposals
+}
+
+func (n *UpgradeProposalsFetcher) Dependencies() []constants.FetcherName {
+	return []constants.FetcherName{}
+}
+
+func (n *UpgradeProposalsFetcher) Get(ctx context.Context, data ...interface{}) (interface{}, []query_info.QueryInfo) {
+	childCtx, span := n.Tracer.Start(
+		ctx,
+		"Fetcher "+string(n.Name()),
+		trace.WithAttributes(attribute.String("node", n.TendermintRPC.Address)),
+	)
+	defer span.End()
+
+	proposals, queryInfo, err := n.TendermintRPC.GetUpgradeProposals(childCtx)
+	if err != nil {
+		n.Logger.Error().Err(err).Msg("Could not fetch upgrade proposals")
+		return nil, []query_info.QueryInfo{queryInfo}
+	}
+
+	return proposals, []query_info.QueryInfo{queryInfo}
+}
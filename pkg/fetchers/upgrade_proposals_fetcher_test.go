@@ -0,0 +1,96 @@
+package fetchers
+
+import (
+	"context"
+	"errors"
+	"main/assets"
+	"main/pkg/clients/tendermint"
+	configPkg "main/pkg/config"
+	"main/pkg/constants"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/tracing"
+	"regexp"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpgradeProposalsFetcherBase(t *testing.T) {
+	t.Parallel()
+
+	config := configPkg.TendermintConfig{Address: "https://example.com"}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := tendermint.NewRPC(config, *logger, tracer)
+
+	fetcher := NewUpgradeProposalsFetcher(*logger, client, true, tracer)
+	assert.True(t, fetcher.Enabled())
+	assert.Equal(t, constants.FetcherNameUpgradeProposals, fetcher.Name())
+	assert.Empty(t, fetcher.Dependencies())
+
+	fetcher = NewUpgradeProposalsFetcher(*logger, client, false, tracer)
+	assert.False(t, fetcher.Enabled())
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestUpgradeProposalsFetcherFail(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterRegexpResponder(
+		"GET",
+		regexp.MustCompile(`/abci_query\?path=%22%2Fcosmos.gov.v1.Query%2FProposals%22`),
+		httpmock.NewErrorResponder(errors.New("custom error")),
+	)
+
+	config := configPkg.TendermintConfig{Address: "https://example.com"}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := tendermint.NewRPC(config, *logger, tracer)
+	fetcher := NewUpgradeProposalsFetcher(*logger, client, true, tracer)
+
+	data, queryInfos := fetcher.Get(context.Background())
+	assert.Len(t, queryInfos, 1)
+	assert.False(t, queryInfos[0].Success)
+	assert.Nil(t, data)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestUpgradeProposalsFetcherOk(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/abci_query?path=%22%2Fcosmos.gov.v1.Query%2FProposals%22&data=0x080122021864",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("gov-proposals-deposit.json")),
+	)
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/abci_query?path=%22%2Fcosmos.gov.v1.Query%2FProposals%22&data=0x080222021864",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("gov-proposals-voting.json")),
+	)
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/abci_query?path=%22%2Fcosmos.gov.v1.Query%2FTallyResult%22&data=0x080a",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("gov-tally.json")),
+	)
+
+	config := configPkg.TendermintConfig{Address: "https://example.com"}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := tendermint.NewRPC(config, *logger, tracer)
+	fetcher := NewUpgradeProposalsFetcher(*logger, client, true, tracer)
+
+	data, queryInfos := fetcher.Get(context.Background())
+	assert.Len(t, queryInfos, 1)
+	assert.True(t, queryInfos[0].Success)
+
+	proposals, ok := data.([]tendermint.UpgradeProposal)
+	require.True(t, ok)
+	assert.Len(t, proposals, 2)
+}
@@ -0,0 +1,88 @@
+package fetchers
+
+import (
+	"context"
+	cosmovisorPkg "main/pkg/clients/cosmovisor"
+	"main/pkg/constants"
+	"main/pkg/query_info"
+	"main/pkg/types"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/rs/zerolog"
+)
+
+type UpgradeSmokeTestsFetcher struct {
+	Cosmovisor        *cosmovisorPkg.Cosmovisor
+	SmokeTestUpgrades bool
+	Logger            zerolog.Logger
+	Tracer            trace.Tracer
+}
+
+func NewUpgradeSmokeTestsFetcher(
+	logger zerolog.Logger,
+	cosmovisor *cosmovisorPkg.Cosmovisor,
+	smokeTestUpgrades bool,
+	tracer trace.Tracer,
+) *UpgradeSmokeTestsFetcher {
+	return &UpgradeSmokeTestsFetcher{
+		Logger:            logger.With().Str("component", "upgrade_smoke_tests_fetcher").Logger(),
+		Cosmovisor:        cosmovisor,
+		SmokeTestUpgrades: smokeTestUpgrades,
+		Tracer:            tracer,
+	}
+}
+
+func (n *UpgradeSmokeTestsFetcher) Enabled() bool {
+	return n.Cosmovisor != nil && n.SmokeTestUpgrades
+}
+
+func (n *UpgradeSmokeTestsFetcher) Name() constants.FetcherName {
+	return constants.FetcherNameUpgradeSmokeTests
+}
+
+func (n *UpgradeSmokeTestsFetcher) Dependencies() []constants.FetcherName {
+	return []constants.FetcherName{constants.FetcherNameCosmovisorState}
+}
+
+func (n *UpgradeSmokeTestsFetcher) Get(ctx context.Context, data ...interface{}) (interface{}, []query_info.QueryInfo) {
+	if len(data) < 1 {
+		panic("data is empty")
+	}
+
+	cosmovisorState, cosmovisorStateConverted := Convert[*types.CosmovisorState](data[0])
+	if !cosmovisorStateConverted {
+		n.Logger.Trace().Msg("Cosmovisor state is empty, cannot smoke-test upgrade binaries.")
+		return nil, []query_info.QueryInfo{}
+	}
+
+	childCtx, span := n.Tracer.Start(
+		ctx,
+		"Fetcher "+string(n.Name()),
+	)
+	defer span.End()
+
+	smokeTests := []types.UpgradeSmokeTestInfo{}
+	queryInfos := []query_info.QueryInfo{}
+
+	for _, upgrade := range cosmovisorState.Upgrades {
+		if !upgrade.BinaryPresent {
+			continue
+		}
+
+		smokeTest, queryInfo, err := n.Cosmovisor.SmokeTestUpgradeBinary(childCtx, upgrade.Name)
+		queryInfos = append(queryInfos, queryInfo)
+
+		if err != nil {
+			n.Logger.Error().
+				Err(err).
+				Str("name", upgrade.Name).
+				Msg("Could not smoke-test upgrade binary")
+			continue
+		}
+
+		smokeTests = append(smokeTests, smokeTest)
+	}
+
+	return smokeTests, queryInfos
+}
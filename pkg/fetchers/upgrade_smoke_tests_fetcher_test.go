@@ -0,0 +1,121 @@
+package fetchers
+
+import (
+	"context"
+	"main/assets"
+	cosmovisorPkg "main/pkg/clients/cosmovisor"
+	configPkg "main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/exec"
+	"main/pkg/fs"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/tracing"
+	"main/pkg/types"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+func getTestUpgradeSmokeTestsFetcher(fileError bool, execFail bool) *UpgradeSmokeTestsFetcher {
+	config := configPkg.CosmovisorConfig{
+		Enabled:           null.BoolFrom(true),
+		ChainBinaryName:   "gaiad",
+		ChainFolder:       "",
+		CosmovisorPath:    "/home/validator/go/bin/cosmovisor",
+		SmokeTestUpgrades: null.BoolFrom(true),
+	}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := cosmovisorPkg.NewCosmovisor(config, *logger, tracer)
+	client.Filesystem = &fs.TestFS{FileError: fileError}
+	client.UpgradeSubfolderPath = "cosmovisor-binaries"
+	client.CommandExecutor = &exec.TestCommandExecutor{
+		Fail:     execFail,
+		Expected: assets.GetBytesOrPanic("cosmovisor-app-version-ok.txt"),
+	}
+
+	return NewUpgradeSmokeTestsFetcher(*logger, client, true, tracer)
+}
+
+func getTestCosmovisorStateWithUpgrades() *types.CosmovisorState {
+	return &types.CosmovisorState{
+		Upgrades: []types.CosmovisorUpgradeFolder{
+			{Name: "v15", BinaryPresent: true},
+			{Name: "v16", BinaryPresent: false},
+		},
+	}
+}
+
+func TestUpgradeSmokeTestsFetcherBase(t *testing.T) {
+	t.Parallel()
+
+	fetcher := getTestUpgradeSmokeTestsFetcher(false, false)
+	assert.True(t, fetcher.Enabled())
+	assert.Equal(t, constants.FetcherNameUpgradeSmokeTests, fetcher.Name())
+	assert.Equal(t, []constants.FetcherName{constants.FetcherNameCosmovisorState}, fetcher.Dependencies())
+}
+
+func TestUpgradeSmokeTestsFetcherDisabled(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	fetcher := NewUpgradeSmokeTestsFetcher(*logger, &cosmovisorPkg.Cosmovisor{}, false, tracer)
+	assert.False(t, fetcher.Enabled())
+}
+
+func TestUpgradeSmokeTestsFetcherNoData(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if r := recover(); r == nil {
+			require.Fail(t, "Expected to have a panic here!")
+		}
+	}()
+
+	fetcher := getTestUpgradeSmokeTestsFetcher(false, false)
+	fetcher.Get(context.Background())
+}
+
+func TestUpgradeSmokeTestsFetcherNoState(t *testing.T) {
+	t.Parallel()
+
+	fetcher := getTestUpgradeSmokeTestsFetcher(false, false)
+	data, queryInfos := fetcher.Get(context.Background(), nil)
+	assert.Empty(t, queryInfos)
+	assert.Nil(t, data)
+}
+
+func TestUpgradeSmokeTestsFetcherStatError(t *testing.T) {
+	t.Parallel()
+
+	fetcher := getTestUpgradeSmokeTestsFetcher(true, false)
+	data, queryInfos := fetcher.Get(context.Background(), getTestCosmovisorStateWithUpgrades())
+	assert.Len(t, queryInfos, 1)
+	assert.False(t, queryInfos[0].Success)
+	assert.Empty(t, data)
+}
+
+func TestUpgradeSmokeTestsFetcherNotRunnable(t *testing.T) {
+	t.Parallel()
+
+	fetcher := getTestUpgradeSmokeTestsFetcher(false, true)
+	data, queryInfos := fetcher.Get(context.Background(), getTestCosmovisorStateWithUpgrades())
+	assert.Len(t, queryInfos, 1)
+	assert.True(t, queryInfos[0].Success)
+	assert.Equal(t, []types.UpgradeSmokeTestInfo{{Name: "v15"}}, data)
+}
+
+func TestUpgradeSmokeTestsFetcherOk(t *testing.T) {
+	t.Parallel()
+
+	fetcher := getTestUpgradeSmokeTestsFetcher(false, false)
+	data, queryInfos := fetcher.Get(context.Background(), getTestCosmovisorStateWithUpgrades())
+	assert.Len(t, queryInfos, 1)
+	assert.True(t, queryInfos[0].Success)
+	assert.Equal(t, []types.UpgradeSmokeTestInfo{
+		{Name: "v15", Runnable: true, Version: "1.6.4"},
+	}, data)
+}
@@ -0,0 +1,58 @@
+package fetchers
+
+import (
+	"context"
+	"main/pkg/clients/validator_state"
+	"main/pkg/constants"
+	"main/pkg/query_info"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/rs/zerolog"
+)
+
+type ValidatorStateFetcher struct {
+	ValidatorState *validator_state.Client
+	Logger         zerolog.Logger
+	Tracer         trace.Tracer
+}
+
+func NewValidatorStateFetcher(
+	logger zerolog.Logger,
+	validatorState *validator_state.Client,
+	tracer trace.Tracer,
+) *ValidatorStateFetcher {
+	return &ValidatorStateFetcher{
+		Logger:         logger.With().Str("component", "validator_state_fetcher").Logger(),
+		ValidatorState: validatorState,
+		Tracer:         tracer,
+	}
+}
+
+func (n *ValidatorStateFetcher) Enabled() bool {
+	return n.ValidatorState != nil
+}
+
+func (n *ValidatorStateFetcher) Name() constants.FetcherName {
+	return constants.FetcherNameValidatorState
+}
+
+func (n *ValidatorStateFetcher) Dependencies() []constants.FetcherName {
+	return []constants.FetcherName{}
+}
+
+func (n *ValidatorStateFetcher) Get(ctx context.Context, data ...interface{}) (interface{}, []query_info.QueryInfo) {
+	childCtx, span := n.Tracer.Start(
+		ctx,
+		"Fetcher "+string(n.Name()),
+	)
+	defer span.End()
+
+	state, queryInfo, err := n.ValidatorState.GetState(childCtx)
+	if err != nil {
+		n.Logger.Error().Err(err).Msg("Could not get validator state")
+		return nil, []query_info.QueryInfo{queryInfo}
+	}
+
+	return state, []query_info.QueryInfo{queryInfo}
+}
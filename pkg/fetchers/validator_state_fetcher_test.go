@@ -0,0 +1,83 @@
+package fetchers
+
+import (
+	"context"
+	"main/pkg/clients/validator_state"
+	"main/pkg/constants"
+	"main/pkg/fs"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/tracing"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatorStateFetcherBase(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+
+	fetcher := NewValidatorStateFetcher(*logger, nil, tracer)
+	assert.False(t, fetcher.Enabled())
+	assert.Equal(t, constants.FetcherNameValidatorState, fetcher.Name())
+	assert.Empty(t, fetcher.Dependencies())
+}
+
+func TestValidatorStateFetcherFail(t *testing.T) {
+	t.Parallel()
+
+	chainFolder := t.TempDir()
+	require.NoError(t, os.MkdirAll(chainFolder+"/data", 0o755))
+	require.NoError(t, os.WriteFile(chainFolder+"/data/priv_validator_state.json", []byte("invalid"), 0o600))
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := validator_state.NewClient(chainFolder, *logger, tracer)
+
+	fetcher := NewValidatorStateFetcher(*logger, client, tracer)
+	assert.True(t, fetcher.Enabled())
+
+	data, queryInfos := fetcher.Get(context.Background())
+	assert.Len(t, queryInfos, 1)
+	assert.False(t, queryInfos[0].Success)
+	assert.Nil(t, data)
+}
+
+func TestValidatorStateFetcherNotPresent(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := validator_state.NewClient(t.TempDir(), *logger, tracer)
+
+	fetcher := NewValidatorStateFetcher(*logger, client, tracer)
+
+	data, queryInfos := fetcher.Get(context.Background())
+	assert.Len(t, queryInfos, 1)
+	assert.True(t, queryInfos[0].Success)
+
+	_, found := Convert[*validator_state.PrivValidatorState](data)
+	assert.False(t, found)
+}
+
+func TestValidatorStateFetcherOk(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := validator_state.NewClient("node-home", *logger, tracer)
+	client.Filesystem = &fs.TestFS{}
+
+	fetcher := NewValidatorStateFetcher(*logger, client, tracer)
+
+	data, queryInfos := fetcher.Get(context.Background())
+	assert.Len(t, queryInfos, 1)
+	assert.True(t, queryInfos[0].Success)
+
+	state, found := Convert[*validator_state.PrivValidatorState](data)
+	require.True(t, found)
+	assert.Equal(t, int64(19823456), state.Height)
+}
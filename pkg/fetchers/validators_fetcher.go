@@ -0,0 +1,117 @@
+package fetchers
+
+import (
+	"context"
+	"main/pkg/clients/tendermint"
+	"main/pkg/constants"
+	"main/pkg/query_info"
+	"main/pkg/types"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/rs/zerolog"
+)
+
+type ValidatorsFetcher struct {
+	TendermintRPC   *tendermint.RPC
+	QueryValidators bool
+	Logger          zerolog.Logger
+	Tracer          trace.Tracer
+}
+
+func NewValidatorsFetcher(
+	logger zerolog.Logger,
+	tendermintRPC *tendermint.RPC,
+	queryValidators bool,
+	tracer trace.Tracer,
+) *ValidatorsFetcher {
+	return &ValidatorsFetcher{
+		Logger:          logger.With().Str("component", "validators_fetcher").Logger(),
+		TendermintRPC:   tendermintRPC,
+		QueryValidators: queryValidators,
+		Tracer:          tracer,
+	}
+}
+
+func (n *ValidatorsFetcher) Enabled() bool {
+	return n.TendermintRPC != nil && n.QueryValidators
+}
+
+func (n *ValidatorsFetcher) Name() constants.FetcherName {
+	return constants.FetcherNameValidators
+}
+
+func (n *ValidatorsFetcher) Dependencies() []constants.FetcherName {
+	return []constants.FetcherName{
+		constants.FetcherNameNodeStatus,
+	}
+}
+
+func (n *ValidatorsFetcher) Get(ctx context.Context, data ...interface{}) (interface{}, []query_info.QueryInfo) {
+	if len(data) < 1 {
+		panic("data is empty")
+	}
+
+	status, statusConverted := Convert[tendermint.StatusResponse](data[0])
+	if !statusConverted {
+		n.Logger.Trace().Msg("Node status is empty, not fetching validators.")
+		return nil, []query_info.QueryInfo{}
+	}
+
+	childCtx, span := n.Tracer.Start(
+		ctx,
+		"Fetcher "+string(n.Name()),
+		trace.WithAttributes(attribute.String("node", n.TendermintRPC.Address)),
+	)
+	defer span.End()
+
+	validators, validatorsQueryInfo, err := n.TendermintRPC.Validators(childCtx)
+	if err != nil {
+		n.Logger.Error().Err(err).Msg("Could not fetch validators")
+		return nil, []query_info.QueryInfo{validatorsQueryInfo}
+	}
+
+	inactiveValidators, inactiveQueryInfo, err := n.TendermintRPC.GetInactiveValidators(childCtx)
+	if err != nil {
+		// not failing here, as the chain might not have the staking module
+		n.Logger.Warn().Err(err).Msg("Could not fetch inactive validators")
+	}
+
+	powerReduction := sdkTypes.DefaultPowerReduction
+	queryInfos := []query_info.QueryInfo{validatorsQueryInfo, inactiveQueryInfo}
+
+	if len(inactiveValidators) > 0 {
+		bondedTokens, poolQueryInfo, poolErr := n.TendermintRPC.GetBondedTokens(childCtx)
+		queryInfos = append(queryInfos, poolQueryInfo)
+
+		if poolErr != nil {
+			n.Logger.Warn().Err(poolErr).Msg("Could not fetch staking pool, using the default power reduction")
+		} else if estimated, ok := types.EstimatePowerReduction(bondedTokens, validators); ok {
+			powerReduction = estimated
+		}
+	}
+
+	inactiveVotingPowers := make([]int64, 0, len(inactiveValidators))
+	for _, validator := range inactiveValidators {
+		// jailed validators cannot get into the active set until unjailed
+		if validator.Jailed {
+			continue
+		}
+
+		inactiveVotingPowers = append(
+			inactiveVotingPowers,
+			validator.PotentialConsensusPower(powerReduction),
+		)
+	}
+
+	validatorSetInfo := types.NewValidatorSetInfo(
+		status.Result.ValidatorInfo.Address,
+		validators,
+		inactiveVotingPowers,
+	)
+
+	return &validatorSetInfo, queryInfos
+}
@@ -0,0 +1,244 @@
+package fetchers
+
+import (
+	"context"
+	"errors"
+	"main/assets"
+	"main/pkg/clients/tendermint"
+	configPkg "main/pkg/config"
+	"main/pkg/constants"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/tracing"
+	"main/pkg/types"
+	"regexp"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatorsFetcherBase(t *testing.T) {
+	t.Parallel()
+
+	config := configPkg.TendermintConfig{Address: "https://example.com"}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := tendermint.NewRPC(config, *logger, tracer)
+
+	fetcher := NewValidatorsFetcher(*logger, client, true, tracer)
+	assert.True(t, fetcher.Enabled())
+	assert.Equal(t, constants.FetcherNameValidators, fetcher.Name())
+	assert.Equal(t, []constants.FetcherName{constants.FetcherNameNodeStatus}, fetcher.Dependencies())
+
+	fetcher = NewValidatorsFetcher(*logger, client, false, tracer)
+	assert.False(t, fetcher.Enabled())
+}
+
+func TestValidatorsFetcherDataEmpty(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if r := recover(); r == nil {
+			require.Fail(t, "Expected to have a panic here!")
+		}
+	}()
+
+	config := configPkg.TendermintConfig{Address: "https://example.com"}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := tendermint.NewRPC(config, *logger, tracer)
+	fetcher := NewValidatorsFetcher(*logger, client, true, tracer)
+	fetcher.Get(context.Background())
+}
+
+func TestValidatorsFetcherNoStatus(t *testing.T) {
+	t.Parallel()
+
+	config := configPkg.TendermintConfig{Address: "https://example.com"}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := tendermint.NewRPC(config, *logger, tracer)
+	fetcher := NewValidatorsFetcher(*logger, client, true, tracer)
+
+	data, queryInfos := fetcher.Get(context.Background(), nil)
+	assert.Empty(t, queryInfos)
+	assert.Nil(t, data)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestValidatorsFetcherValidatorsFail(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/validators?page=1&per_page=100",
+		httpmock.NewErrorResponder(errors.New("custom error")),
+	)
+
+	config := configPkg.TendermintConfig{Address: "https://example.com"}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := tendermint.NewRPC(config, *logger, tracer)
+	fetcher := NewValidatorsFetcher(*logger, client, true, tracer)
+
+	data, queryInfos := fetcher.Get(context.Background(), tendermint.StatusResponse{})
+	assert.Len(t, queryInfos, 1)
+	assert.False(t, queryInfos[0].Success)
+	assert.Nil(t, data)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestValidatorsFetcherInactiveValidatorsFail(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/validators?page=1&per_page=100",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("validators-page-1.json")),
+	)
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/validators?page=2&per_page=100",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("validators-page-2.json")),
+	)
+
+	httpmock.RegisterRegexpResponder(
+		"GET",
+		regexp.MustCompile(`/abci_query\?path=%22%2Fcosmos.staking.v1beta1.Query%2FValidators%22`),
+		httpmock.NewErrorResponder(errors.New("custom error")),
+	)
+
+	config := configPkg.TendermintConfig{Address: "https://example.com"}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := tendermint.NewRPC(config, *logger, tracer)
+	fetcher := NewValidatorsFetcher(*logger, client, true, tracer)
+
+	status := tendermint.StatusResponse{Result: tendermint.StatusResult{
+		ValidatorInfo: tendermint.ValidatorInfo{Address: "DFA9DD9F731B4D94E19285E810D5D425CC6BB1E3"},
+	}}
+
+	data, queryInfos := fetcher.Get(context.Background(), status)
+	assert.Len(t, queryInfos, 2)
+	assert.True(t, queryInfos[0].Success)
+	assert.False(t, queryInfos[1].Success)
+
+	validatorSetInfo, ok := data.(*types.ValidatorSetInfo)
+	require.True(t, ok)
+	assert.True(t, validatorSetInfo.InActiveSet)
+	assert.False(t, validatorSetInfo.HasInactive)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestValidatorsFetcherStakingPoolFail(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/validators?page=1&per_page=100",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("validators-page-1.json")),
+	)
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/validators?page=2&per_page=100",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("validators-page-2.json")),
+	)
+
+	httpmock.RegisterRegexpResponder(
+		"GET",
+		regexp.MustCompile(`/abci_query\?path=%22%2Fcosmos.staking.v1beta1.Query%2FValidators%22`),
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("staking-validators-inactive.json")),
+	)
+
+	httpmock.RegisterRegexpResponder(
+		"GET",
+		regexp.MustCompile(`/abci_query\?path=%22%2Fcosmos.staking.v1beta1.Query%2FPool%22`),
+		httpmock.NewErrorResponder(errors.New("custom error")),
+	)
+
+	config := configPkg.TendermintConfig{Address: "https://example.com"}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := tendermint.NewRPC(config, *logger, tracer)
+	fetcher := NewValidatorsFetcher(*logger, client, true, tracer)
+
+	status := tendermint.StatusResponse{Result: tendermint.StatusResult{
+		ValidatorInfo: tendermint.ValidatorInfo{Address: "DFA9DD9F731B4D94E19285E810D5D425CC6BB1E3"},
+	}}
+
+	data, queryInfos := fetcher.Get(context.Background(), status)
+	assert.Len(t, queryInfos, 3)
+	assert.True(t, queryInfos[0].Success)
+	assert.True(t, queryInfos[1].Success)
+	assert.False(t, queryInfos[2].Success)
+
+	// falls back to the default power reduction
+	validatorSetInfo, ok := data.(*types.ValidatorSetInfo)
+	require.True(t, ok)
+	assert.Equal(t, int64(5000), validatorSetInfo.FirstInactiveVotingPower)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestValidatorsFetcherOk(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/validators?page=1&per_page=100",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("validators-page-1.json")),
+	)
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/validators?page=2&per_page=100",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("validators-page-2.json")),
+	)
+
+	httpmock.RegisterRegexpResponder(
+		"GET",
+		regexp.MustCompile(`/abci_query\?path=%22%2Fcosmos.staking.v1beta1.Query%2FValidators%22`),
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("staking-validators-inactive.json")),
+	)
+
+	httpmock.RegisterRegexpResponder(
+		"GET",
+		regexp.MustCompile(`/abci_query\?path=%22%2Fcosmos.staking.v1beta1.Query%2FPool%22`),
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("staking-pool.json")),
+	)
+
+	config := configPkg.TendermintConfig{Address: "https://example.com"}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := tendermint.NewRPC(config, *logger, tracer)
+	fetcher := NewValidatorsFetcher(*logger, client, true, tracer)
+
+	status := tendermint.StatusResponse{Result: tendermint.StatusResult{
+		ValidatorInfo: tendermint.ValidatorInfo{Address: "DFA9DD9F731B4D94E19285E810D5D425CC6BB1E3"},
+	}}
+
+	data, queryInfos := fetcher.Get(context.Background(), status)
+	assert.Len(t, queryInfos, 3)
+	assert.True(t, queryInfos[0].Success)
+	assert.True(t, queryInfos[1].Success)
+	assert.True(t, queryInfos[2].Success)
+
+	validatorSetInfo, ok := data.(*types.ValidatorSetInfo)
+	require.True(t, ok)
+	assert.Equal(t, types.ValidatorSetInfo{
+		InActiveSet:              true,
+		Rank:                     2,
+		VotingPower:              10000,
+		ProposerPriority:         2500,
+		ActiveSetSize:            3,
+		LastActiveVotingPower:    8000,
+		FirstInactiveVotingPower: 5000,
+		HasInactive:              true,
+	}, *validatorSetInfo)
+}
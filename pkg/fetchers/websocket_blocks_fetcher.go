@@ -0,0 +1,54 @@
+package fetchers
+
+import (
+	"context"
+	"main/pkg/clients/tendermint"
+	"main/pkg/constants"
+	"main/pkg/query_info"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/rs/zerolog"
+)
+
+type WebsocketBlocksFetcher struct {
+	WebsocketClient *tendermint.WebsocketClient
+	Logger          zerolog.Logger
+	Tracer          trace.Tracer
+}
+
+func NewWebsocketBlocksFetcher(
+	logger zerolog.Logger,
+	websocketClient *tendermint.WebsocketClient,
+	tracer trace.Tracer,
+) *WebsocketBlocksFetcher {
+	return &WebsocketBlocksFetcher{
+		Logger:          logger.With().Str("component", "websocket_blocks_fetcher").Logger(),
+		WebsocketClient: websocketClient,
+		Tracer:          tracer,
+	}
+}
+
+func (n *WebsocketBlocksFetcher) Enabled() bool {
+	return n.WebsocketClient != nil
+}
+
+func (n *WebsocketBlocksFetcher) Name() constants.FetcherName {
+	return constants.FetcherNameWebsocketBlocks
+}
+
+func (n *WebsocketBlocksFetcher) Dependencies() []constants.FetcherName {
+	return []constants.FetcherName{}
+}
+
+func (n *WebsocketBlocksFetcher) Get(ctx context.Context, data ...interface{}) (interface{}, []query_info.QueryInfo) {
+	_, span := n.Tracer.Start(
+		ctx,
+		"Fetcher "+string(n.Name()),
+	)
+	defer span.End()
+
+	// the data comes from the live subscription, so there are no queries made here
+	snapshot := n.WebsocketClient.Snapshot()
+	return &snapshot, []query_info.QueryInfo{}
+}
@@ -0,0 +1,52 @@
+package fetchers
+
+import (
+	"context"
+	"main/pkg/clients/tendermint"
+	configPkg "main/pkg/config"
+	"main/pkg/constants"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/tracing"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebsocketBlocksFetcherBase(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := tendermint.NewWebsocketClient(configPkg.TendermintConfig{Address: "https://example.com"}, *logger)
+	fetcher := NewWebsocketBlocksFetcher(*logger, client, tracer)
+	assert.True(t, fetcher.Enabled())
+	assert.Equal(t, constants.FetcherNameWebsocketBlocks, fetcher.Name())
+	assert.Empty(t, fetcher.Dependencies())
+}
+
+func TestWebsocketBlocksFetcherDisabled(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	fetcher := NewWebsocketBlocksFetcher(*logger, nil, tracer)
+	assert.False(t, fetcher.Enabled())
+}
+
+func TestWebsocketBlocksFetcherOk(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := tendermint.NewWebsocketClient(configPkg.TendermintConfig{Address: "https://example.com"}, *logger)
+	fetcher := NewWebsocketBlocksFetcher(*logger, client, tracer)
+
+	data, queryInfos := fetcher.Get(context.Background())
+	assert.Empty(t, queryInfos)
+
+	snapshot, ok := data.(*tendermint.WebsocketSnapshot)
+	require.True(t, ok)
+	assert.False(t, snapshot.Connected)
+	assert.Empty(t, snapshot.Headers)
+}
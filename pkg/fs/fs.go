@@ -1,9 +1,14 @@
 package fs
 
-import "os"
+import (
+	"io"
+	"os"
+)
 
 type FS interface {
 	ReadFile(name string) ([]byte, error)
+	Open(name string) (io.ReadCloser, error)
 	ReadDir(name string) ([]os.DirEntry, error)
 	Stat(name string) (interface{}, error)
+	Readlink(name string) (string, error)
 }
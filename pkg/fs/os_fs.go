@@ -1,6 +1,9 @@
 package fs
 
-import "os"
+import (
+	"io"
+	"os"
+)
 
 type OsFS struct {
 }
@@ -9,6 +12,10 @@ func (fs *OsFS) ReadFile(name string) ([]byte, error) {
 	return os.ReadFile(name)
 }
 
+func (fs *OsFS) Open(name string) (io.ReadCloser, error) {
+	return os.Open(name)
+}
+
 func (fs *OsFS) ReadDir(name string) ([]os.DirEntry, error) {
 	return os.ReadDir(name)
 }
@@ -16,3 +23,7 @@ func (fs *OsFS) ReadDir(name string) ([]os.DirEntry, error) {
 func (fs *OsFS) Stat(name string) (interface{}, error) {
 	return os.Stat(name)
 }
+
+func (fs *OsFS) Readlink(name string) (string, error) {
+	return os.Readlink(name)
+}
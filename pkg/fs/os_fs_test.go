@@ -15,6 +15,15 @@ func TestNativeFsReadFile(t *testing.T) {
 	require.Nil(t, file)
 }
 
+func TestNativeFsOpen(t *testing.T) {
+	t.Parallel()
+
+	fs := OsFS{}
+	file, err := fs.Open("not-found.txt")
+	require.Error(t, err)
+	require.Nil(t, file)
+}
+
 func TestNativeFsReadDir(t *testing.T) {
 	t.Parallel()
 
@@ -32,3 +41,12 @@ func TestNativeFsStat(t *testing.T) {
 	require.Error(t, err)
 	require.Nil(t, stat)
 }
+
+func TestNativeFsReadlink(t *testing.T) {
+	t.Parallel()
+
+	fs := OsFS{}
+	target, err := fs.Readlink("not-found.txt")
+	require.Error(t, err)
+	require.Empty(t, target)
+}
@@ -2,6 +2,7 @@ package fs
 
 import (
 	"errors"
+	"io"
 	"main/assets"
 	"os"
 )
@@ -15,6 +16,10 @@ func (fs *TestFS) ReadFile(name string) ([]byte, error) {
 	return assets.EmbedFS.ReadFile(name)
 }
 
+func (fs *TestFS) Open(name string) (io.ReadCloser, error) {
+	return assets.EmbedFS.Open(name)
+}
+
 func (fs *TestFS) ReadDir(name string) ([]os.DirEntry, error) {
 	return assets.EmbedFS.ReadDir(name)
 }
@@ -30,3 +35,8 @@ func (fs *TestFS) Stat(name string) (interface{}, error) {
 
 	return nil, nil //nolint:nilnil //used for tests only
 }
+
+func (fs *TestFS) Readlink(name string) (string, error) {
+	// embedded files cannot be symlinks
+	return "", &os.PathError{Op: "readlink", Path: name, Err: os.ErrInvalid}
+}
@@ -0,0 +1,66 @@
+package generators
+
+import (
+	"encoding/hex"
+	"main/pkg/constants"
+	"main/pkg/fetchers"
+	"main/pkg/metrics"
+	"main/pkg/types"
+	"strings"
+)
+
+type AbciInfoGenerator struct{}
+
+func NewAbciInfoGenerator() *AbciInfoGenerator {
+	return &AbciInfoGenerator{}
+}
+
+func (g *AbciInfoGenerator) Get(state fetchers.State) []metrics.MetricInfo {
+	abciInfo, abciInfoFound := fetchers.StateGet[*types.AbciInfo](state, constants.FetcherNameAbciInfo)
+	if !abciInfoFound {
+		return []metrics.MetricInfo{}
+	}
+
+	response := abciInfo.Info
+
+	metricsInfo := []metrics.MetricInfo{
+		{
+			MetricName: metrics.MetricNameAbciLastBlockHeight,
+			Labels:     map[string]string{},
+			Value:      float64(response.LastBlockHeight),
+		},
+		{
+			MetricName: metrics.MetricNameAbciAppVersion,
+			Labels:     map[string]string{},
+			Value:      float64(response.AppVersion),
+		},
+		{
+			MetricName: metrics.MetricNameAbciInfo,
+			Labels: map[string]string{
+				"name":    response.Data,
+				"version": response.Version,
+			},
+			Value: 1,
+		},
+		{
+			// the app hash changes with every block, so it is a separate metric
+			// to keep abci_info series stable
+			MetricName: metrics.MetricNameAbciLastBlockAppHash,
+			Labels: map[string]string{
+				// same format as the app hashes returned by /status
+				"app_hash": strings.ToUpper(hex.EncodeToString(response.LastBlockAppHash)),
+			},
+			Value: 1,
+		},
+	}
+
+	if abciInfo.HasLatestBlockHeight {
+		metricsInfo = append(metricsInfo, metrics.MetricInfo{
+			MetricName: metrics.MetricNameAbciHeightLag,
+			Labels:     map[string]string{},
+			Value:      float64(abciInfo.LatestBlockHeight - response.LastBlockHeight),
+		})
+	}
+
+	return metricsInfo
+}
@@ -0,0 +1,102 @@
+package generators
+
+import (
+	"main/pkg/clients/tendermint"
+	"main/pkg/constants"
+	"main/pkg/fetchers"
+	metricsPkg "main/pkg/metrics"
+	"main/pkg/types"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAbciInfoGeneratorEmpty(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{}
+
+	generator := NewAbciInfoGenerator()
+	metrics := generator.Get(state)
+	assert.Empty(t, metrics)
+}
+
+func TestAbciInfoGeneratorInvalid(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if r := recover(); r == nil {
+			require.Fail(t, "Expected to have a panic here!")
+		}
+	}()
+
+	state := fetchers.State{
+		constants.FetcherNameAbciInfo: 3,
+	}
+
+	generator := NewAbciInfoGenerator()
+	generator.Get(state)
+}
+
+func TestAbciInfoGeneratorNoStatus(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{
+		constants.FetcherNameAbciInfo: &types.AbciInfo{
+			Info: tendermint.AbciInfo{
+				Data:             "GaiaApp",
+				Version:          "v17.2.0",
+				AppVersion:       2,
+				LastBlockHeight:  1000,
+				LastBlockAppHash: []byte{0xff, 0x8c},
+			},
+		},
+	}
+
+	generator := NewAbciInfoGenerator()
+	metrics := generator.Get(state)
+	assert.Len(t, metrics, 4)
+
+	assert.Equal(t, metricsPkg.MetricNameAbciLastBlockHeight, metrics[0].MetricName)
+	assert.InDelta(t, 1000, metrics[0].Value, 0.01)
+
+	assert.Equal(t, metricsPkg.MetricNameAbciAppVersion, metrics[1].MetricName)
+	assert.InDelta(t, 2, metrics[1].Value, 0.01)
+
+	assert.Equal(t, metricsPkg.MetricNameAbciInfo, metrics[2].MetricName)
+	assert.Equal(t, map[string]string{
+		"name":    "GaiaApp",
+		"version": "v17.2.0",
+	}, metrics[2].Labels)
+	assert.InDelta(t, 1, metrics[2].Value, 0.01)
+
+	assert.Equal(t, metricsPkg.MetricNameAbciLastBlockAppHash, metrics[3].MetricName)
+	assert.Equal(t, map[string]string{"app_hash": "FF8C"}, metrics[3].Labels)
+	assert.InDelta(t, 1, metrics[3].Value, 0.01)
+}
+
+func TestAbciInfoGeneratorWithStatus(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{
+		constants.FetcherNameAbciInfo: &types.AbciInfo{
+			Info:                 tendermint.AbciInfo{LastBlockHeight: 1000},
+			LatestBlockHeight:    1002,
+			HasLatestBlockHeight: true,
+		},
+		// the node status might be overridden by the websocket, so it is not used
+		constants.FetcherNameNodeStatus: tendermint.StatusResponse{
+			Result: tendermint.StatusResult{
+				SyncInfo: tendermint.SyncInfo{LatestBlockHeight: 1010},
+			},
+		},
+	}
+
+	generator := NewAbciInfoGenerator()
+	metrics := generator.Get(state)
+	assert.Len(t, metrics, 5)
+
+	assert.Equal(t, metricsPkg.MetricNameAbciHeightLag, metrics[4].MetricName)
+	assert.InDelta(t, 2, metrics[4].Value, 0.01)
+}
@@ -0,0 +1,54 @@
+package generators
+
+import (
+	"main/pkg/constants"
+	"main/pkg/fetchers"
+	"main/pkg/metrics"
+	"main/pkg/types"
+	"sort"
+)
+
+type AppliedUpgradesGenerator struct{}
+
+func NewAppliedUpgradesGenerator() *AppliedUpgradesGenerator {
+	return &AppliedUpgradesGenerator{}
+}
+
+func (g *AppliedUpgradesGenerator) Get(state fetchers.State) []metrics.MetricInfo {
+	appliedUpgrades, appliedUpgradesFound := fetchers.StateGet[*types.AppliedUpgrades](state, constants.FetcherNameAppliedUpgrades)
+	if !appliedUpgradesFound {
+		return []metrics.MetricInfo{}
+	}
+
+	metricsInfo := []metrics.MetricInfo{}
+
+	upgradeNames := make([]string, 0, len(appliedUpgrades.AppliedPlans))
+	for name := range appliedUpgrades.AppliedPlans {
+		upgradeNames = append(upgradeNames, name)
+	}
+	sort.Strings(upgradeNames)
+
+	for _, name := range upgradeNames {
+		metricsInfo = append(metricsInfo, metrics.MetricInfo{
+			MetricName: metrics.MetricNameUpgradeAppliedHeight,
+			Labels:     map[string]string{"name": name},
+			Value:      float64(appliedUpgrades.AppliedPlans[name]),
+		})
+	}
+
+	moduleNames := make([]string, 0, len(appliedUpgrades.ModuleVersions))
+	for name := range appliedUpgrades.ModuleVersions {
+		moduleNames = append(moduleNames, name)
+	}
+	sort.Strings(moduleNames)
+
+	for _, name := range moduleNames {
+		metricsInfo = append(metricsInfo, metrics.MetricInfo{
+			MetricName: metrics.MetricNameModuleConsensusVersion,
+			Labels:     map[string]string{"module": name},
+			Value:      float64(appliedUpgrades.ModuleVersions[name]),
+		})
+	}
+
+	return metricsInfo
+}
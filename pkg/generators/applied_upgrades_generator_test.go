@@ -0,0 +1,75 @@
+package generators
+
+import (
+	"main/pkg/constants"
+	"main/pkg/fetchers"
+	metricsPkg "main/pkg/metrics"
+	"main/pkg/types"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppliedUpgradesGeneratorEmpty(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{}
+
+	generator := NewAppliedUpgradesGenerator()
+	metrics := generator.Get(state)
+	assert.Empty(t, metrics)
+}
+
+func TestAppliedUpgradesGeneratorInvalid(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if r := recover(); r == nil {
+			require.Fail(t, "Expected to have a panic here!")
+		}
+	}()
+
+	state := fetchers.State{
+		constants.FetcherNameAppliedUpgrades: 3,
+	}
+
+	generator := NewAppliedUpgradesGenerator()
+	generator.Get(state)
+}
+
+func TestAppliedUpgradesGeneratorOk(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{
+		constants.FetcherNameAppliedUpgrades: &types.AppliedUpgrades{
+			AppliedPlans:   map[string]int64{"v18": 0, "v17": 20739800},
+			ModuleVersions: map[string]uint64{"staking": 5, "bank": 4},
+		},
+	}
+
+	generator := NewAppliedUpgradesGenerator()
+	metrics := generator.Get(state)
+	assert.Equal(t, []metricsPkg.MetricInfo{
+		{
+			MetricName: metricsPkg.MetricNameUpgradeAppliedHeight,
+			Labels:     map[string]string{"name": "v17"},
+			Value:      20739800,
+		},
+		{
+			MetricName: metricsPkg.MetricNameUpgradeAppliedHeight,
+			Labels:     map[string]string{"name": "v18"},
+			Value:      0,
+		},
+		{
+			MetricName: metricsPkg.MetricNameModuleConsensusVersion,
+			Labels:     map[string]string{"module": "bank"},
+			Value:      4,
+		},
+		{
+			MetricName: metricsPkg.MetricNameModuleConsensusVersion,
+			Labels:     map[string]string{"module": "staking"},
+			Value:      5,
+		},
+	}, metrics)
+}
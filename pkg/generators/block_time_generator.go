@@ -0,0 +1,34 @@
+package generators
+
+import (
+	"main/pkg/clients/tendermint"
+	"main/pkg/constants"
+	"main/pkg/fetchers"
+	"main/pkg/metrics"
+	"strconv"
+)
+
+type BlockTimeGenerator struct{}
+
+func NewBlockTimeGenerator() *BlockTimeGenerator {
+	return &BlockTimeGenerator{}
+}
+
+func (g *BlockTimeGenerator) Get(state fetchers.State) []metrics.MetricInfo {
+	blockTimes, blockTimesFound := fetchers.StateGet[tendermint.BlockTimes](state, constants.FetcherNameBlockTime)
+	if !blockTimesFound {
+		return []metrics.MetricInfo{}
+	}
+
+	metricsInfo := make([]metrics.MetricInfo, len(blockTimes))
+
+	for index, blockTime := range blockTimes {
+		metricsInfo[index] = metrics.MetricInfo{
+			MetricName: metrics.MetricNameAverageBlockTime,
+			Labels:     map[string]string{"window": strconv.FormatInt(blockTime.Window, 10)},
+			Value:      blockTime.BlocksInfo.BlockTime(),
+		}
+	}
+
+	return metricsInfo
+}
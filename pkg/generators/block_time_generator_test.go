@@ -0,0 +1,77 @@
+package generators
+
+import (
+	"main/pkg/clients/tendermint"
+	"main/pkg/constants"
+	"main/pkg/fetchers"
+	metricsPkg "main/pkg/metrics"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockTimeGeneratorEmpty(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{}
+
+	generator := NewBlockTimeGenerator()
+	metrics := generator.Get(state)
+	assert.Empty(t, metrics)
+}
+
+func TestBlockTimeGeneratorInvalid(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if r := recover(); r == nil {
+			require.Fail(t, "Expected to have a panic here!")
+		}
+	}()
+
+	state := fetchers.State{
+		constants.FetcherNameBlockTime: 3,
+	}
+
+	generator := NewBlockTimeGenerator()
+	generator.Get(state)
+}
+
+func TestBlockTimeGeneratorOk(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, 6, 29, 0, 0, 0, 0, time.UTC)
+	blocksInfo := func(blocks int64, blockTime time.Duration) *tendermint.BlocksInfo {
+		return &tendermint.BlocksInfo{
+			NewerBlock: tendermint.BlockResponse{Result: tendermint.BlockResult{Block: tendermint.Block{
+				Header: tendermint.BlockHeader{Height: 2000, Time: start},
+			}}},
+			OlderBlock: tendermint.BlockResponse{Result: tendermint.BlockResult{Block: tendermint.Block{
+				Header: tendermint.BlockHeader{Height: 2000 - blocks, Time: start.Add(-time.Duration(blocks) * blockTime)},
+			}}},
+		}
+	}
+
+	state := fetchers.State{
+		constants.FetcherNameBlockTime: tendermint.BlockTimes{
+			{Window: 100, BlocksInfo: blocksInfo(100, 7*time.Second)},
+			{Window: 1000, BlocksInfo: blocksInfo(1000, 6*time.Second)},
+		},
+	}
+
+	generator := NewBlockTimeGenerator()
+	metrics := generator.Get(state)
+	assert.Len(t, metrics, 2)
+
+	first := metrics[0]
+	assert.Equal(t, metricsPkg.MetricNameAverageBlockTime, first.MetricName)
+	assert.Equal(t, map[string]string{"window": "100"}, first.Labels)
+	assert.InDelta(t, 7, first.Value, 0.01)
+
+	second := metrics[1]
+	assert.Equal(t, metricsPkg.MetricNameAverageBlockTime, second.MetricName)
+	assert.Equal(t, map[string]string{"window": "1000"}, second.Labels)
+	assert.InDelta(t, 6, second.Value, 0.01)
+}
@@ -0,0 +1,49 @@
+package generators
+
+import (
+	"main/pkg/clients/chain_registry"
+	"main/pkg/constants"
+	"main/pkg/fetchers"
+	"main/pkg/metrics"
+)
+
+type ChainRegistryGenerator struct{}
+
+func NewChainRegistryGenerator() *ChainRegistryGenerator {
+	return &ChainRegistryGenerator{}
+}
+
+func (g *ChainRegistryGenerator) Get(state fetchers.State) []metrics.MetricInfo {
+	chainInfo, chainInfoFound := fetchers.StateGet[*chain_registry.ChainInfo](state, constants.FetcherNameChainRegistry)
+	if !chainInfoFound {
+		return []metrics.MetricInfo{}
+	}
+
+	metricsInfo := []metrics.MetricInfo{}
+
+	for _, version := range chainInfo.Codebase.CompatibleVersions {
+		metricsInfo = append(metricsInfo, metrics.MetricInfo{
+			MetricName: metrics.MetricNameChainRegistryCompatibleVersion,
+			Labels:     map[string]string{"version": version},
+			Value:      1,
+		})
+	}
+
+	for _, version := range chainInfo.Codebase.Versions {
+		// versions without a height are not scheduled yet (or are the genesis one)
+		if version.Height == 0 {
+			continue
+		}
+
+		metricsInfo = append(metricsInfo, metrics.MetricInfo{
+			MetricName: metrics.MetricNameChainRegistryUpgradeHeight,
+			Labels: map[string]string{
+				"name":                version.Name,
+				"recommended_version": version.RecommendedVersion,
+			},
+			Value: float64(version.Height),
+		})
+	}
+
+	return metricsInfo
+}
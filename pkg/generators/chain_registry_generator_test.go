@@ -0,0 +1,84 @@
+package generators
+
+import (
+	"context"
+	"main/pkg/clients/chain_registry"
+	configPkg "main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/fetchers"
+	"main/pkg/fs"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/metrics"
+	"main/pkg/tracing"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChainRegistryGeneratorNoData(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{}
+	generator := NewChainRegistryGenerator()
+	results := generator.Get(state)
+	assert.Empty(t, results)
+}
+
+func TestChainRegistryGeneratorInvalid(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if r := recover(); r == nil {
+			require.Fail(t, "Expected to have a panic here!")
+		}
+	}()
+
+	state := fetchers.State{
+		constants.FetcherNameChainRegistry: 3,
+	}
+
+	generator := NewChainRegistryGenerator()
+	generator.Get(state)
+}
+
+func TestChainRegistryGeneratorOk(t *testing.T) {
+	t.Parallel()
+
+	config := configPkg.ChainRegistryConfig{Path: "chain-registry.json"}
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := chain_registry.NewClient(config, *logger, tracer)
+	client.Filesystem = &fs.TestFS{}
+	fetcher := fetchers.NewChainRegistryFetcher(*logger, client, tracer)
+
+	data, _ := fetcher.Get(context.Background())
+	assert.NotNil(t, data)
+
+	state := fetchers.State{
+		constants.FetcherNameChainRegistry: data,
+	}
+
+	generator := NewChainRegistryGenerator()
+	results := generator.Get(state)
+	assert.Len(t, results, 4)
+
+	assert.Equal(t, metrics.MetricNameChainRegistryCompatibleVersion, results[0].MetricName)
+	assert.Equal(t, map[string]string{"version": "v17.1.0"}, results[0].Labels)
+	assert.InDelta(t, 1, results[0].Value, 0.01)
+
+	assert.Equal(t, map[string]string{"version": "v17.2.0"}, results[1].Labels)
+
+	assert.Equal(t, metrics.MetricNameChainRegistryUpgradeHeight, results[2].MetricName)
+	assert.Equal(t, map[string]string{
+		"name":                "v16",
+		"recommended_version": "v16.0.0",
+	}, results[2].Labels)
+	assert.InDelta(t, 19939000, results[2].Value, 0.01)
+
+	assert.Equal(t, map[string]string{
+		"name":                "v17",
+		"recommended_version": "v17.2.0",
+	}, results[3].Labels)
+	assert.InDelta(t, 20739800, results[3].Value, 0.01)
+}
@@ -0,0 +1,41 @@
+package generators
+
+import (
+	"main/pkg/constants"
+	"main/pkg/fetchers"
+	"main/pkg/metrics"
+	"main/pkg/types"
+)
+
+type ClockDriftGenerator struct{}
+
+func NewClockDriftGenerator() *ClockDriftGenerator {
+	return &ClockDriftGenerator{}
+}
+
+func (g *ClockDriftGenerator) Get(state fetchers.State) []metrics.MetricInfo {
+	clockDrift, clockDriftFound := fetchers.StateGet[*types.ClockDrift](state, constants.FetcherNameClockDrift)
+	if !clockDriftFound {
+		return []metrics.MetricInfo{}
+	}
+
+	metricsInfo := []metrics.MetricInfo{}
+
+	if clockDrift.HasBlockTimeOffset {
+		metricsInfo = append(metricsInfo, metrics.MetricInfo{
+			MetricName: metrics.MetricNameBlockTimeClockOffset,
+			Labels:     map[string]string{},
+			Value:      clockDrift.BlockTimeOffset.Seconds(),
+		})
+	}
+
+	if clockDrift.HasNtpOffset {
+		metricsInfo = append(metricsInfo, metrics.MetricInfo{
+			MetricName: metrics.MetricNameNtpClockOffset,
+			Labels:     map[string]string{},
+			Value:      clockDrift.NtpOffset.Seconds(),
+		})
+	}
+
+	return metricsInfo
+}
@@ -0,0 +1,78 @@
+package generators
+
+import (
+	"main/pkg/constants"
+	"main/pkg/fetchers"
+	metricsPkg "main/pkg/metrics"
+	"main/pkg/types"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClockDriftGeneratorEmpty(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{}
+
+	generator := NewClockDriftGenerator()
+	metrics := generator.Get(state)
+	assert.Empty(t, metrics)
+}
+
+func TestClockDriftGeneratorInvalid(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if r := recover(); r == nil {
+			require.Fail(t, "Expected to have a panic here!")
+		}
+	}()
+
+	state := fetchers.State{
+		constants.FetcherNameClockDrift: 3,
+	}
+
+	generator := NewClockDriftGenerator()
+	generator.Get(state)
+}
+
+func TestClockDriftGeneratorOk(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{
+		constants.FetcherNameClockDrift: &types.ClockDrift{
+			BlockTimeOffset:    2500 * time.Millisecond,
+			HasBlockTimeOffset: true,
+			NtpOffset:          -300 * time.Millisecond,
+			HasNtpOffset:       true,
+		},
+	}
+
+	generator := NewClockDriftGenerator()
+	metrics := generator.Get(state)
+	assert.Len(t, metrics, 2)
+
+	assert.Equal(t, metricsPkg.MetricNameBlockTimeClockOffset, metrics[0].MetricName)
+	assert.InDelta(t, 2.5, metrics[0].Value, 0.01)
+	assert.Equal(t, metricsPkg.MetricNameNtpClockOffset, metrics[1].MetricName)
+	assert.InDelta(t, -0.3, metrics[1].Value, 0.01)
+}
+
+func TestClockDriftGeneratorNtpOnly(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{
+		constants.FetcherNameClockDrift: &types.ClockDrift{
+			NtpOffset:    time.Second,
+			HasNtpOffset: true,
+		},
+	}
+
+	generator := NewClockDriftGenerator()
+	metrics := generator.Get(state)
+	assert.Len(t, metrics, 1)
+	assert.Equal(t, metricsPkg.MetricNameNtpClockOffset, metrics[0].MetricName)
+}
@@ -0,0 +1,126 @@
+package generators
+
+import (
+	"main/pkg/clients/config_files"
+	"main/pkg/constants"
+	"main/pkg/fetchers"
+	"main/pkg/metrics"
+	"main/pkg/utils"
+)
+
+type ConfigFilesGenerator struct{}
+
+func NewConfigFilesGenerator() *ConfigFilesGenerator {
+	return &ConfigFilesGenerator{}
+}
+
+func (g *ConfigFilesGenerator) Get(state fetchers.State) []metrics.MetricInfo {
+	configFiles, found := fetchers.StateGet[*config_files.NodeConfigFiles](state, constants.FetcherNameConfigFiles)
+	if !found {
+		return []metrics.MetricInfo{}
+	}
+
+	metricsInfo := []metrics.MetricInfo{}
+
+	if configToml := configFiles.Config; configToml != nil {
+		metricsInfo = append(
+			metricsInfo,
+			metrics.MetricInfo{
+				MetricName: metrics.MetricNameConfigServerEnabled,
+				Labels: map[string]string{
+					"server":  "rpc",
+					"address": configToml.RPC.ListenAddress,
+				},
+				Value: utils.BoolToFloat64(configToml.RPC.ListenAddress != ""),
+			},
+			metrics.MetricInfo{
+				MetricName: metrics.MetricNameConfigTxIndexer,
+				Labels:     map[string]string{"indexer": configToml.TxIndex.Indexer},
+				Value:      1,
+			},
+			metrics.MetricInfo{
+				MetricName: metrics.MetricNameConfigStateSyncEnabled,
+				Labels:     map[string]string{},
+				Value:      utils.BoolToFloat64(configToml.StateSync.Enable),
+			},
+			metrics.MetricInfo{
+				MetricName: metrics.MetricNameConfigMempoolSize,
+				Labels:     map[string]string{},
+				Value:      float64(configToml.Mempool.Size),
+			},
+			metrics.MetricInfo{
+				MetricName: metrics.MetricNameConfigMempoolMaxTxsBytes,
+				Labels:     map[string]string{},
+				Value:      float64(configToml.Mempool.MaxTxsBytes),
+			},
+			metrics.MetricInfo{
+				MetricName: metrics.MetricNameConfigMempoolCacheSize,
+				Labels:     map[string]string{},
+				Value:      float64(configToml.Mempool.CacheSize),
+			},
+		)
+	}
+
+	if appToml := configFiles.App; appToml != nil {
+		metricsInfo = append(
+			metricsInfo,
+			metrics.MetricInfo{
+				MetricName: metrics.MetricNameConfigPruning,
+				Labels:     map[string]string{"strategy": appToml.Pruning},
+				Value:      1,
+			},
+			metrics.MetricInfo{
+				MetricName: metrics.MetricNameConfigPruningKeepRecent,
+				Labels:     map[string]string{},
+				Value:      float64(appToml.PruningKeepRecent),
+			},
+			metrics.MetricInfo{
+				MetricName: metrics.MetricNameConfigPruningInterval,
+				Labels:     map[string]string{},
+				Value:      float64(appToml.PruningInterval),
+			},
+			metrics.MetricInfo{
+				MetricName: metrics.MetricNameConfigMinRetainBlocks,
+				Labels:     map[string]string{},
+				Value:      float64(appToml.MinRetainBlocks),
+			},
+			metrics.MetricInfo{
+				MetricName: metrics.MetricNameConfigSnapshotInterval,
+				Labels:     map[string]string{},
+				Value:      float64(appToml.StateSync.SnapshotInterval),
+			},
+			metrics.MetricInfo{
+				MetricName: metrics.MetricNameConfigSnapshotKeepRecent,
+				Labels:     map[string]string{},
+				Value:      float64(appToml.StateSync.SnapshotKeepRecent),
+			},
+			metrics.MetricInfo{
+				MetricName: metrics.MetricNameConfigAppMempoolMaxTxs,
+				Labels:     map[string]string{},
+				Value:      float64(appToml.Mempool.MaxTxs),
+			},
+		)
+
+		servers := []struct {
+			Name   string
+			Config config_files.ServerConfig
+		}{
+			{Name: "api", Config: appToml.API},
+			{Name: "grpc", Config: appToml.Grpc},
+			{Name: "grpc-web", Config: appToml.GrpcWeb},
+		}
+
+		for _, server := range servers {
+			metricsInfo = append(metricsInfo, metrics.MetricInfo{
+				MetricName: metrics.MetricNameConfigServerEnabled,
+				Labels: map[string]string{
+					"server":  server.Name,
+					"address": server.Config.Address,
+				},
+				Value: utils.BoolToFloat64(server.Config.Enable),
+			})
+		}
+	}
+
+	return metricsInfo
+}
@@ -0,0 +1,131 @@
+package generators
+
+import (
+	"main/pkg/clients/config_files"
+	"main/pkg/constants"
+	"main/pkg/fetchers"
+	"main/pkg/metrics"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigFilesGeneratorNoData(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{}
+	generator := NewConfigFilesGenerator()
+	results := generator.Get(state)
+	assert.Empty(t, results)
+}
+
+func TestConfigFilesGeneratorInvalid(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if r := recover(); r == nil {
+			require.Fail(t, "Expected to have a panic here!")
+		}
+	}()
+
+	state := fetchers.State{
+		constants.FetcherNameConfigFiles: 3,
+	}
+
+	generator := NewConfigFilesGenerator()
+	generator.Get(state)
+}
+
+func TestConfigFilesGeneratorOnlyConfigToml(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{
+		constants.FetcherNameConfigFiles: &config_files.NodeConfigFiles{
+			Config: &config_files.ConfigToml{
+				RPC:       config_files.RPCConfig{ListenAddress: "tcp://127.0.0.1:26657"},
+				Mempool:   config_files.MempoolConfig{Size: 5000, MaxTxsBytes: 1073741824, CacheSize: 10000},
+				StateSync: config_files.StateSyncConfig{Enable: true},
+				TxIndex:   config_files.TxIndexConfig{Indexer: "null"},
+			},
+		},
+	}
+
+	generator := NewConfigFilesGenerator()
+	results := generator.Get(state)
+	require.Len(t, results, 6)
+
+	rpc := results[0]
+	assert.Equal(t, metrics.MetricNameConfigServerEnabled, rpc.MetricName)
+	assert.Equal(t, map[string]string{"server": "rpc", "address": "tcp://127.0.0.1:26657"}, rpc.Labels)
+	assert.InDelta(t, 1, rpc.Value, 0.01)
+
+	indexer := results[1]
+	assert.Equal(t, metrics.MetricNameConfigTxIndexer, indexer.MetricName)
+	assert.Equal(t, map[string]string{"indexer": "null"}, indexer.Labels)
+	assert.InDelta(t, 1, indexer.Value, 0.01)
+
+	stateSync := results[2]
+	assert.Equal(t, metrics.MetricNameConfigStateSyncEnabled, stateSync.MetricName)
+	assert.InDelta(t, 1, stateSync.Value, 0.01)
+
+	mempoolSize := results[3]
+	assert.Equal(t, metrics.MetricNameConfigMempoolSize, mempoolSize.MetricName)
+	assert.InDelta(t, 5000, mempoolSize.Value, 0.01)
+}
+
+func TestConfigFilesGeneratorOk(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{
+		constants.FetcherNameConfigFiles: &config_files.NodeConfigFiles{
+			Config: &config_files.ConfigToml{},
+			App: &config_files.AppToml{
+				Pruning:           "custom",
+				PruningKeepRecent: 100,
+				PruningInterval:   10,
+				MinRetainBlocks:   50,
+				API:               config_files.ServerConfig{Enable: true, Address: "tcp://0.0.0.0:1317"},
+				Grpc:              config_files.ServerConfig{Enable: true, Address: "localhost:9090"},
+				GrpcWeb:           config_files.ServerConfig{Enable: false, Address: "localhost:9091"},
+				StateSync:         config_files.SnapshotsConfig{SnapshotInterval: 1000, SnapshotKeepRecent: 2},
+				Mempool:           config_files.AppMempool{MaxTxs: 5000},
+			},
+		},
+	}
+
+	generator := NewConfigFilesGenerator()
+	results := generator.Get(state)
+	require.Len(t, results, 16)
+
+	rpc := results[0]
+	assert.Equal(t, metrics.MetricNameConfigServerEnabled, rpc.MetricName)
+	assert.Equal(t, map[string]string{"server": "rpc", "address": ""}, rpc.Labels)
+	assert.Zero(t, rpc.Value)
+
+	pruning := results[6]
+	assert.Equal(t, metrics.MetricNameConfigPruning, pruning.MetricName)
+	assert.Equal(t, map[string]string{"strategy": "custom"}, pruning.Labels)
+	assert.InDelta(t, 1, pruning.Value, 0.01)
+
+	keepRecent := results[7]
+	assert.Equal(t, metrics.MetricNameConfigPruningKeepRecent, keepRecent.MetricName)
+	assert.InDelta(t, 100, keepRecent.Value, 0.01)
+
+	minRetainBlocks := results[9]
+	assert.Equal(t, metrics.MetricNameConfigMinRetainBlocks, minRetainBlocks.MetricName)
+	assert.InDelta(t, 50, minRetainBlocks.Value, 0.01)
+
+	appMempool := results[12]
+	assert.Equal(t, metrics.MetricNameConfigAppMempoolMaxTxs, appMempool.MetricName)
+	assert.InDelta(t, 5000, appMempool.Value, 0.01)
+
+	api := results[13]
+	assert.Equal(t, metrics.MetricNameConfigServerEnabled, api.MetricName)
+	assert.Equal(t, map[string]string{"server": "api", "address": "tcp://0.0.0.0:1317"}, api.Labels)
+	assert.InDelta(t, 1, api.Value, 0.01)
+
+	grpcWeb := results[15]
+	assert.Equal(t, map[string]string{"server": "grpc-web", "address": "localhost:9091"}, grpcWeb.Labels)
+	assert.Zero(t, grpcWeb.Value)
+}
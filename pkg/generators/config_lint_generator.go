@@ -0,0 +1,41 @@
+package generators
+
+import (
+	"main/pkg/clients/config_files"
+	"main/pkg/config_lint"
+	"main/pkg/constants"
+	"main/pkg/fetchers"
+	"main/pkg/metrics"
+	"main/pkg/utils"
+)
+
+type ConfigLintGenerator struct {
+	Role string
+}
+
+func NewConfigLintGenerator(role string) *ConfigLintGenerator {
+	return &ConfigLintGenerator{Role: role}
+}
+
+func (g *ConfigLintGenerator) Get(state fetchers.State) []metrics.MetricInfo {
+	configFiles, found := fetchers.StateGet[*config_files.NodeConfigFiles](state, constants.FetcherNameConfigFiles)
+	if !found {
+		return []metrics.MetricInfo{}
+	}
+
+	results := config_lint.Lint(configFiles, g.Role)
+	metricsInfo := make([]metrics.MetricInfo, len(results))
+
+	for index, result := range results {
+		metricsInfo[index] = metrics.MetricInfo{
+			MetricName: metrics.MetricNameConfigLintViolation,
+			Labels: map[string]string{
+				"rule":     result.Rule,
+				"severity": result.Severity,
+			},
+			Value: utils.BoolToFloat64(result.Violated),
+		}
+	}
+
+	return metricsInfo
+}
@@ -0,0 +1,71 @@
+package generators
+
+import (
+	"main/pkg/clients/config_files"
+	"main/pkg/constants"
+	"main/pkg/fetchers"
+	"main/pkg/metrics"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigLintGeneratorNoData(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{}
+	generator := NewConfigLintGenerator(constants.NodeRoleValidator)
+	results := generator.Get(state)
+	assert.Empty(t, results)
+}
+
+func TestConfigLintGeneratorInvalid(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if r := recover(); r == nil {
+			require.Fail(t, "Expected to have a panic here!")
+		}
+	}()
+
+	state := fetchers.State{
+		constants.FetcherNameConfigFiles: 3,
+	}
+
+	generator := NewConfigLintGenerator(constants.NodeRoleValidator)
+	generator.Get(state)
+}
+
+func TestConfigLintGeneratorOk(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{
+		constants.FetcherNameConfigFiles: &config_files.NodeConfigFiles{
+			Config: &config_files.ConfigToml{
+				RPC:       config_files.RPCConfig{ListenAddress: "tcp://0.0.0.0:26657"},
+				Consensus: config_files.ConsensusConfig{DoubleSignCheckHeight: 10},
+			},
+		},
+	}
+
+	generator := NewConfigLintGenerator(constants.NodeRoleValidator)
+	results := generator.Get(state)
+	require.Len(t, results, 3)
+
+	rpcPublic := results[0]
+	assert.Equal(t, metrics.MetricNameConfigLintViolation, rpcPublic.MetricName)
+	assert.Equal(t, map[string]string{
+		"rule":     "rpc-public-on-validator",
+		"severity": constants.ConfigLintSeverityCritical,
+	}, rpcPublic.Labels)
+	assert.InDelta(t, 1, rpcPublic.Value, 0.01)
+
+	doubleSignCheck := results[1]
+	assert.Equal(t, "double-sign-check-disabled", doubleSignCheck.Labels["rule"])
+	assert.Zero(t, doubleSignCheck.Value)
+
+	cors := results[2]
+	assert.Equal(t, "rpc-cors-wildcard", cors.Labels["rule"])
+	assert.Zero(t, cors.Value)
+}
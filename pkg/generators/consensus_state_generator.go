@@ -0,0 +1,54 @@
+package generators
+
+import (
+	"main/pkg/constants"
+	"main/pkg/fetchers"
+	"main/pkg/metrics"
+	"main/pkg/types"
+)
+
+type ConsensusStateGenerator struct{}
+
+func NewConsensusStateGenerator() *ConsensusStateGenerator {
+	return &ConsensusStateGenerator{}
+}
+
+func (g *ConsensusStateGenerator) Get(state fetchers.State) []metrics.MetricInfo {
+	consensusState, consensusStateFound := fetchers.StateGet[*types.ConsensusStateInfo](state, constants.FetcherNameConsensusState)
+	if !consensusStateFound {
+		return []metrics.MetricInfo{}
+	}
+
+	return []metrics.MetricInfo{
+		{
+			MetricName: metrics.MetricNameConsensusHeight,
+			Labels:     map[string]string{},
+			Value:      float64(consensusState.State.Height),
+		},
+		{
+			MetricName: metrics.MetricNameConsensusRound,
+			Labels:     map[string]string{},
+			Value:      float64(consensusState.State.Round),
+		},
+		{
+			MetricName: metrics.MetricNameConsensusStep,
+			Labels:     map[string]string{},
+			Value:      float64(consensusState.State.Step),
+		},
+		{
+			MetricName: metrics.MetricNameConsensusPrevotesPercent,
+			Labels:     map[string]string{},
+			Value:      consensusState.State.PrevotesPercent,
+		},
+		{
+			MetricName: metrics.MetricNameConsensusPrecommitsPercent,
+			Labels:     map[string]string{},
+			Value:      consensusState.State.PrecommitsPercent,
+		},
+		{
+			MetricName: metrics.MetricNameSecondsSinceHeightChange,
+			Labels:     map[string]string{},
+			Value:      consensusState.TimeSinceHeightChange.Seconds(),
+		},
+	}
+}
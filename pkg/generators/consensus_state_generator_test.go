@@ -0,0 +1,86 @@
+package generators
+
+import (
+	"main/pkg/clients/tendermint"
+	"main/pkg/constants"
+	"main/pkg/fetchers"
+	metricsPkg "main/pkg/metrics"
+	"main/pkg/types"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConsensusStateGeneratorEmpty(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{}
+
+	generator := NewConsensusStateGenerator()
+	metrics := generator.Get(state)
+	assert.Empty(t, metrics)
+}
+
+func TestConsensusStateGeneratorInvalid(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if r := recover(); r == nil {
+			require.Fail(t, "Expected to have a panic here!")
+		}
+	}()
+
+	state := fetchers.State{
+		constants.FetcherNameConsensusState: 3,
+	}
+
+	generator := NewConsensusStateGenerator()
+	generator.Get(state)
+}
+
+func TestConsensusStateGeneratorOk(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{
+		constants.FetcherNameConsensusState: &types.ConsensusStateInfo{
+			State: &tendermint.ConsensusState{
+				Height:            21077109,
+				Round:             1,
+				Step:              6,
+				PrevotesPercent:   75,
+				PrecommitsPercent: 50,
+			},
+			TimeSinceHeightChange: 30 * time.Second,
+		},
+	}
+
+	generator := NewConsensusStateGenerator()
+	metrics := generator.Get(state)
+	assert.Len(t, metrics, 6)
+
+	height := metrics[0]
+	assert.Equal(t, metricsPkg.MetricNameConsensusHeight, height.MetricName)
+	assert.InDelta(t, 21077109, height.Value, 0.01)
+
+	round := metrics[1]
+	assert.Equal(t, metricsPkg.MetricNameConsensusRound, round.MetricName)
+	assert.InDelta(t, 1, round.Value, 0.01)
+
+	step := metrics[2]
+	assert.Equal(t, metricsPkg.MetricNameConsensusStep, step.MetricName)
+	assert.InDelta(t, 6, step.Value, 0.01)
+
+	prevotes := metrics[3]
+	assert.Equal(t, metricsPkg.MetricNameConsensusPrevotesPercent, prevotes.MetricName)
+	assert.InDelta(t, 75, prevotes.Value, 0.01)
+
+	precommits := metrics[4]
+	assert.Equal(t, metricsPkg.MetricNameConsensusPrecommitsPercent, precommits.MetricName)
+	assert.InDelta(t, 50, precommits.Value, 0.01)
+
+	sinceHeightChange := metrics[5]
+	assert.Equal(t, metricsPkg.MetricNameSecondsSinceHeightChange, sinceHeightChange.MetricName)
+	assert.InDelta(t, 30, sinceHeightChange.Value, 0.01)
+}
@@ -0,0 +1,60 @@
+package generators
+
+import (
+	"main/pkg/constants"
+	"main/pkg/fetchers"
+	"main/pkg/metrics"
+	"main/pkg/types"
+	"main/pkg/utils"
+)
+
+type CosmovisorSettingsGenerator struct{}
+
+func NewCosmovisorSettingsGenerator() *CosmovisorSettingsGenerator {
+	return &CosmovisorSettingsGenerator{}
+}
+
+func (g *CosmovisorSettingsGenerator) Get(state fetchers.State) []metrics.MetricInfo {
+	settings, settingsFound := fetchers.StateGet[*types.CosmovisorSettings](state, constants.FetcherNameCosmovisorSettings)
+	if !settingsFound {
+		return []metrics.MetricInfo{}
+	}
+
+	metricsInfo := []metrics.MetricInfo{
+		{
+			MetricName: metrics.MetricNameCosmovisorAllowDownloadBinaries,
+			Labels:     map[string]string{},
+			Value:      utils.BoolToFloat64(settings.AllowDownloadBinaries),
+		},
+		{
+			MetricName: metrics.MetricNameCosmovisorRestartAfterUpgrade,
+			Labels:     map[string]string{},
+			Value:      utils.BoolToFloat64(settings.RestartAfterUpgrade),
+		},
+		{
+			MetricName: metrics.MetricNameCosmovisorUnsafeSkipBackup,
+			Labels:     map[string]string{},
+			Value:      utils.BoolToFloat64(settings.UnsafeSkipBackup),
+		},
+		{
+			MetricName: metrics.MetricNameCosmovisorPollIntervalSeconds,
+			Labels:     map[string]string{},
+			Value:      settings.PollInterval.Seconds(),
+		},
+		{
+			MetricName: metrics.MetricNameCosmovisorRestartDelaySeconds,
+			Labels:     map[string]string{},
+			Value:      settings.RestartDelay.Seconds(),
+		},
+	}
+
+	for name, value := range settings.Values {
+		metricsInfo = append(metricsInfo, metrics.MetricInfo{
+			MetricName: metrics.MetricNameCosmovisorSetting,
+			Labels:     map[string]string{"name": name, "value": value},
+			Value:      1,
+		})
+	}
+
+	return metricsInfo
+}
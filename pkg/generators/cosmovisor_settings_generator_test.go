@@ -0,0 +1,88 @@
+package generators
+
+import (
+	"main/pkg/constants"
+	"main/pkg/fetchers"
+	"main/pkg/metrics"
+	"main/pkg/types"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCosmovisorSettingsGeneratorNoData(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{}
+	generator := NewCosmovisorSettingsGenerator()
+	results := generator.Get(state)
+	assert.Empty(t, results)
+}
+
+func TestCosmovisorSettingsGeneratorInvalid(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if r := recover(); r == nil {
+			require.Fail(t, "Expected to have a panic here!")
+		}
+	}()
+
+	state := fetchers.State{
+		constants.FetcherNameCosmovisorSettings: 3,
+	}
+
+	generator := NewCosmovisorSettingsGenerator()
+	generator.Get(state)
+}
+
+func TestCosmovisorSettingsGeneratorOk(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{
+		constants.FetcherNameCosmovisorSettings: &types.CosmovisorSettings{
+			AllowDownloadBinaries: true,
+			RestartAfterUpgrade:   false,
+			UnsafeSkipBackup:      true,
+			PollInterval:          300 * time.Millisecond,
+			RestartDelay:          10 * time.Second,
+			Values: map[string]string{
+				"DAEMON_RESTART_AFTER_UPGRADE": "false",
+			},
+		},
+	}
+
+	generator := NewCosmovisorSettingsGenerator()
+	results := generator.Get(state)
+	require.Len(t, results, 6)
+
+	allowDownload := results[0]
+	assert.Equal(t, metrics.MetricNameCosmovisorAllowDownloadBinaries, allowDownload.MetricName)
+	assert.InDelta(t, 1, allowDownload.Value, 0.01)
+
+	restartAfterUpgrade := results[1]
+	assert.Equal(t, metrics.MetricNameCosmovisorRestartAfterUpgrade, restartAfterUpgrade.MetricName)
+	assert.Zero(t, restartAfterUpgrade.Value)
+
+	unsafeSkipBackup := results[2]
+	assert.Equal(t, metrics.MetricNameCosmovisorUnsafeSkipBackup, unsafeSkipBackup.MetricName)
+	assert.InDelta(t, 1, unsafeSkipBackup.Value, 0.01)
+
+	pollInterval := results[3]
+	assert.Equal(t, metrics.MetricNameCosmovisorPollIntervalSeconds, pollInterval.MetricName)
+	assert.InDelta(t, 0.3, pollInterval.Value, 0.01)
+
+	restartDelay := results[4]
+	assert.Equal(t, metrics.MetricNameCosmovisorRestartDelaySeconds, restartDelay.MetricName)
+	assert.InDelta(t, 10, restartDelay.Value, 0.01)
+
+	setting := results[5]
+	assert.Equal(t, metrics.MetricNameCosmovisorSetting, setting.MetricName)
+	assert.Equal(t, map[string]string{
+		"name":  "DAEMON_RESTART_AFTER_UPGRADE",
+		"value": "false",
+	}, setting.Labels)
+	assert.InDelta(t, 1, setting.Value, 0.01)
+}
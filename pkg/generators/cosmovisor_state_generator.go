@@ -0,0 +1,56 @@
+package generators
+
+import (
+	"main/pkg/constants"
+	"main/pkg/fetchers"
+	"main/pkg/metrics"
+	"main/pkg/types"
+	"main/pkg/utils"
+)
+
+type CosmovisorStateGenerator struct{}
+
+func NewCosmovisorStateGenerator() *CosmovisorStateGenerator {
+	return &CosmovisorStateGenerator{}
+}
+
+func (g *CosmovisorStateGenerator) Get(state fetchers.State) []metrics.MetricInfo {
+	cosmovisorState, cosmovisorStateFound := fetchers.StateGet[*types.CosmovisorState](state, constants.FetcherNameCosmovisorState)
+	if !cosmovisorStateFound {
+		return []metrics.MetricInfo{}
+	}
+
+	metricsInfo := []metrics.MetricInfo{
+		{
+			MetricName: metrics.MetricNameCosmovisorGenesisBinaryPresent,
+			Labels:     map[string]string{},
+			Value:      utils.BoolToFloat64(cosmovisorState.GenesisBinaryPresent),
+		},
+	}
+
+	if cosmovisorState.CurrentUpgrade != "" {
+		metricsInfo = append(metricsInfo, metrics.MetricInfo{
+			MetricName: metrics.MetricNameCosmovisorCurrentUpgrade,
+			Labels:     map[string]string{"name": cosmovisorState.CurrentUpgrade},
+			Value:      1,
+		})
+	}
+
+	for _, upgrade := range cosmovisorState.Upgrades {
+		metricsInfo = append(metricsInfo, metrics.MetricInfo{
+			MetricName: metrics.MetricNameCosmovisorUpgradeFolderBinaryPresent,
+			Labels:     map[string]string{"name": upgrade.Name},
+			Value:      utils.BoolToFloat64(upgrade.BinaryPresent),
+		})
+
+		if upgrade.HasBinaryModTime {
+			metricsInfo = append(metricsInfo, metrics.MetricInfo{
+				MetricName: metrics.MetricNameCosmovisorUpgradeFolderBinaryModifiedTime,
+				Labels:     map[string]string{"name": upgrade.Name},
+				Value:      float64(upgrade.BinaryModTime.Unix()),
+			})
+		}
+	}
+
+	return metricsInfo
+}
@@ -0,0 +1,92 @@
+package generators
+
+import (
+	"main/pkg/constants"
+	"main/pkg/fetchers"
+	"main/pkg/metrics"
+	"main/pkg/types"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCosmovisorStateGeneratorNoData(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{}
+	generator := NewCosmovisorStateGenerator()
+	results := generator.Get(state)
+	assert.Empty(t, results)
+}
+
+func TestCosmovisorStateGeneratorInvalid(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if r := recover(); r == nil {
+			require.Fail(t, "Expected to have a panic here!")
+		}
+	}()
+
+	state := fetchers.State{
+		constants.FetcherNameCosmovisorState: 3,
+	}
+
+	generator := NewCosmovisorStateGenerator()
+	generator.Get(state)
+}
+
+func TestCosmovisorStateGeneratorNoCurrent(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{
+		constants.FetcherNameCosmovisorState: &types.CosmovisorState{},
+	}
+
+	generator := NewCosmovisorStateGenerator()
+	results := generator.Get(state)
+	require.Len(t, results, 1)
+	assert.Equal(t, metrics.MetricNameCosmovisorGenesisBinaryPresent, results[0].MetricName)
+	assert.Zero(t, results[0].Value)
+}
+
+func TestCosmovisorStateGeneratorOk(t *testing.T) {
+	t.Parallel()
+
+	modTime := time.Unix(1719619200, 0)
+
+	state := fetchers.State{
+		constants.FetcherNameCosmovisorState: &types.CosmovisorState{
+			CurrentUpgrade:       "v16",
+			GenesisBinaryPresent: true,
+			Upgrades: []types.CosmovisorUpgradeFolder{
+				{Name: "v16", BinaryPresent: true, BinaryModTime: modTime, HasBinaryModTime: true},
+				{Name: "v17", BinaryPresent: false},
+			},
+		},
+	}
+
+	generator := NewCosmovisorStateGenerator()
+	results := generator.Get(state)
+	require.Len(t, results, 5)
+
+	assert.Equal(t, metrics.MetricNameCosmovisorGenesisBinaryPresent, results[0].MetricName)
+	assert.InDelta(t, 1, results[0].Value, 0.01)
+
+	assert.Equal(t, metrics.MetricNameCosmovisorCurrentUpgrade, results[1].MetricName)
+	assert.Equal(t, map[string]string{"name": "v16"}, results[1].Labels)
+	assert.InDelta(t, 1, results[1].Value, 0.01)
+
+	assert.Equal(t, metrics.MetricNameCosmovisorUpgradeFolderBinaryPresent, results[2].MetricName)
+	assert.Equal(t, map[string]string{"name": "v16"}, results[2].Labels)
+	assert.InDelta(t, 1, results[2].Value, 0.01)
+
+	assert.Equal(t, metrics.MetricNameCosmovisorUpgradeFolderBinaryModifiedTime, results[3].MetricName)
+	assert.InDelta(t, 1719619200, results[3].Value, 0.01)
+
+	assert.Equal(t, metrics.MetricNameCosmovisorUpgradeFolderBinaryPresent, results[4].MetricName)
+	assert.Equal(t, map[string]string{"name": "v17"}, results[4].Labels)
+	assert.Zero(t, results[4].Value)
+}
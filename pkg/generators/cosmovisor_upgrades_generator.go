@@ -6,8 +6,6 @@ import (
 	"main/pkg/metrics"
 	"main/pkg/types"
 	"main/pkg/utils"
-	"net/url"
-	"strings"
 
 	upgradeTypes "cosmossdk.io/x/upgrade/types"
 )
@@ -36,7 +34,7 @@ func (g *CosmovisorUpgradesGenerator) Get(state fetchers.State) []metrics.Metric
 				"name":   governanceUpgrade.Name,
 				"source": constants.UpgradeSourceGovernance,
 			},
-			Value: utils.BoolToFloat64(cosmovisorUpgrades.HasUpgrade(g.normalizeName(governanceUpgrade.Name))),
+			Value: utils.BoolToFloat64(cosmovisorUpgrades.HasUpgrade(utils.NormalizeUpgradeName(governanceUpgrade.Name))),
 		})
 	}
 
@@ -47,19 +45,9 @@ func (g *CosmovisorUpgradesGenerator) Get(state fetchers.State) []metrics.Metric
 				"name":   upgradeJSON.Name,
 				"source": constants.UpgradeSourceUpgradeInfo,
 			},
-			Value: utils.BoolToFloat64(cosmovisorUpgrades.HasUpgrade(g.normalizeName(upgradeJSON.Name))),
+			Value: utils.BoolToFloat64(cosmovisorUpgrades.HasUpgrade(utils.NormalizeUpgradeName(upgradeJSON.Name))),
 		})
 	}
 
 	return metricsInfo
 }
-
-func (g *CosmovisorUpgradesGenerator) normalizeName(originalName string) string {
-	// From cosmovisor docs:
-	// The name variable in upgrades/<name> is the lowercase URI-encoded name
-	// of the upgrade as specified in the upgrade module plan.
-	upgradeName := strings.ToLower(originalName)
-	upgradeName = url.QueryEscape(upgradeName)
-
-	return upgradeName
-}
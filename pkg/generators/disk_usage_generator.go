@@ -0,0 +1,71 @@
+package generators
+
+import (
+	"main/pkg/constants"
+	"main/pkg/fetchers"
+	"main/pkg/metrics"
+	"main/pkg/types"
+)
+
+type DiskUsageGenerator struct{}
+
+func NewDiskUsageGenerator() *DiskUsageGenerator {
+	return &DiskUsageGenerator{}
+}
+
+func (g *DiskUsageGenerator) Get(state fetchers.State) []metrics.MetricInfo {
+	directories, directoriesFound := fetchers.StateGet[[]types.DirectoryUsage](state, constants.FetcherNameDiskUsage)
+	if !directoriesFound {
+		return []metrics.MetricInfo{}
+	}
+
+	metricsInfo := []metrics.MetricInfo{}
+
+	for _, directory := range directories {
+		if !directory.Present {
+			continue
+		}
+
+		if directory.HasSize {
+			metricsInfo = append(metricsInfo, metrics.MetricInfo{
+				MetricName: metrics.MetricNameDiskDirectorySize,
+				Labels:     map[string]string{"directory": directory.Name},
+				Value:      float64(directory.Size),
+			})
+		}
+
+		if directory.HasFilesystemStats {
+			metricsInfo = append(
+				metricsInfo,
+				metrics.MetricInfo{
+					MetricName: metrics.MetricNameDiskFree,
+					Labels:     map[string]string{"directory": directory.Name},
+					Value:      float64(directory.FreeBytes),
+				},
+				metrics.MetricInfo{
+					MetricName: metrics.MetricNameDiskTotal,
+					Labels:     map[string]string{"directory": directory.Name},
+					Value:      float64(directory.TotalBytes),
+				},
+			)
+		}
+
+		if directory.HasGrowth {
+			metricsInfo = append(metricsInfo, metrics.MetricInfo{
+				MetricName: metrics.MetricNameDiskGrowthPerDay,
+				Labels:     map[string]string{"directory": directory.Name},
+				Value:      directory.GrowthPerDay,
+			})
+		}
+
+		if directory.HasSecondsTillFull {
+			metricsInfo = append(metricsInfo, metrics.MetricInfo{
+				MetricName: metrics.MetricNameDiskEstimatedFull,
+				Labels:     map[string]string{"directory": directory.Name},
+				Value:      directory.SecondsTillFull,
+			})
+		}
+	}
+
+	return metricsInfo
+}
@@ -0,0 +1,122 @@
+package generators
+
+import (
+	"main/pkg/constants"
+	"main/pkg/fetchers"
+	"main/pkg/metrics"
+	"main/pkg/types"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiskUsageGeneratorNoData(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{}
+	generator := NewDiskUsageGenerator()
+	results := generator.Get(state)
+	assert.Empty(t, results)
+}
+
+func TestDiskUsageGeneratorInvalid(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if r := recover(); r == nil {
+			require.Fail(t, "Expected to have a panic here!")
+		}
+	}()
+
+	state := fetchers.State{
+		constants.FetcherNameDiskUsage: 3,
+	}
+
+	generator := NewDiskUsageGenerator()
+	generator.Get(state)
+}
+
+func TestDiskUsageGeneratorOk(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{
+		constants.FetcherNameDiskUsage: []types.DirectoryUsage{
+			{
+				Name:               "data",
+				Present:            true,
+				Size:               1000,
+				HasSize:            true,
+				FreeBytes:          2000,
+				TotalBytes:         5000,
+				HasFilesystemStats: true,
+			},
+			{Name: "wasm", Present: false},
+			{
+				Name:               "cosmovisor",
+				Present:            true,
+				Size:               100,
+				HasSize:            true,
+				FreeBytes:          2000,
+				TotalBytes:         5000,
+				HasFilesystemStats: true,
+				GrowthPerDay:       200,
+				HasGrowth:          true,
+				SecondsTillFull:    864000,
+				HasSecondsTillFull: true,
+			},
+		},
+	}
+
+	generator := NewDiskUsageGenerator()
+	results := generator.Get(state)
+	require.Len(t, results, 8)
+
+	dataSize := results[0]
+	assert.Equal(t, metrics.MetricNameDiskDirectorySize, dataSize.MetricName)
+	assert.Equal(t, map[string]string{"directory": "data"}, dataSize.Labels)
+	assert.InDelta(t, 1000, dataSize.Value, 0.01)
+
+	dataFree := results[1]
+	assert.Equal(t, metrics.MetricNameDiskFree, dataFree.MetricName)
+	assert.InDelta(t, 2000, dataFree.Value, 0.01)
+
+	dataTotal := results[2]
+	assert.Equal(t, metrics.MetricNameDiskTotal, dataTotal.MetricName)
+	assert.InDelta(t, 5000, dataTotal.Value, 0.01)
+
+	cosmovisorSize := results[3]
+	assert.Equal(t, metrics.MetricNameDiskDirectorySize, cosmovisorSize.MetricName)
+	assert.Equal(t, map[string]string{"directory": "cosmovisor"}, cosmovisorSize.Labels)
+	assert.InDelta(t, 100, cosmovisorSize.Value, 0.01)
+
+	cosmovisorGrowth := results[6]
+	assert.Equal(t, metrics.MetricNameDiskGrowthPerDay, cosmovisorGrowth.MetricName)
+	assert.InDelta(t, 200, cosmovisorGrowth.Value, 0.01)
+
+	cosmovisorFull := results[7]
+	assert.Equal(t, metrics.MetricNameDiskEstimatedFull, cosmovisorFull.MetricName)
+	assert.InDelta(t, 864000, cosmovisorFull.Value, 0.01)
+}
+
+func TestDiskUsageGeneratorNotWalkedYet(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{
+		constants.FetcherNameDiskUsage: []types.DirectoryUsage{
+			{
+				Name:               "data",
+				Present:            true,
+				FreeBytes:          2000,
+				TotalBytes:         5000,
+				HasFilesystemStats: true,
+			},
+		},
+	}
+
+	generator := NewDiskUsageGenerator()
+	results := generator.Get(state)
+	require.Len(t, results, 2)
+	assert.Equal(t, metrics.MetricNameDiskFree, results[0].MetricName)
+	assert.Equal(t, metrics.MetricNameDiskTotal, results[1].MetricName)
+}
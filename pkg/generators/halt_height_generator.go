@@ -0,0 +1,62 @@
+package generators
+
+import (
+	"main/pkg/clients/tendermint"
+	"main/pkg/constants"
+	"main/pkg/fetchers"
+	"main/pkg/metrics"
+	"main/pkg/utils"
+
+	"cosmossdk.io/x/upgrade/types"
+	"github.com/cosmos/cosmos-sdk/client/grpc/node"
+)
+
+type HaltHeightGenerator struct {
+	Window int64
+}
+
+func NewHaltHeightGenerator(window int64) *HaltHeightGenerator {
+	return &HaltHeightGenerator{Window: window}
+}
+
+func (g *HaltHeightGenerator) Get(state fetchers.State) []metrics.MetricInfo {
+	nodeConfig, nodeConfigFound := fetchers.StateGet[*node.ConfigResponse](state, constants.FetcherNameNodeConfig)
+	if !nodeConfigFound || nodeConfig.HaltHeight == 0 {
+		return []metrics.MetricInfo{}
+	}
+
+	haltHeight := int64(nodeConfig.HaltHeight)
+	metricsInfo := []metrics.MetricInfo{}
+
+	blockTimes, _ := fetchers.StateGet[tendermint.BlockTimes](state, constants.FetcherNameBlockTime)
+	if blocksInfo, blocksInfoFound := blockTimes.ForWindow(g.Window); blocksInfoFound {
+		metricsInfo = append(metricsInfo, metrics.MetricInfo{
+			MetricName: metrics.MetricNameHaltHeightEstimatedTime,
+			Labels:     map[string]string{},
+			Value:      float64(blocksInfo.EstimatedTimeAt(haltHeight).Unix()),
+		})
+	}
+
+	// the node halts after committing the halt height block, so it's only
+	// in the past if the node has already gone beyond it
+	status, statusFound := fetchers.StateGet[tendermint.StatusResponse](state, constants.FetcherNameNodeStatus)
+	if statusFound {
+		metricsInfo = append(metricsInfo, metrics.MetricInfo{
+			MetricName: metrics.MetricNameHaltHeightInconsistent,
+			Labels:     map[string]string{"reason": constants.HaltHeightInconsistencyInPast},
+			Value:      utils.BoolToFloat64(haltHeight < status.Result.SyncInfo.LatestBlockHeight),
+		})
+	}
+
+	// the node would stop at the upgrade height first, so the halt height is never reached
+	governanceUpgrade, governanceUpgradeFound := fetchers.StateGet[*types.Plan](state, constants.FetcherNameUpgrades)
+	if governanceUpgradeFound {
+		metricsInfo = append(metricsInfo, metrics.MetricInfo{
+			MetricName: metrics.MetricNameHaltHeightInconsistent,
+			Labels:     map[string]string{"reason": constants.HaltHeightInconsistencyAfterUpgrade},
+			Value:      utils.BoolToFloat64(haltHeight > governanceUpgrade.Height),
+		})
+	}
+
+	return metricsInfo
+}
@@ -0,0 +1,133 @@
+package generators
+
+import (
+	"main/pkg/clients/tendermint"
+	"main/pkg/constants"
+	"main/pkg/fetchers"
+	metricsPkg "main/pkg/metrics"
+	"testing"
+	"time"
+
+	"cosmossdk.io/x/upgrade/types"
+	"github.com/cosmos/cosmos-sdk/client/grpc/node"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHaltHeightGeneratorEmpty(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{}
+
+	generator := NewHaltHeightGenerator(1000)
+	metrics := generator.Get(state)
+	assert.Empty(t, metrics)
+}
+
+func TestHaltHeightGeneratorInvalid(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if r := recover(); r == nil {
+			require.Fail(t, "Expected to have a panic here!")
+		}
+	}()
+
+	state := fetchers.State{
+		constants.FetcherNameNodeConfig: 3,
+	}
+
+	generator := NewHaltHeightGenerator(1000)
+	generator.Get(state)
+}
+
+func TestHaltHeightGeneratorNoHaltHeight(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{
+		constants.FetcherNameNodeConfig: &node.ConfigResponse{HaltHeight: 0},
+		constants.FetcherNameNodeStatus: tendermint.StatusResponse{
+			Result: tendermint.StatusResult{
+				SyncInfo: tendermint.SyncInfo{LatestBlockHeight: 2000},
+			},
+		},
+	}
+
+	generator := NewHaltHeightGenerator(1000)
+	metrics := generator.Get(state)
+	assert.Empty(t, metrics)
+}
+
+func TestHaltHeightGeneratorConsistent(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, 6, 29, 0, 0, 0, 0, time.UTC)
+	state := fetchers.State{
+		constants.FetcherNameNodeConfig: &node.ConfigResponse{HaltHeight: 2100},
+		constants.FetcherNameNodeStatus: tendermint.StatusResponse{
+			Result: tendermint.StatusResult{
+				SyncInfo: tendermint.SyncInfo{LatestBlockHeight: 2000},
+			},
+		},
+		constants.FetcherNameUpgrades: &types.Plan{Name: "v2", Height: 2100},
+		constants.FetcherNameBlockTime: tendermint.BlockTimes{
+			{Window: 1000, BlocksInfo: &tendermint.BlocksInfo{
+				NewerBlock: tendermint.BlockResponse{Result: tendermint.BlockResult{Block: tendermint.Block{
+					Header: tendermint.BlockHeader{Height: 2000, Time: start},
+				}}},
+				OlderBlock: tendermint.BlockResponse{Result: tendermint.BlockResult{Block: tendermint.Block{
+					Header: tendermint.BlockHeader{Height: 1000, Time: start.Add(-6000 * time.Second)},
+				}}},
+			}},
+		},
+	}
+
+	generator := NewHaltHeightGenerator(1000)
+	metrics := generator.Get(state)
+	assert.Len(t, metrics, 3)
+
+	assert.Equal(t, metricsPkg.MetricNameHaltHeightEstimatedTime, metrics[0].MetricName)
+	assert.InDelta(t, float64(start.Add(600*time.Second).Unix()), metrics[0].Value, 0.01)
+
+	assert.Equal(t, metricsPkg.MetricInfo{
+		MetricName: metricsPkg.MetricNameHaltHeightInconsistent,
+		Labels:     map[string]string{"reason": constants.HaltHeightInconsistencyInPast},
+		Value:      0,
+	}, metrics[1])
+
+	assert.Equal(t, metricsPkg.MetricInfo{
+		MetricName: metricsPkg.MetricNameHaltHeightInconsistent,
+		Labels:     map[string]string{"reason": constants.HaltHeightInconsistencyAfterUpgrade},
+		Value:      0,
+	}, metrics[2])
+}
+
+func TestHaltHeightGeneratorInconsistent(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{
+		constants.FetcherNameNodeConfig: &node.ConfigResponse{HaltHeight: 1500},
+		constants.FetcherNameNodeStatus: tendermint.StatusResponse{
+			Result: tendermint.StatusResult{
+				SyncInfo: tendermint.SyncInfo{LatestBlockHeight: 2000},
+			},
+		},
+		constants.FetcherNameUpgrades: &types.Plan{Name: "v2", Height: 1200},
+	}
+
+	generator := NewHaltHeightGenerator(1000)
+	metrics := generator.Get(state)
+	assert.Len(t, metrics, 2)
+
+	assert.Equal(t, metricsPkg.MetricInfo{
+		MetricName: metricsPkg.MetricNameHaltHeightInconsistent,
+		Labels:     map[string]string{"reason": constants.HaltHeightInconsistencyInPast},
+		Value:      1,
+	}, metrics[0])
+
+	assert.Equal(t, metricsPkg.MetricInfo{
+		MetricName: metricsPkg.MetricNameHaltHeightInconsistent,
+		Labels:     map[string]string{"reason": constants.HaltHeightInconsistencyAfterUpgrade},
+		Value:      1,
+	}, metrics[1])
+}
@@ -1,6 +1,7 @@
 package generators
 
 import (
+	"main/pkg/clients/chain_registry"
 	"main/pkg/constants"
 	"main/pkg/fetchers"
 	"main/pkg/metrics"
@@ -47,6 +48,14 @@ func (g *IsLatestGenerator) Get(state fetchers.State) []metrics.MetricInfo {
 	// 0 is for equal, 1 is when the local version is greater
 	isLatestOrSameVersion := semverLocal.Compare(semverRemote) >= 0
 
+	// a version that chain-registry lists as compatible is as good as the recommended one
+	if chainInfo, chainInfoFound := fetchers.StateGet[*chain_registry.ChainInfo](
+		state,
+		constants.FetcherNameChainRegistry,
+	); chainInfoFound && !isLatestOrSameVersion {
+		isLatestOrSameVersion = g.IsCompatibleVersion(semverLocal, chainInfo.Codebase.CompatibleVersions)
+	}
+
 	return []metrics.MetricInfo{{
 		MetricName: metrics.MetricNameIsLatest,
 		Labels: map[string]string{
@@ -56,3 +65,19 @@ func (g *IsLatestGenerator) Get(state fetchers.State) []metrics.MetricInfo {
 		Value: utils.BoolToFloat64(isLatestOrSameVersion),
 	}}
 }
+
+func (g *IsLatestGenerator) IsCompatibleVersion(version *semver.Version, compatibleVersions []string) bool {
+	for _, compatibleVersion := range compatibleVersions {
+		semverCompatible, err := semver.NewVersion(compatibleVersion)
+		if err != nil {
+			g.Logger.Warn().Err(err).Str("version", compatibleVersion).Msg("Could not parse compatible version")
+			continue
+		}
+
+		if version.Equal(semverCompatible) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -3,6 +3,7 @@ package generators
 import (
 	"context"
 	"main/assets"
+	"main/pkg/clients/chain_registry"
 	cosmovisorPkg "main/pkg/clients/cosmovisor"
 	githubPkg "main/pkg/clients/git"
 	configPkg "main/pkg/config"
@@ -111,11 +112,11 @@ func TestIsLatestGeneratorLocalSemverInvalid(t *testing.T) {
 	cosmovisor := cosmovisorPkg.NewCosmovisor(cosmovisorConfig, *logger, tracer)
 	cosmovisor.CommandExecutor = &exec.TestCommandExecutor{Expected: assets.GetBytesOrPanic("cosmovisor-app-version-invalid.txt")}
 
-	localFetcher := fetchers.NewLocalVersionFetcher(*logger, cosmovisor, tracer)
+	localFetcher := fetchers.NewLocalVersionFetcher(*logger, cosmovisor, nil, tracer)
 	localData, _ := localFetcher.Get(context.Background())
 
-	remoteFetcher := fetchers.NewRemoteVersionFetcher(*logger, githubClient, tracer)
-	remoteData, _ := remoteFetcher.Get(context.Background())
+	remoteFetcher := fetchers.NewRemoteVersionFetcher(*logger, githubClient, nil, tracer)
+	remoteData, _ := remoteFetcher.Get(context.Background(), nil)
 
 	state := fetchers.State{
 		constants.FetcherNameLocalVersion:  localData,
@@ -153,11 +154,11 @@ func TestIsLatestGeneratorRemoteSemverInvalid(t *testing.T) {
 	cosmovisor := cosmovisorPkg.NewCosmovisor(cosmovisorConfig, *logger, tracer)
 	cosmovisor.CommandExecutor = &exec.TestCommandExecutor{Expected: assets.GetBytesOrPanic("cosmovisor-app-version-ok.txt")}
 
-	localFetcher := fetchers.NewLocalVersionFetcher(*logger, cosmovisor, tracer)
+	localFetcher := fetchers.NewLocalVersionFetcher(*logger, cosmovisor, nil, tracer)
 	localData, _ := localFetcher.Get(context.Background())
 
-	remoteFetcher := fetchers.NewRemoteVersionFetcher(*logger, githubClient, tracer)
-	remoteData, _ := remoteFetcher.Get(context.Background())
+	remoteFetcher := fetchers.NewRemoteVersionFetcher(*logger, githubClient, nil, tracer)
+	remoteData, _ := remoteFetcher.Get(context.Background(), nil)
 
 	state := fetchers.State{
 		constants.FetcherNameLocalVersion:  localData,
@@ -195,11 +196,11 @@ func TestIsLatestGeneratorAllOk(t *testing.T) {
 	cosmovisor := cosmovisorPkg.NewCosmovisor(cosmovisorConfig, *logger, tracer)
 	cosmovisor.CommandExecutor = &exec.TestCommandExecutor{Expected: assets.GetBytesOrPanic("cosmovisor-app-version-ok.txt")}
 
-	localFetcher := fetchers.NewLocalVersionFetcher(*logger, cosmovisor, tracer)
+	localFetcher := fetchers.NewLocalVersionFetcher(*logger, cosmovisor, nil, tracer)
 	localData, _ := localFetcher.Get(context.Background())
 
-	remoteFetcher := fetchers.NewRemoteVersionFetcher(*logger, githubClient, tracer)
-	remoteData, _ := remoteFetcher.Get(context.Background())
+	remoteFetcher := fetchers.NewRemoteVersionFetcher(*logger, githubClient, nil, tracer)
+	remoteData, _ := remoteFetcher.Get(context.Background(), nil)
 
 	state := fetchers.State{
 		constants.FetcherNameLocalVersion:  localData,
@@ -216,3 +217,45 @@ func TestIsLatestGeneratorAllOk(t *testing.T) {
 	}, isLatest.Labels)
 	assert.Zero(t, isLatest.Value)
 }
+
+func TestIsLatestGeneratorCompatibleVersion(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{
+		constants.FetcherNameLocalVersion:  types.VersionInfo{Version: "17.1.0", Name: "gaiad"},
+		constants.FetcherNameRemoteVersion: "17.2.0",
+		constants.FetcherNameChainRegistry: &chain_registry.ChainInfo{
+			Codebase: &chain_registry.Codebase{
+				RecommendedVersion: "v17.2.0",
+				CompatibleVersions: []string{"invalid", "v17.1.0", "v17.2.0"},
+			},
+		},
+	}
+
+	logger := loggerPkg.GetNopLogger()
+	generator := NewIsLatestGenerator(*logger)
+	metrics := generator.Get(state)
+	assert.Len(t, metrics, 1)
+	assert.InDelta(t, 1, metrics[0].Value, 0.01)
+}
+
+func TestIsLatestGeneratorNotCompatibleVersion(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{
+		constants.FetcherNameLocalVersion:  types.VersionInfo{Version: "17.0.0", Name: "gaiad"},
+		constants.FetcherNameRemoteVersion: "17.2.0",
+		constants.FetcherNameChainRegistry: &chain_registry.ChainInfo{
+			Codebase: &chain_registry.Codebase{
+				RecommendedVersion: "v17.2.0",
+				CompatibleVersions: []string{"v17.1.0", "v17.2.0"},
+			},
+		},
+	}
+
+	logger := loggerPkg.GetNopLogger()
+	generator := NewIsLatestGenerator(*logger)
+	metrics := generator.Get(state)
+	assert.Len(t, metrics, 1)
+	assert.Zero(t, metrics[0].Value)
+}
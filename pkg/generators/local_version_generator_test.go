@@ -61,7 +61,7 @@ func TestLocalVersionGeneratorOk(t *testing.T) {
 	cosmovisor := cosmovisorPkg.NewCosmovisor(config, *logger, tracer)
 	cosmovisor.CommandExecutor = &exec.TestCommandExecutor{Expected: assets.GetBytesOrPanic("cosmovisor-app-version-ok.txt")}
 
-	fetcher := fetchers.NewLocalVersionFetcher(*logger, cosmovisor, tracer)
+	fetcher := fetchers.NewLocalVersionFetcher(*logger, cosmovisor, nil, tracer)
 
 	data, _ := fetcher.Get(context.Background())
 	assert.NotNil(t, data)
@@ -4,6 +4,7 @@ import (
 	"context"
 	grpcPkg "main/pkg/clients/grpc"
 	"main/pkg/constants"
+	"main/pkg/failover"
 	"main/pkg/fetchers"
 	loggerPkg "main/pkg/logger"
 	"main/pkg/tracing"
@@ -85,9 +86,10 @@ func TestNodeInfoGeneratorOk(t *testing.T) {
 	)
 	require.NoError(t, err)
 	client := &grpcPkg.Client{
-		Logger: *logger,
-		Client: grpcConn,
-		Tracer: tracer,
+		Logger:      *logger,
+		Connections: map[string]*grpc.ClientConn{"localhost:9090": grpcConn},
+		Failover:    failover.NewFailover([]string{"localhost:9090"}, constants.FailoverPrimaryCooldown),
+		Tracer:      tracer,
 	}
 
 	fetcher := fetchers.NewNodeInfoFetcher(*logger, client, tracer)
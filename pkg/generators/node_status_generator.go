@@ -54,5 +54,27 @@ func (g *NodeStatusGenerator) Get(state fetchers.State) []metrics.MetricInfo {
 			Labels:     map[string]string{},
 			Value:      float64(status.Result.ValidatorInfo.VotingPower),
 		},
+		{
+			MetricName: metrics.MetricNameEarliestBlockHeight,
+			Labels:     map[string]string{},
+			Value:      float64(status.Result.SyncInfo.EarliestBlockHeight),
+		},
+		{
+			MetricName: metrics.MetricNameEarliestBlockTime,
+			Labels:     map[string]string{},
+			Value:      float64(status.Result.SyncInfo.EarliestBlockTime.Unix()),
+		},
+		{
+			MetricName: metrics.MetricNameAppHash,
+			Labels:     map[string]string{"earliest_app_hash": status.Result.SyncInfo.EarliestAppHash},
+			Value:      1,
+		},
+		{
+			// the latest app hash changes with every block, so it is a separate metric
+			// to keep app_hash series stable
+			MetricName: metrics.MetricNameLatestAppHash,
+			Labels:     map[string]string{"app_hash": status.Result.SyncInfo.LatestAppHash},
+			Value:      1,
+		},
 	}
 }
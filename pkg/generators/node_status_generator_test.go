@@ -64,7 +64,7 @@ func TestNodeStatusGeneratorOk(t *testing.T) {
 	client := tendermint.NewRPC(config, *logger, tracer)
 	fetcher := fetchers.NewNodeStatusFetcher(*logger, client, tracer)
 
-	data, _ := fetcher.Get(context.Background())
+	data, _ := fetcher.Get(context.Background(), nil)
 	assert.NotNil(t, data)
 
 	state := fetchers.State{
@@ -74,7 +74,7 @@ func TestNodeStatusGeneratorOk(t *testing.T) {
 	generator := NewNodeStatusGenerator()
 
 	metrics := generator.Get(state)
-	assert.Len(t, metrics, 6)
+	assert.Len(t, metrics, 10)
 
 	catchingUp := metrics[0]
 	assert.Empty(t, catchingUp.Labels)
@@ -104,4 +104,24 @@ func TestNodeStatusGeneratorOk(t *testing.T) {
 	votingPower := metrics[5]
 	assert.Empty(t, votingPower.Labels)
 	assert.Zero(t, votingPower.Value)
+
+	earliestBlockHeight := metrics[6]
+	assert.Empty(t, earliestBlockHeight.Labels)
+	assert.InDelta(t, float64(21065923), earliestBlockHeight.Value, 0.01)
+
+	earliestBlockTime := metrics[7]
+	assert.Empty(t, earliestBlockTime.Labels)
+	assert.InDelta(t, 1719614636, earliestBlockTime.Value, 0.01)
+
+	appHash := metrics[8]
+	assert.Equal(t, map[string]string{
+		"earliest_app_hash": "5D269B92CC74F98A1DC4215801EB918125C96D18FC85120B92F3B007ADFB082E",
+	}, appHash.Labels)
+	assert.InDelta(t, 1, appHash.Value, 0.01)
+
+	latestAppHash := metrics[9]
+	assert.Equal(t, map[string]string{
+		"app_hash": "FF8C5A16787CC2BF9C3DFE8C33CBE57C8FB7BE853A4F10B9C1D8B487E75785A0",
+	}, latestAppHash.Labels)
+	assert.InDelta(t, 1, latestAppHash.Value, 0.01)
 }
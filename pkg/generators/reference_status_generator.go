@@ -0,0 +1,53 @@
+package generators
+
+import (
+	"main/pkg/clients/tendermint"
+	"main/pkg/constants"
+	"main/pkg/fetchers"
+	"main/pkg/metrics"
+	"main/pkg/types"
+)
+
+type ReferenceStatusGenerator struct{}
+
+func NewReferenceStatusGenerator() *ReferenceStatusGenerator {
+	return &ReferenceStatusGenerator{}
+}
+
+func (g *ReferenceStatusGenerator) Get(state fetchers.State) []metrics.MetricInfo {
+	referenceStatuses, referenceStatusesFound := fetchers.StateGet[types.ReferenceStatuses](state, constants.FetcherNameReferenceStatus)
+	if !referenceStatusesFound {
+		return []metrics.MetricInfo{}
+	}
+
+	metricsInfo := []metrics.MetricInfo{}
+
+	for _, referenceStatus := range referenceStatuses {
+		if !referenceStatus.Success {
+			continue
+		}
+
+		metricsInfo = append(metricsInfo, metrics.MetricInfo{
+			MetricName: metrics.MetricNameReferenceLatestBlockHeight,
+			Labels:     map[string]string{"reference": referenceStatus.Address},
+			Value:      float64(referenceStatus.Height),
+		}, metrics.MetricInfo{
+			MetricName: metrics.MetricNameReferenceLatency,
+			Labels:     map[string]string{"reference": referenceStatus.Address},
+			Value:      referenceStatus.Latency.Seconds(),
+		})
+	}
+
+	status, statusFound := fetchers.StateGet[tendermint.StatusResponse](state, constants.FetcherNameNodeStatus)
+	referenceHeight, referenceHeightFound := referenceStatuses.MedianHeight()
+
+	if statusFound && referenceHeightFound {
+		metricsInfo = append(metricsInfo, metrics.MetricInfo{
+			MetricName: metrics.MetricNameBlocksBehindReference,
+			Labels:     map[string]string{},
+			Value:      float64(referenceHeight - status.Result.SyncInfo.LatestBlockHeight),
+		})
+	}
+
+	return metricsInfo
+}
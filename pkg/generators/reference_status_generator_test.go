@@ -0,0 +1,109 @@
+package generators
+
+import (
+	"main/pkg/clients/tendermint"
+	"main/pkg/constants"
+	"main/pkg/fetchers"
+	metricsPkg "main/pkg/metrics"
+	"main/pkg/types"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReferenceStatusGeneratorEmpty(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{}
+
+	generator := NewReferenceStatusGenerator()
+	metrics := generator.Get(state)
+	assert.Empty(t, metrics)
+}
+
+func TestReferenceStatusGeneratorInvalid(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if r := recover(); r == nil {
+			require.Fail(t, "Expected to have a panic here!")
+		}
+	}()
+
+	state := fetchers.State{
+		constants.FetcherNameReferenceStatus: 3,
+	}
+
+	generator := NewReferenceStatusGenerator()
+	generator.Get(state)
+}
+
+func TestReferenceStatusGeneratorNoNodeStatus(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{
+		constants.FetcherNameReferenceStatus: types.ReferenceStatuses{
+			{Address: "https://reference1.com", Height: 110, Latency: time.Second, Success: true},
+		},
+	}
+
+	generator := NewReferenceStatusGenerator()
+	metrics := generator.Get(state)
+	assert.Len(t, metrics, 2)
+}
+
+func TestReferenceStatusGeneratorAllFailed(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{
+		constants.FetcherNameReferenceStatus: types.ReferenceStatuses{
+			{Address: "https://reference1.com", Success: false},
+		},
+		constants.FetcherNameNodeStatus: tendermint.StatusResponse{
+			Result: tendermint.StatusResult{
+				SyncInfo: tendermint.SyncInfo{LatestBlockHeight: 100},
+			},
+		},
+	}
+
+	generator := NewReferenceStatusGenerator()
+	metrics := generator.Get(state)
+	assert.Empty(t, metrics)
+}
+
+func TestReferenceStatusGeneratorOk(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{
+		constants.FetcherNameReferenceStatus: types.ReferenceStatuses{
+			{Address: "https://reference1.com", Height: 110, Latency: time.Second, Success: true},
+			{Address: "https://reference2.com", Success: false},
+		},
+		constants.FetcherNameNodeStatus: tendermint.StatusResponse{
+			Result: tendermint.StatusResult{
+				SyncInfo: tendermint.SyncInfo{LatestBlockHeight: 100},
+			},
+		},
+	}
+
+	generator := NewReferenceStatusGenerator()
+	metrics := generator.Get(state)
+	assert.Len(t, metrics, 3)
+
+	referenceHeight := metrics[0]
+	assert.Equal(t, metricsPkg.MetricNameReferenceLatestBlockHeight, referenceHeight.MetricName)
+	assert.Equal(t, map[string]string{"reference": "https://reference1.com"}, referenceHeight.Labels)
+	assert.InDelta(t, 110, referenceHeight.Value, 0.01)
+
+	referenceLatency := metrics[1]
+	assert.Equal(t, metricsPkg.MetricNameReferenceLatency, referenceLatency.MetricName)
+	assert.Equal(t, map[string]string{"reference": "https://reference1.com"}, referenceLatency.Labels)
+	assert.InDelta(t, 1, referenceLatency.Value, 0.01)
+
+	blocksBehind := metrics[2]
+	assert.Equal(t, metricsPkg.MetricNameBlocksBehindReference, blocksBehind.MetricName)
+	assert.Empty(t, blocksBehind.Labels)
+	assert.InDelta(t, 10, blocksBehind.Value, 0.01)
+}
@@ -65,9 +65,9 @@ func TestRemoteVersionGeneratorOk(t *testing.T) {
 	logger := loggerPkg.GetNopLogger()
 	tracer := tracing.InitNoopTracer()
 	client := git.NewGithub(config, *logger, tracer)
-	fetcher := fetchers.NewRemoteVersionFetcher(*logger, client, tracer)
+	fetcher := fetchers.NewRemoteVersionFetcher(*logger, client, nil, tracer)
 
-	data, _ := fetcher.Get(context.Background())
+	data, _ := fetcher.Get(context.Background(), nil)
 	assert.NotNil(t, data)
 
 	state := fetchers.State{
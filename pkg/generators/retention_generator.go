@@ -0,0 +1,57 @@
+package generators
+
+import (
+	"main/pkg/clients/tendermint"
+	"main/pkg/constants"
+	"main/pkg/fetchers"
+	"main/pkg/metrics"
+)
+
+type RetentionGenerator struct {
+	Window int64
+}
+
+func NewRetentionGenerator(window int64) *RetentionGenerator {
+	return &RetentionGenerator{Window: window}
+}
+
+func (g *RetentionGenerator) Get(state fetchers.State) []metrics.MetricInfo {
+	status, statusFound := fetchers.StateGet[tendermint.StatusResponse](state, constants.FetcherNameNodeStatus)
+	if !statusFound {
+		return []metrics.MetricInfo{}
+	}
+
+	retainedBlocks := status.Result.SyncInfo.RetainedBlocks()
+	if retainedBlocks == 0 {
+		return []metrics.MetricInfo{}
+	}
+
+	metricsInfo := []metrics.MetricInfo{
+		{
+			MetricName: metrics.MetricNameRetainedBlocks,
+			Labels:     map[string]string{},
+			Value:      float64(retainedBlocks),
+		},
+	}
+
+	// using the average block time if it's known, otherwise relying on the
+	// earliest and latest block timestamps reported by the node
+	blockTimes, _ := fetchers.StateGet[tendermint.BlockTimes](state, constants.FetcherNameBlockTime)
+	if blocksInfo, blocksInfoFound := blockTimes.ForWindow(g.Window); blocksInfoFound {
+		metricsInfo = append(metricsInfo, metrics.MetricInfo{
+			MetricName: metrics.MetricNameEstimatedRetention,
+			Labels:     map[string]string{},
+			Value:      float64(retainedBlocks) * blocksInfo.BlockTime(),
+		})
+	} else if !status.Result.SyncInfo.EarliestBlockTime.IsZero() {
+		metricsInfo = append(metricsInfo, metrics.MetricInfo{
+			MetricName: metrics.MetricNameEstimatedRetention,
+			Labels:     map[string]string{},
+			Value: status.Result.SyncInfo.LatestBlockTime.
+				Sub(status.Result.SyncInfo.EarliestBlockTime).
+				Seconds(),
+		})
+	}
+
+	return metricsInfo
+}
@@ -0,0 +1,120 @@
+package generators
+
+import (
+	"main/pkg/clients/tendermint"
+	"main/pkg/constants"
+	"main/pkg/fetchers"
+	metricsPkg "main/pkg/metrics"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetentionGeneratorEmpty(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{}
+
+	generator := NewRetentionGenerator(1000)
+	metrics := generator.Get(state)
+	assert.Empty(t, metrics)
+}
+
+func TestRetentionGeneratorInvalid(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if r := recover(); r == nil {
+			require.Fail(t, "Expected to have a panic here!")
+		}
+	}()
+
+	state := fetchers.State{
+		constants.FetcherNameNodeStatus: 3,
+	}
+
+	generator := NewRetentionGenerator(1000)
+	generator.Get(state)
+}
+
+func TestRetentionGeneratorNoEarliestBlock(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{
+		constants.FetcherNameNodeStatus: tendermint.StatusResponse{
+			Result: tendermint.StatusResult{
+				SyncInfo: tendermint.SyncInfo{LatestBlockHeight: 1000},
+			},
+		},
+	}
+
+	generator := NewRetentionGenerator(1000)
+	metrics := generator.Get(state)
+	assert.Empty(t, metrics)
+}
+
+func TestRetentionGeneratorFromBlockTimestamps(t *testing.T) {
+	t.Parallel()
+
+	latest := time.Date(2024, 6, 29, 0, 0, 0, 0, time.UTC)
+	state := fetchers.State{
+		constants.FetcherNameNodeStatus: tendermint.StatusResponse{
+			Result: tendermint.StatusResult{
+				SyncInfo: tendermint.SyncInfo{
+					LatestBlockHeight:   2000,
+					LatestBlockTime:     latest,
+					EarliestBlockHeight: 1001,
+					EarliestBlockTime:   latest.Add(-1000 * time.Second),
+				},
+			},
+		},
+	}
+
+	generator := NewRetentionGenerator(1000)
+	metrics := generator.Get(state)
+	assert.Len(t, metrics, 2)
+
+	assert.Equal(t, metricsPkg.MetricNameRetainedBlocks, metrics[0].MetricName)
+	assert.InDelta(t, 1000, metrics[0].Value, 0.01)
+	assert.Equal(t, metricsPkg.MetricNameEstimatedRetention, metrics[1].MetricName)
+	assert.InDelta(t, 1000, metrics[1].Value, 0.01)
+}
+
+func TestRetentionGeneratorFromBlockTime(t *testing.T) {
+	t.Parallel()
+
+	latest := time.Date(2024, 6, 29, 0, 0, 0, 0, time.UTC)
+	state := fetchers.State{
+		constants.FetcherNameNodeStatus: tendermint.StatusResponse{
+			Result: tendermint.StatusResult{
+				SyncInfo: tendermint.SyncInfo{
+					LatestBlockHeight:   2000,
+					LatestBlockTime:     latest,
+					EarliestBlockHeight: 1001,
+					EarliestBlockTime:   latest.Add(-1000 * time.Second),
+				},
+			},
+		},
+		constants.FetcherNameBlockTime: tendermint.BlockTimes{
+			{Window: 100, BlocksInfo: &tendermint.BlocksInfo{
+				NewerBlock: tendermint.BlockResponse{Result: tendermint.BlockResult{Block: tendermint.Block{
+					Header: tendermint.BlockHeader{Height: 2000, Time: latest},
+				}}},
+				OlderBlock: tendermint.BlockResponse{Result: tendermint.BlockResult{Block: tendermint.Block{
+					Header: tendermint.BlockHeader{Height: 1900, Time: latest.Add(-600 * time.Second)},
+				}}},
+			}},
+		},
+	}
+
+	generator := NewRetentionGenerator(100)
+	metrics := generator.Get(state)
+	assert.Len(t, metrics, 2)
+
+	assert.Equal(t, metricsPkg.MetricNameRetainedBlocks, metrics[0].MetricName)
+	assert.InDelta(t, 1000, metrics[0].Value, 0.01)
+	assert.Equal(t, metricsPkg.MetricNameEstimatedRetention, metrics[1].MetricName)
+	assert.InDelta(t, 6000, metrics[1].Value, 0.01)
+}
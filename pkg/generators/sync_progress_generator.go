@@ -0,0 +1,58 @@
+package generators
+
+import (
+	"main/pkg/constants"
+	"main/pkg/fetchers"
+	"main/pkg/metrics"
+	"main/pkg/types"
+)
+
+type SyncProgressGenerator struct{}
+
+func NewSyncProgressGenerator() *SyncProgressGenerator {
+	return &SyncProgressGenerator{}
+}
+
+func (g *SyncProgressGenerator) Get(state fetchers.State) []metrics.MetricInfo {
+	syncProgress, syncProgressFound := fetchers.StateGet[*types.SyncProgress](state, constants.FetcherNameSyncProgress)
+	if !syncProgressFound {
+		return []metrics.MetricInfo{}
+	}
+
+	// node is synced, so there's nothing left to sync
+	if !syncProgress.CatchingUp {
+		return []metrics.MetricInfo{
+			{MetricName: metrics.MetricNameSyncRate, Labels: map[string]string{}, Value: 0},
+			{MetricName: metrics.MetricNameBlocksRemaining, Labels: map[string]string{}, Value: 0},
+			{MetricName: metrics.MetricNameEstimatedSyncCompletion, Labels: map[string]string{}, Value: 0},
+		}
+	}
+
+	metricsInfo := []metrics.MetricInfo{}
+
+	if syncProgress.HasRate {
+		metricsInfo = append(metricsInfo, metrics.MetricInfo{
+			MetricName: metrics.MetricNameSyncRate,
+			Labels:     map[string]string{},
+			Value:      syncProgress.Rate,
+		})
+	}
+
+	if syncProgress.HasReferenceHeight {
+		metricsInfo = append(metricsInfo, metrics.MetricInfo{
+			MetricName: metrics.MetricNameBlocksRemaining,
+			Labels:     map[string]string{},
+			Value:      float64(syncProgress.BlocksRemaining),
+		})
+	}
+
+	if !syncProgress.EstimatedCompletion.IsZero() {
+		metricsInfo = append(metricsInfo, metrics.MetricInfo{
+			MetricName: metrics.MetricNameEstimatedSyncCompletion,
+			Labels:     map[string]string{},
+			Value:      float64(syncProgress.EstimatedCompletion.Unix()),
+		})
+	}
+
+	return metricsInfo
+}
@@ -0,0 +1,102 @@
+package generators
+
+import (
+	"main/pkg/constants"
+	"main/pkg/fetchers"
+	metricsPkg "main/pkg/metrics"
+	"main/pkg/types"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncProgressGeneratorEmpty(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{}
+
+	generator := NewSyncProgressGenerator()
+	metrics := generator.Get(state)
+	assert.Empty(t, metrics)
+}
+
+func TestSyncProgressGeneratorInvalid(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if r := recover(); r == nil {
+			require.Fail(t, "Expected to have a panic here!")
+		}
+	}()
+
+	state := fetchers.State{
+		constants.FetcherNameSyncProgress: 3,
+	}
+
+	generator := NewSyncProgressGenerator()
+	generator.Get(state)
+}
+
+func TestSyncProgressGeneratorSynced(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{
+		constants.FetcherNameSyncProgress: &types.SyncProgress{CatchingUp: false},
+	}
+
+	generator := NewSyncProgressGenerator()
+	metrics := generator.Get(state)
+	assert.Len(t, metrics, 3)
+
+	for _, metric := range metrics {
+		assert.Zero(t, metric.Value)
+	}
+}
+
+func TestSyncProgressGeneratorPartial(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{
+		constants.FetcherNameSyncProgress: &types.SyncProgress{
+			CatchingUp: true,
+			HasRate:    true,
+			Rate:       10,
+		},
+	}
+
+	generator := NewSyncProgressGenerator()
+	metrics := generator.Get(state)
+	assert.Len(t, metrics, 1)
+
+	assert.Equal(t, metricsPkg.MetricNameSyncRate, metrics[0].MetricName)
+	assert.InDelta(t, 10, metrics[0].Value, 0.01)
+}
+
+func TestSyncProgressGeneratorOk(t *testing.T) {
+	t.Parallel()
+
+	completion := time.Unix(1719700000, 0)
+	state := fetchers.State{
+		constants.FetcherNameSyncProgress: &types.SyncProgress{
+			CatchingUp:          true,
+			HasRate:             true,
+			Rate:                10,
+			HasReferenceHeight:  true,
+			BlocksRemaining:     1000,
+			EstimatedCompletion: completion,
+		},
+	}
+
+	generator := NewSyncProgressGenerator()
+	metrics := generator.Get(state)
+	assert.Len(t, metrics, 3)
+
+	assert.Equal(t, metricsPkg.MetricNameSyncRate, metrics[0].MetricName)
+	assert.InDelta(t, 10, metrics[0].Value, 0.01)
+	assert.Equal(t, metricsPkg.MetricNameBlocksRemaining, metrics[1].MetricName)
+	assert.InDelta(t, 1000, metrics[1].Value, 0.01)
+	assert.Equal(t, metricsPkg.MetricNameEstimatedSyncCompletion, metrics[2].MetricName)
+	assert.InDelta(t, 1719700000, metrics[2].Value, 0.01)
+}
@@ -5,22 +5,28 @@ import (
 	"main/pkg/constants"
 	"main/pkg/fetchers"
 	"main/pkg/metrics"
-	"time"
 
 	"cosmossdk.io/x/upgrade/types"
 )
 
-type TimeTillUpgradeGenerator struct{}
+type TimeTillUpgradeGenerator struct {
+	Window int64
+}
 
-func NewTimeTillUpgradeGenerator() *TimeTillUpgradeGenerator {
-	return &TimeTillUpgradeGenerator{}
+func NewTimeTillUpgradeGenerator(window int64) *TimeTillUpgradeGenerator {
+	return &TimeTillUpgradeGenerator{Window: window}
 }
 
 func (g *TimeTillUpgradeGenerator) Get(state fetchers.State) []metrics.MetricInfo {
 	governanceUpgrade, governanceUpgradeFound := fetchers.StateGet[*types.Plan](state, constants.FetcherNameUpgrades)
 	upgradeInfoJson, upgradeInfoJsonFound := fetchers.StateGet[*types.Plan](state, constants.FetcherNameCosmovisorUpgradeInfo)
-	blocksInfo, blocksInfoFound := fetchers.StateGet[*tendermint.BlocksInfo](state, constants.FetcherNameBlockTime)
+	blockTimes, blockTimesFound := fetchers.StateGet[tendermint.BlockTimes](state, constants.FetcherNameBlockTime)
+
+	if !blockTimesFound {
+		return []metrics.MetricInfo{}
+	}
 
+	blocksInfo, blocksInfoFound := blockTimes.ForWindow(g.Window)
 	if !blocksInfoFound {
 		return []metrics.MetricInfo{}
 	}
@@ -34,7 +40,7 @@ func (g *TimeTillUpgradeGenerator) Get(state fetchers.State) []metrics.MetricInf
 				"name":   governanceUpgrade.Name,
 				"source": constants.UpgradeSourceGovernance,
 			},
-			Value: float64(g.calculateBlockTime(governanceUpgrade.Height, blocksInfo).Unix()),
+			Value: float64(blocksInfo.EstimatedTimeAt(governanceUpgrade.Height).Unix()),
 		})
 	}
 
@@ -45,20 +51,9 @@ func (g *TimeTillUpgradeGenerator) Get(state fetchers.State) []metrics.MetricInf
 				"name":   upgradeInfoJson.Name,
 				"source": constants.UpgradeSourceUpgradeInfo,
 			},
-			Value: float64(g.calculateBlockTime(upgradeInfoJson.Height, blocksInfo).Unix()),
+			Value: float64(blocksInfo.EstimatedTimeAt(upgradeInfoJson.Height).Unix()),
 		})
 	}
 
 	return metricsInfo
 }
-
-func (g *TimeTillUpgradeGenerator) calculateBlockTime(height int64, blocksInfo *tendermint.BlocksInfo) time.Time {
-	blockTime := blocksInfo.BlockTime()
-
-	blocksTillEstimatedBlock := height - blocksInfo.NewerBlock.Result.Block.Header.Height
-	secondsTillEstimatedBlock := int64(float64(blocksTillEstimatedBlock) * blockTime)
-	durationTillEstimatedBlock := time.Duration(secondsTillEstimatedBlock * int64(time.Second))
-	upgradeTime := blocksInfo.NewerBlock.Result.Block.Header.Time.Add(durationTillEstimatedBlock)
-
-	return upgradeTime
-}
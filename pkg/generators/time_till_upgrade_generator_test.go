@@ -25,7 +25,7 @@ func TestTimeTillUpgradeGeneratorStateEmpty(t *testing.T) {
 
 	state := fetchers.State{}
 
-	generator := NewTimeTillUpgradeGenerator()
+	generator := NewTimeTillUpgradeGenerator(1000)
 	metrics := generator.Get(state)
 	assert.Empty(t, metrics)
 }
@@ -37,7 +37,22 @@ func TestTimeTillUpgradeGeneratorBlockTimeEmpty(t *testing.T) {
 		constants.FetcherNameUpgrades: &types.Plan{},
 	}
 
-	generator := NewTimeTillUpgradeGenerator()
+	generator := NewTimeTillUpgradeGenerator(1000)
+	metrics := generator.Get(state)
+	assert.Empty(t, metrics)
+}
+
+func TestTimeTillUpgradeGeneratorNoWindow(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{
+		constants.FetcherNameUpgrades: &types.Plan{Height: 200},
+		constants.FetcherNameBlockTime: tendermint.BlockTimes{
+			{Window: 100, BlocksInfo: &tendermint.BlocksInfo{}},
+		},
+	}
+
+	generator := NewTimeTillUpgradeGenerator(1000)
 	metrics := generator.Get(state)
 	assert.Empty(t, metrics)
 }
@@ -53,10 +68,10 @@ func TestTimeTillUpgradeGeneratorWrongUpgrade(t *testing.T) {
 
 	state := fetchers.State{
 		constants.FetcherNameUpgrades:  3,
-		constants.FetcherNameBlockTime: &tendermint.BlocksInfo{},
+		constants.FetcherNameBlockTime: tendermint.BlockTimes{},
 	}
 
-	generator := NewTimeTillUpgradeGenerator()
+	generator := NewTimeTillUpgradeGenerator(1000)
 	generator.Get(state)
 }
 
@@ -74,7 +89,7 @@ func TestTimeTillUpgradeGeneratorWrongBlockTime(t *testing.T) {
 		constants.FetcherNameBlockTime: 3,
 	}
 
-	generator := NewTimeTillUpgradeGenerator()
+	generator := NewTimeTillUpgradeGenerator(1000)
 	generator.Get(state)
 }
 
@@ -110,8 +125,8 @@ func TestTimeTillUpgradeGeneratorOk(t *testing.T) {
 	upgradesInfo, _ := upgradesFetcher.Get(context.Background())
 	assert.NotNil(t, upgradesInfo)
 
-	blockTimeFetcher := fetchers.NewBlockTimeFetcher(*logger, client, tracer)
-	blockTimeData, _ := blockTimeFetcher.Get(context.Background(), upgradesInfo, upgradesInfo)
+	blockTimeFetcher := fetchers.NewBlockTimeFetcher(*logger, client, []int64{1000}, tracer)
+	blockTimeData, _ := blockTimeFetcher.Get(context.Background(), upgradesInfo, upgradesInfo, nil, nil, nil)
 	assert.NotNil(t, blockTimeData)
 
 	state := fetchers.State{
@@ -120,7 +135,7 @@ func TestTimeTillUpgradeGeneratorOk(t *testing.T) {
 		constants.FetcherNameBlockTime:             blockTimeData,
 	}
 
-	generator := NewTimeTillUpgradeGenerator()
+	generator := NewTimeTillUpgradeGenerator(1000)
 	metrics := generator.Get(state)
 	assert.Len(t, metrics, 2)
 
@@ -0,0 +1,49 @@
+package generators
+
+import (
+	"main/pkg/constants"
+	"main/pkg/fetchers"
+	"main/pkg/metrics"
+	"main/pkg/types"
+	"main/pkg/utils"
+)
+
+type UpgradeBinariesGenerator struct{}
+
+func NewUpgradeBinariesGenerator() *UpgradeBinariesGenerator {
+	return &UpgradeBinariesGenerator{}
+}
+
+func (g *UpgradeBinariesGenerator) Get(state fetchers.State) []metrics.MetricInfo {
+	binariesInfo, binariesInfoFound := fetchers.StateGet[[]types.UpgradeBinaryInfo](state, constants.FetcherNameUpgradeBinaries)
+	if !binariesInfoFound {
+		return []metrics.MetricInfo{}
+	}
+
+	metricsInfo := []metrics.MetricInfo{}
+
+	for _, binaryInfo := range binariesInfo {
+		metricsInfo = append(metricsInfo, metrics.MetricInfo{
+			MetricName: metrics.MetricNameUpgradeBinaryPlatformAvailable,
+			Labels: map[string]string{
+				"name":     binaryInfo.Name,
+				"source":   binaryInfo.Source,
+				"platform": binaryInfo.Platform,
+			},
+			Value: utils.BoolToFloat64(binaryInfo.PlatformAvailable),
+		})
+
+		if binaryInfo.HasChecksumMatch {
+			metricsInfo = append(metricsInfo, metrics.MetricInfo{
+				MetricName: metrics.MetricNameUpgradeBinaryChecksumMatch,
+				Labels: map[string]string{
+					"name":   binaryInfo.Name,
+					"source": binaryInfo.Source,
+				},
+				Value: utils.BoolToFloat64(binaryInfo.ChecksumMatch),
+			})
+		}
+	}
+
+	return metricsInfo
+}
@@ -0,0 +1,83 @@
+package generators
+
+import (
+	"main/pkg/constants"
+	"main/pkg/fetchers"
+	"main/pkg/metrics"
+	"main/pkg/types"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpgradeBinariesGeneratorNoData(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{}
+	generator := NewUpgradeBinariesGenerator()
+	results := generator.Get(state)
+	assert.Empty(t, results)
+}
+
+func TestUpgradeBinariesGeneratorInvalid(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if r := recover(); r == nil {
+			require.Fail(t, "Expected to have a panic here!")
+		}
+	}()
+
+	state := fetchers.State{
+		constants.FetcherNameUpgradeBinaries: 3,
+	}
+
+	generator := NewUpgradeBinariesGenerator()
+	generator.Get(state)
+}
+
+func TestUpgradeBinariesGeneratorOk(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{
+		constants.FetcherNameUpgradeBinaries: []types.UpgradeBinaryInfo{
+			{
+				Name:              "v15",
+				Source:            constants.UpgradeSourceGovernance,
+				Platform:          "linux/amd64",
+				PlatformAvailable: true,
+				HasChecksumMatch:  true,
+				ChecksumMatch:     true,
+			},
+			{
+				Name:              "v15",
+				Source:            constants.UpgradeSourceUpgradeInfo,
+				Platform:          "linux/amd64",
+				PlatformAvailable: false,
+			},
+		},
+	}
+
+	generator := NewUpgradeBinariesGenerator()
+	results := generator.Get(state)
+	assert.Len(t, results, 3)
+
+	assert.Equal(t, metrics.MetricNameUpgradeBinaryPlatformAvailable, results[0].MetricName)
+	assert.Equal(t, map[string]string{
+		"name":     "v15",
+		"source":   constants.UpgradeSourceGovernance,
+		"platform": "linux/amd64",
+	}, results[0].Labels)
+	assert.InDelta(t, 1, results[0].Value, 0.01)
+
+	assert.Equal(t, metrics.MetricNameUpgradeBinaryChecksumMatch, results[1].MetricName)
+	assert.Equal(t, map[string]string{
+		"name":   "v15",
+		"source": constants.UpgradeSourceGovernance,
+	}, results[1].Labels)
+	assert.InDelta(t, 1, results[1].Value, 0.01)
+
+	assert.Equal(t, metrics.MetricNameUpgradeBinaryPlatformAvailable, results[2].MetricName)
+	assert.Zero(t, results[2].Value)
+}
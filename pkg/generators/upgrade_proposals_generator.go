@@ -0,0 +1,91 @@
+package generators
+
+import (
+	"main/pkg/clients/tendermint"
+	"main/pkg/constants"
+	"main/pkg/fetchers"
+	"main/pkg/metrics"
+	"strconv"
+	"strings"
+
+	"github.com/rs/zerolog"
+)
+
+type UpgradeProposalsGenerator struct {
+	Logger zerolog.Logger
+}
+
+func NewUpgradeProposalsGenerator(logger zerolog.Logger) *UpgradeProposalsGenerator {
+	return &UpgradeProposalsGenerator{
+		Logger: logger.With().Str("component", "upgrade_proposals_generator").Logger(),
+	}
+}
+
+func (g *UpgradeProposalsGenerator) Get(state fetchers.State) []metrics.MetricInfo {
+	proposals, proposalsFound := fetchers.StateGet[[]tendermint.UpgradeProposal](state, constants.FetcherNameUpgradeProposals)
+	if !proposalsFound {
+		return []metrics.MetricInfo{}
+	}
+
+	metricsInfo := []metrics.MetricInfo{}
+
+	for _, proposal := range proposals {
+		proposalID := strconv.FormatUint(proposal.ID, 10)
+
+		metricsInfo = append(metricsInfo, metrics.MetricInfo{
+			MetricName: metrics.MetricNameUpgradeProposalHeight,
+			Labels: map[string]string{
+				"proposal_id": proposalID,
+				"name":        proposal.Plan.Name,
+				// PROPOSAL_STATUS_VOTING_PERIOD -> voting_period
+				"status": strings.ToLower(strings.TrimPrefix(proposal.Status.String(), "PROPOSAL_STATUS_")),
+			},
+			Value: float64(proposal.Plan.Height),
+		})
+
+		if proposal.VotingEndTime != nil && !proposal.VotingEndTime.IsZero() {
+			metricsInfo = append(metricsInfo, metrics.MetricInfo{
+				MetricName: metrics.MetricNameUpgradeProposalVotingEndTime,
+				Labels:     map[string]string{"proposal_id": proposalID, "name": proposal.Plan.Name},
+				Value:      float64(proposal.VotingEndTime.Unix()),
+			})
+		}
+
+		if proposal.Tally == nil {
+			continue
+		}
+
+		for _, option := range []struct {
+			Name  string
+			Count string
+		}{
+			{Name: "yes", Count: proposal.Tally.YesCount},
+			{Name: "no", Count: proposal.Tally.NoCount},
+			{Name: "abstain", Count: proposal.Tally.AbstainCount},
+			{Name: "no_with_veto", Count: proposal.Tally.NoWithVetoCount},
+		} {
+			count, err := strconv.ParseFloat(option.Count, 64)
+			if err != nil {
+				g.Logger.Warn().
+					Err(err).
+					Uint64("proposal_id", proposal.ID).
+					Str("option", option.Name).
+					Str("count", option.Count).
+					Msg("Could not parse proposal tally, skipping")
+				continue
+			}
+
+			metricsInfo = append(metricsInfo, metrics.MetricInfo{
+				MetricName: metrics.MetricNameUpgradeProposalTally,
+				Labels: map[string]string{
+					"proposal_id": proposalID,
+					"name":        proposal.Plan.Name,
+					"option":      option.Name,
+				},
+				Value: count,
+			})
+		}
+	}
+
+	return metricsInfo
+}
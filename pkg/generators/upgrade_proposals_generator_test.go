@@ -0,0 +1,133 @@
+package generators
+
+import (
+	"main/pkg/clients/tendermint"
+	"main/pkg/constants"
+	"main/pkg/fetchers"
+	loggerPkg "main/pkg/logger"
+	metricsPkg "main/pkg/metrics"
+	"testing"
+	"time"
+
+	upgradeTypes "cosmossdk.io/x/upgrade/types"
+	govV1Types "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpgradeProposalsGeneratorEmpty(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{}
+
+	logger := loggerPkg.GetNopLogger()
+	generator := NewUpgradeProposalsGenerator(*logger)
+	metrics := generator.Get(state)
+	assert.Empty(t, metrics)
+}
+
+func TestUpgradeProposalsGeneratorInvalid(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if r := recover(); r == nil {
+			require.Fail(t, "Expected to have a panic here!")
+		}
+	}()
+
+	state := fetchers.State{
+		constants.FetcherNameUpgradeProposals: 3,
+	}
+
+	logger := loggerPkg.GetNopLogger()
+	generator := NewUpgradeProposalsGenerator(*logger)
+	generator.Get(state)
+}
+
+func TestUpgradeProposalsGeneratorInvalidTally(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{
+		constants.FetcherNameUpgradeProposals: []tendermint.UpgradeProposal{
+			{
+				ID:     10,
+				Status: govV1Types.StatusVotingPeriod,
+				Plan:   upgradeTypes.Plan{Name: "v18", Height: 21300000},
+				Tally:  &govV1Types.TallyResult{YesCount: "invalid", NoCount: "100", AbstainCount: "0", NoWithVetoCount: "0"},
+			},
+		},
+	}
+
+	logger := loggerPkg.GetNopLogger()
+	generator := NewUpgradeProposalsGenerator(*logger)
+	metrics := generator.Get(state)
+
+	// the invalid option is skipped, the rest are still reported
+	assert.Len(t, metrics, 4)
+	assert.Equal(t, metricsPkg.MetricNameUpgradeProposalHeight, metrics[0].MetricName)
+	assert.Equal(t, "no", metrics[1].Labels["option"])
+	assert.InDelta(t, 100, metrics[1].Value, 0.01)
+}
+
+func TestUpgradeProposalsGeneratorOk(t *testing.T) {
+	t.Parallel()
+
+	votingEndTime := time.Date(2024, 7, 3, 12, 0, 0, 0, time.UTC)
+	state := fetchers.State{
+		constants.FetcherNameUpgradeProposals: []tendermint.UpgradeProposal{
+			{
+				ID:     11,
+				Status: govV1Types.StatusDepositPeriod,
+				Plan:   upgradeTypes.Plan{Name: "v19", Height: 21500000},
+			},
+			{
+				ID:            10,
+				Status:        govV1Types.StatusVotingPeriod,
+				Plan:          upgradeTypes.Plan{Name: "v18", Height: 21300000},
+				VotingEndTime: &votingEndTime,
+				Tally: &govV1Types.TallyResult{
+					YesCount:        "100000000",
+					NoCount:         "2000000",
+					AbstainCount:    "500000",
+					NoWithVetoCount: "0",
+				},
+			},
+		},
+	}
+
+	logger := loggerPkg.GetNopLogger()
+	generator := NewUpgradeProposalsGenerator(*logger)
+	metrics := generator.Get(state)
+	assert.Len(t, metrics, 7)
+
+	assert.Equal(t, metricsPkg.MetricInfo{
+		MetricName: metricsPkg.MetricNameUpgradeProposalHeight,
+		Labels:     map[string]string{"proposal_id": "11", "name": "v19", "status": "deposit_period"},
+		Value:      21500000,
+	}, metrics[0])
+
+	assert.Equal(t, metricsPkg.MetricInfo{
+		MetricName: metricsPkg.MetricNameUpgradeProposalHeight,
+		Labels:     map[string]string{"proposal_id": "10", "name": "v18", "status": "voting_period"},
+		Value:      21300000,
+	}, metrics[1])
+
+	assert.Equal(t, metricsPkg.MetricInfo{
+		MetricName: metricsPkg.MetricNameUpgradeProposalVotingEndTime,
+		Labels:     map[string]string{"proposal_id": "10", "name": "v18"},
+		Value:      1720008000,
+	}, metrics[2])
+
+	assert.Equal(t, metricsPkg.MetricInfo{
+		MetricName: metricsPkg.MetricNameUpgradeProposalTally,
+		Labels:     map[string]string{"proposal_id": "10", "name": "v18", "option": "yes"},
+		Value:      100000000,
+	}, metrics[3])
+
+	assert.Equal(t, "no", metrics[4].Labels["option"])
+	assert.InDelta(t, 2000000, metrics[4].Value, 0.01)
+	assert.Equal(t, "abstain", metrics[5].Labels["option"])
+	assert.InDelta(t, 500000, metrics[5].Value, 0.01)
+	assert.Equal(t, "no_with_veto", metrics[6].Labels["option"])
+	assert.Zero(t, metrics[6].Value)
+}
@@ -0,0 +1,45 @@
+package generators
+
+import (
+	"main/pkg/constants"
+	"main/pkg/fetchers"
+	"main/pkg/metrics"
+	"main/pkg/types"
+	"main/pkg/utils"
+)
+
+type UpgradeSmokeTestsGenerator struct{}
+
+func NewUpgradeSmokeTestsGenerator() *UpgradeSmokeTestsGenerator {
+	return &UpgradeSmokeTestsGenerator{}
+}
+
+func (g *UpgradeSmokeTestsGenerator) Get(state fetchers.State) []metrics.MetricInfo {
+	smokeTests, smokeTestsFound := fetchers.StateGet[[]types.UpgradeSmokeTestInfo](state, constants.FetcherNameUpgradeSmokeTests)
+	if !smokeTestsFound {
+		return []metrics.MetricInfo{}
+	}
+
+	metricsInfo := []metrics.MetricInfo{}
+
+	for _, smokeTest := range smokeTests {
+		metricsInfo = append(metricsInfo, metrics.MetricInfo{
+			MetricName: metrics.MetricNameUpgradeBinaryRunnable,
+			Labels:     map[string]string{"name": smokeTest.Name},
+			Value:      utils.BoolToFloat64(smokeTest.Runnable),
+		})
+
+		if smokeTest.Version != "" {
+			metricsInfo = append(metricsInfo, metrics.MetricInfo{
+				MetricName: metrics.MetricNameUpgradeBinaryVersion,
+				Labels: map[string]string{
+					"name":    smokeTest.Name,
+					"version": smokeTest.Version,
+				},
+				Value: 1,
+			})
+		}
+	}
+
+	return metricsInfo
+}
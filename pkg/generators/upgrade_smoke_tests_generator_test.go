@@ -0,0 +1,75 @@
+package generators
+
+import (
+	"main/pkg/constants"
+	"main/pkg/fetchers"
+	"main/pkg/metrics"
+	"main/pkg/types"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpgradeSmokeTestsGeneratorNoData(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{}
+	generator := NewUpgradeSmokeTestsGenerator()
+	results := generator.Get(state)
+	assert.Empty(t, results)
+}
+
+func TestUpgradeSmokeTestsGeneratorInvalid(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if r := recover(); r == nil {
+			require.Fail(t, "Expected to have a panic here!")
+		}
+	}()
+
+	state := fetchers.State{
+		constants.FetcherNameUpgradeSmokeTests: 3,
+	}
+
+	generator := NewUpgradeSmokeTestsGenerator()
+	generator.Get(state)
+}
+
+func TestUpgradeSmokeTestsGeneratorOk(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{
+		constants.FetcherNameUpgradeSmokeTests: []types.UpgradeSmokeTestInfo{
+			{Name: "v15", Runnable: true, Version: "15.0.0"},
+			{Name: "v16", Runnable: true},
+			{Name: "v17"},
+		},
+	}
+
+	generator := NewUpgradeSmokeTestsGenerator()
+	results := generator.Get(state)
+	assert.Equal(t, []metrics.MetricInfo{
+		{
+			MetricName: metrics.MetricNameUpgradeBinaryRunnable,
+			Labels:     map[string]string{"name": "v15"},
+			Value:      1,
+		},
+		{
+			MetricName: metrics.MetricNameUpgradeBinaryVersion,
+			Labels:     map[string]string{"name": "v15", "version": "15.0.0"},
+			Value:      1,
+		},
+		{
+			MetricName: metrics.MetricNameUpgradeBinaryRunnable,
+			Labels:     map[string]string{"name": "v16"},
+			Value:      1,
+		},
+		{
+			MetricName: metrics.MetricNameUpgradeBinaryRunnable,
+			Labels:     map[string]string{"name": "v17"},
+			Value:      0,
+		},
+	}, results)
+}
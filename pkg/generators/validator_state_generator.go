@@ -0,0 +1,72 @@
+package generators
+
+import (
+	"main/pkg/clients/tendermint"
+	"main/pkg/clients/validator_state"
+	"main/pkg/constants"
+	"main/pkg/fetchers"
+	"main/pkg/metrics"
+	"main/pkg/utils"
+)
+
+type ValidatorStateGenerator struct{}
+
+func NewValidatorStateGenerator() *ValidatorStateGenerator {
+	return &ValidatorStateGenerator{}
+}
+
+func (g *ValidatorStateGenerator) Get(state fetchers.State) []metrics.MetricInfo {
+	validatorState, found := fetchers.StateGet[*validator_state.PrivValidatorState](
+		state,
+		constants.FetcherNameValidatorState,
+	)
+	if !found {
+		return []metrics.MetricInfo{}
+	}
+
+	metricsInfo := []metrics.MetricInfo{
+		{
+			MetricName: metrics.MetricNameValidatorStateHeight,
+			Labels:     map[string]string{},
+			Value:      float64(validatorState.Height),
+		},
+		{
+			MetricName: metrics.MetricNameValidatorStateRound,
+			Labels:     map[string]string{},
+			Value:      float64(validatorState.Round),
+		},
+		{
+			MetricName: metrics.MetricNameValidatorStateStep,
+			Labels:     map[string]string{},
+			Value:      float64(validatorState.Step),
+		},
+	}
+
+	// the key has never signed anything, so there's nothing to compare
+	if validatorState.Height == 0 {
+		return metricsInfo
+	}
+
+	status, statusFound := fetchers.StateGet[tendermint.StatusResponse](state, constants.FetcherNameNodeStatus)
+	if !statusFound {
+		return metricsInfo
+	}
+
+	// an active validator signs votes for the block after the latest committed one,
+	// so the signed height is ahead only if it goes beyond that
+	latestHeight := status.Result.SyncInfo.LatestBlockHeight
+
+	return append(
+		metricsInfo,
+		metrics.MetricInfo{
+			MetricName: metrics.MetricNameValidatorStateLag,
+			Labels:     map[string]string{},
+			Value:      float64(max(latestHeight-validatorState.Height, 0)),
+		},
+		metrics.MetricInfo{
+			MetricName: metrics.MetricNameValidatorStateAheadOfChain,
+			Labels:     map[string]string{},
+			Value:      utils.BoolToFloat64(validatorState.Height > latestHeight+1),
+		},
+	)
+}
@@ -0,0 +1,143 @@
+package generators
+
+import (
+	"main/pkg/clients/tendermint"
+	"main/pkg/clients/validator_state"
+	"main/pkg/constants"
+	"main/pkg/fetchers"
+	"main/pkg/metrics"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatorStateGeneratorNoData(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{}
+	generator := NewValidatorStateGenerator()
+	results := generator.Get(state)
+	assert.Empty(t, results)
+}
+
+func TestValidatorStateGeneratorInvalid(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if r := recover(); r == nil {
+			require.Fail(t, "Expected to have a panic here!")
+		}
+	}()
+
+	state := fetchers.State{
+		constants.FetcherNameValidatorState: 3,
+	}
+
+	generator := NewValidatorStateGenerator()
+	generator.Get(state)
+}
+
+func TestValidatorStateGeneratorNoStatus(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{
+		constants.FetcherNameValidatorState: &validator_state.PrivValidatorState{Height: 100, Round: 1, Step: 2},
+	}
+
+	generator := NewValidatorStateGenerator()
+	results := generator.Get(state)
+	require.Len(t, results, 3)
+
+	height := results[0]
+	assert.Equal(t, metrics.MetricNameValidatorStateHeight, height.MetricName)
+	assert.InDelta(t, 100, height.Value, 0.01)
+
+	round := results[1]
+	assert.Equal(t, metrics.MetricNameValidatorStateRound, round.MetricName)
+	assert.InDelta(t, 1, round.Value, 0.01)
+
+	step := results[2]
+	assert.Equal(t, metrics.MetricNameValidatorStateStep, step.MetricName)
+	assert.InDelta(t, 2, step.Value, 0.01)
+}
+
+func TestValidatorStateGeneratorNeverSigned(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{
+		constants.FetcherNameValidatorState: &validator_state.PrivValidatorState{},
+		constants.FetcherNameNodeStatus: tendermint.StatusResponse{
+			Result: tendermint.StatusResult{
+				SyncInfo: tendermint.SyncInfo{LatestBlockHeight: 2000},
+			},
+		},
+	}
+
+	generator := NewValidatorStateGenerator()
+	results := generator.Get(state)
+	require.Len(t, results, 3)
+}
+
+func TestValidatorStateGeneratorLagging(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{
+		constants.FetcherNameValidatorState: &validator_state.PrivValidatorState{Height: 1900},
+		constants.FetcherNameNodeStatus: tendermint.StatusResponse{
+			Result: tendermint.StatusResult{
+				SyncInfo: tendermint.SyncInfo{LatestBlockHeight: 2000},
+			},
+		},
+	}
+
+	generator := NewValidatorStateGenerator()
+	results := generator.Get(state)
+	require.Len(t, results, 5)
+
+	lag := results[3]
+	assert.Equal(t, metrics.MetricNameValidatorStateLag, lag.MetricName)
+	assert.InDelta(t, 100, lag.Value, 0.01)
+
+	ahead := results[4]
+	assert.Equal(t, metrics.MetricNameValidatorStateAheadOfChain, ahead.MetricName)
+	assert.Zero(t, ahead.Value)
+}
+
+func TestValidatorStateGeneratorSigning(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{
+		constants.FetcherNameValidatorState: &validator_state.PrivValidatorState{Height: 2001},
+		constants.FetcherNameNodeStatus: tendermint.StatusResponse{
+			Result: tendermint.StatusResult{
+				SyncInfo: tendermint.SyncInfo{LatestBlockHeight: 2000},
+			},
+		},
+	}
+
+	generator := NewValidatorStateGenerator()
+	results := generator.Get(state)
+	require.Len(t, results, 5)
+	assert.Zero(t, results[3].Value)
+	assert.Zero(t, results[4].Value)
+}
+
+func TestValidatorStateGeneratorAheadOfChain(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{
+		constants.FetcherNameValidatorState: &validator_state.PrivValidatorState{Height: 5000},
+		constants.FetcherNameNodeStatus: tendermint.StatusResponse{
+			Result: tendermint.StatusResult{
+				SyncInfo: tendermint.SyncInfo{LatestBlockHeight: 2000},
+			},
+		},
+	}
+
+	generator := NewValidatorStateGenerator()
+	results := generator.Get(state)
+	require.Len(t, results, 5)
+	assert.Zero(t, results[3].Value)
+	assert.InDelta(t, 1, results[4].Value, 0.01)
+}
@@ -0,0 +1,63 @@
+package generators
+
+import (
+	"main/pkg/constants"
+	"main/pkg/fetchers"
+	"main/pkg/metrics"
+	"main/pkg/types"
+	"main/pkg/utils"
+)
+
+type ValidatorsGenerator struct{}
+
+func NewValidatorsGenerator() *ValidatorsGenerator {
+	return &ValidatorsGenerator{}
+}
+
+func (g *ValidatorsGenerator) Get(state fetchers.State) []metrics.MetricInfo {
+	validatorSetInfo, validatorSetInfoFound := fetchers.StateGet[*types.ValidatorSetInfo](state, constants.FetcherNameValidators)
+	if !validatorSetInfoFound {
+		return []metrics.MetricInfo{}
+	}
+
+	metricsInfo := []metrics.MetricInfo{
+		{
+			MetricName: metrics.MetricNameActiveSetSize,
+			Labels:     map[string]string{},
+			Value:      float64(validatorSetInfo.ActiveSetSize),
+		},
+		{
+			MetricName: metrics.MetricNameValidatorInActiveSet,
+			Labels:     map[string]string{},
+			Value:      utils.BoolToFloat64(validatorSetInfo.InActiveSet),
+		},
+	}
+
+	if !validatorSetInfo.InActiveSet {
+		return metricsInfo
+	}
+
+	metricsInfo = append(metricsInfo, metrics.MetricInfo{
+		MetricName: metrics.MetricNameValidatorRank,
+		Labels:     map[string]string{},
+		Value:      float64(validatorSetInfo.Rank),
+	}, metrics.MetricInfo{
+		MetricName: metrics.MetricNameValidatorProposerPriority,
+		Labels:     map[string]string{},
+		Value:      float64(validatorSetInfo.ProposerPriority),
+	}, metrics.MetricInfo{
+		MetricName: metrics.MetricNameVotingPowerGapToLastActive,
+		Labels:     map[string]string{},
+		Value:      float64(validatorSetInfo.VotingPower - validatorSetInfo.LastActiveVotingPower),
+	})
+
+	if validatorSetInfo.HasInactive {
+		metricsInfo = append(metricsInfo, metrics.MetricInfo{
+			MetricName: metrics.MetricNameVotingPowerGapToFirstInactive,
+			Labels:     map[string]string{},
+			Value:      float64(validatorSetInfo.VotingPower - validatorSetInfo.FirstInactiveVotingPower),
+		})
+	}
+
+	return metricsInfo
+}
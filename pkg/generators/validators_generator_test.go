@@ -0,0 +1,97 @@
+package generators
+
+import (
+	"main/pkg/constants"
+	"main/pkg/fetchers"
+	metricsPkg "main/pkg/metrics"
+	"main/pkg/types"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatorsGeneratorEmpty(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{}
+
+	generator := NewValidatorsGenerator()
+	metrics := generator.Get(state)
+	assert.Empty(t, metrics)
+}
+
+func TestValidatorsGeneratorInvalid(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if r := recover(); r == nil {
+			require.Fail(t, "Expected to have a panic here!")
+		}
+	}()
+
+	state := fetchers.State{
+		constants.FetcherNameValidators: 3,
+	}
+
+	generator := NewValidatorsGenerator()
+	generator.Get(state)
+}
+
+func TestValidatorsGeneratorNotInActiveSet(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{
+		constants.FetcherNameValidators: &types.ValidatorSetInfo{
+			ActiveSetSize:         180,
+			LastActiveVotingPower: 8000,
+		},
+	}
+
+	generator := NewValidatorsGenerator()
+	metrics := generator.Get(state)
+	assert.Len(t, metrics, 2)
+
+	assert.Equal(t, metricsPkg.MetricNameActiveSetSize, metrics[0].MetricName)
+	assert.InDelta(t, 180, metrics[0].Value, 0.01)
+	assert.Equal(t, metricsPkg.MetricNameValidatorInActiveSet, metrics[1].MetricName)
+	assert.Zero(t, metrics[1].Value)
+}
+
+func TestValidatorsGeneratorOk(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{
+		constants.FetcherNameValidators: &types.ValidatorSetInfo{
+			InActiveSet:              true,
+			Rank:                     2,
+			VotingPower:              10000,
+			ProposerPriority:         2500,
+			ActiveSetSize:            3,
+			LastActiveVotingPower:    8000,
+			FirstInactiveVotingPower: 5000,
+			HasInactive:              true,
+		},
+	}
+
+	generator := NewValidatorsGenerator()
+	metrics := generator.Get(state)
+	assert.Len(t, metrics, 6)
+
+	expected := []struct {
+		name  metricsPkg.MetricName
+		value float64
+	}{
+		{metricsPkg.MetricNameActiveSetSize, 3},
+		{metricsPkg.MetricNameValidatorInActiveSet, 1},
+		{metricsPkg.MetricNameValidatorRank, 2},
+		{metricsPkg.MetricNameValidatorProposerPriority, 2500},
+		{metricsPkg.MetricNameVotingPowerGapToLastActive, 2000},
+		{metricsPkg.MetricNameVotingPowerGapToFirstInactive, 5000},
+	}
+
+	for index, metric := range expected {
+		assert.Equal(t, metric.name, metrics[index].MetricName)
+		assert.InDelta(t, metric.value, metrics[index].Value, 0.01)
+	}
+}
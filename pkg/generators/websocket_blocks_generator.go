@@ -0,0 +1,59 @@
+package generators
+
+import (
+	"main/pkg/clients/tendermint"
+	"main/pkg/constants"
+	"main/pkg/fetchers"
+	"main/pkg/metrics"
+	"main/pkg/utils"
+)
+
+type WebsocketBlocksGenerator struct{}
+
+func NewWebsocketBlocksGenerator() *WebsocketBlocksGenerator {
+	return &WebsocketBlocksGenerator{}
+}
+
+func (g *WebsocketBlocksGenerator) Get(state fetchers.State) []metrics.MetricInfo {
+	snapshot, snapshotFound := fetchers.StateGet[*tendermint.WebsocketSnapshot](state, constants.FetcherNameWebsocketBlocks)
+	if !snapshotFound {
+		return []metrics.MetricInfo{}
+	}
+
+	metricsInfo := []metrics.MetricInfo{
+		{
+			MetricName: metrics.MetricNameWebsocketConnected,
+			Labels:     map[string]string{},
+			Value:      utils.BoolToFloat64(snapshot.Connected),
+		},
+	}
+
+	latestHeader, latestHeaderFound := snapshot.LatestHeader()
+	if !latestHeaderFound {
+		return metricsInfo
+	}
+
+	metricsInfo = append(metricsInfo, metrics.MetricInfo{
+		MetricName: metrics.MetricNameWebsocketLatestBlockHeight,
+		Labels:     map[string]string{},
+		Value:      float64(latestHeader.Height),
+	}, metrics.MetricInfo{
+		MetricName: metrics.MetricNameWebsocketLatestBlockTime,
+		Labels:     map[string]string{},
+		Value:      float64(latestHeader.Time.Unix()),
+	}, metrics.MetricInfo{
+		MetricName: metrics.MetricNameWebsocketSecondsSinceLastBlock,
+		Labels:     map[string]string{},
+		Value:      snapshot.TimeSinceLastBlock.Seconds(),
+	})
+
+	if blocksInfo, ok := snapshot.BlocksInfo(); ok {
+		metricsInfo = append(metricsInfo, metrics.MetricInfo{
+			MetricName: metrics.MetricNameWebsocketAverageBlockTime,
+			Labels:     map[string]string{},
+			Value:      blocksInfo.BlockTime(),
+		})
+	}
+
+	return metricsInfo
+}
@@ -0,0 +1,99 @@
+package generators
+
+import (
+	"main/pkg/clients/tendermint"
+	"main/pkg/constants"
+	"main/pkg/fetchers"
+	metricsPkg "main/pkg/metrics"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebsocketBlocksGeneratorEmpty(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{}
+
+	generator := NewWebsocketBlocksGenerator()
+	metrics := generator.Get(state)
+	assert.Empty(t, metrics)
+}
+
+func TestWebsocketBlocksGeneratorInvalid(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if r := recover(); r == nil {
+			require.Fail(t, "Expected to have a panic here!")
+		}
+	}()
+
+	state := fetchers.State{
+		constants.FetcherNameWebsocketBlocks: 3,
+	}
+
+	generator := NewWebsocketBlocksGenerator()
+	generator.Get(state)
+}
+
+func TestWebsocketBlocksGeneratorNoBlocks(t *testing.T) {
+	t.Parallel()
+
+	state := fetchers.State{
+		constants.FetcherNameWebsocketBlocks: &tendermint.WebsocketSnapshot{Connected: false},
+	}
+
+	generator := NewWebsocketBlocksGenerator()
+	metrics := generator.Get(state)
+	assert.Len(t, metrics, 1)
+	assert.Equal(t, metricsPkg.MetricNameWebsocketConnected, metrics[0].MetricName)
+	assert.Zero(t, metrics[0].Value)
+}
+
+func TestWebsocketBlocksGeneratorOk(t *testing.T) {
+	t.Parallel()
+
+	start := time.Unix(1719681600, 0)
+	headers := make([]tendermint.BlockHeader, constants.WebsocketMinHeadersForBlockTime)
+	for index := range headers {
+		headers[index] = tendermint.BlockHeader{
+			Height: int64(index + 1),
+			Time:   start.Add(time.Duration(index) * 5 * time.Second),
+		}
+	}
+
+	state := fetchers.State{
+		constants.FetcherNameWebsocketBlocks: &tendermint.WebsocketSnapshot{
+			Connected:          true,
+			Headers:            headers,
+			TimeSinceLastBlock: 3 * time.Second,
+		},
+	}
+
+	generator := NewWebsocketBlocksGenerator()
+	metrics := generator.Get(state)
+	assert.Len(t, metrics, 5)
+
+	connected := metrics[0]
+	assert.Equal(t, metricsPkg.MetricNameWebsocketConnected, connected.MetricName)
+	assert.InDelta(t, 1, connected.Value, 0.01)
+
+	latestHeight := metrics[1]
+	assert.Equal(t, metricsPkg.MetricNameWebsocketLatestBlockHeight, latestHeight.MetricName)
+	assert.InDelta(t, 100, latestHeight.Value, 0.01)
+
+	latestTime := metrics[2]
+	assert.Equal(t, metricsPkg.MetricNameWebsocketLatestBlockTime, latestTime.MetricName)
+	assert.InDelta(t, 1719681600+99*5, latestTime.Value, 0.01)
+
+	sinceLastBlock := metrics[3]
+	assert.Equal(t, metricsPkg.MetricNameWebsocketSecondsSinceLastBlock, sinceLastBlock.MetricName)
+	assert.InDelta(t, 3, sinceLastBlock.Value, 0.01)
+
+	blockTime := metrics[4]
+	assert.Equal(t, metricsPkg.MetricNameWebsocketAverageBlockTime, blockTime.MetricName)
+	assert.InDelta(t, 5, blockTime.Value, 0.01)
+}
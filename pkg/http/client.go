@@ -2,9 +2,14 @@ package http
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
+	"main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/failover"
 	"net/http"
+	"reflect"
 	"time"
 
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
@@ -14,28 +19,82 @@ import (
 )
 
 type Client struct {
-	Logger zerolog.Logger
-	Host   string
-	Tracer trace.Tracer
+	Logger    zerolog.Logger
+	Failover  *failover.Failover
+	Config    config.ClientConfig
+	TLSConfig *tls.Config
+	TLSError  error
+	Tracer    trace.Tracer
 }
 
-func NewClient(logger zerolog.Logger, host string, tracer trace.Tracer) *Client {
+func NewClient(
+	logger zerolog.Logger,
+	hosts []string,
+	clientConfig config.ClientConfig,
+	tracer trace.Tracer,
+) *Client {
+	clientLogger := logger.With().Str("component", "http_client").Logger()
+
+	// not failing here, so the error is reported on each query and in query metrics
+	tlsConfig, err := clientConfig.GetTLSConfig()
+	if err != nil {
+		clientLogger.Error().Err(err).Msg("Could not load TLS config")
+	}
+
 	return &Client{
-		Logger: logger.With().Str("component", "http_client").Logger(),
-		Host:   host,
-		Tracer: tracer,
+		Logger:    clientLogger,
+		Failover:  failover.NewFailover(hosts, constants.FailoverPrimaryCooldown),
+		Config:    clientConfig,
+		TLSConfig: tlsConfig,
+		TLSError:  err,
+		Tracer:    tracer,
 	}
 }
 
-func (c Client) Query(ctx context.Context, relativeUrl string, output interface{}) error {
+// Query queries the hosts until one of them responds successfully,
+// returning the host that served the request.
+func (c Client) Query(ctx context.Context, relativeUrl string, output interface{}) (string, error) {
+	outputValue := reflect.ValueOf(output)
+
+	return c.Failover.Do(func(host string) error {
+		// a host that failed might have decoded a part of its response, so each host
+		// decodes into a fresh value, which is copied into the output only on success
+		hostOutput := output
+		if outputValue.Kind() == reflect.Pointer && !outputValue.IsNil() {
+			hostOutput = reflect.New(outputValue.Elem().Type()).Interface()
+		}
+
+		if err := c.QueryHost(ctx, host, relativeUrl, hostOutput); err != nil {
+			c.Logger.Debug().Err(err).Str("host", host).Msg("Query failed")
+			return err
+		}
+
+		if hostOutput != output {
+			outputValue.Elem().Set(reflect.ValueOf(hostOutput).Elem())
+		}
+
+		return nil
+	})
+}
+
+func (c Client) QueryHost(ctx context.Context, host string, relativeUrl string, output interface{}) error {
 	childCtx, span := c.Tracer.Start(ctx, "HTTP request")
 	defer span.End()
 
+	if c.TLSError != nil {
+		return c.TLSError
+	}
+
 	var transport http.RoundTripper
 
 	transportRaw, ok := http.DefaultTransport.(*http.Transport)
 	if ok {
-		transport = transportRaw.Clone()
+		clonedTransport := transportRaw.Clone()
+		if c.TLSConfig != nil {
+			clonedTransport.TLSClientConfig = c.TLSConfig
+		}
+
+		transport = clonedTransport
 	} else {
 		transport = http.DefaultTransport
 	}
@@ -45,7 +104,7 @@ func (c Client) Query(ctx context.Context, relativeUrl string, output interface{
 		Transport: otelhttp.NewTransport(transport),
 	}
 
-	fullUrl := fmt.Sprintf("%s%s", c.Host, relativeUrl)
+	fullUrl := fmt.Sprintf("%s%s", host, relativeUrl)
 	req, err := http.NewRequestWithContext(childCtx, http.MethodGet, fullUrl, nil)
 	if err != nil {
 		return err
@@ -53,6 +112,14 @@ func (c Client) Query(ctx context.Context, relativeUrl string, output interface{
 
 	req.Header.Set("User-Agent", "cosmos-node-exporter")
 
+	for key, value := range c.Config.Headers {
+		req.Header.Set(key, value)
+	}
+
+	if c.Config.BasicAuth.Username != "" {
+		req.SetBasicAuth(c.Config.BasicAuth.Username, c.Config.BasicAuth.Password)
+	}
+
 	res, err := client.Do(req)
 	if err != nil {
 		return err
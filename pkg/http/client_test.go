@@ -2,10 +2,14 @@ package http
 
 import (
 	"context"
+	"errors"
+	configPkg "main/pkg/config"
 	loggerPkg "main/pkg/logger"
 	"main/pkg/tracing"
+	"net/http"
 	"testing"
 
+	"github.com/jarcoal/httpmock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -14,8 +18,132 @@ func TestHttpClientInitFail(t *testing.T) {
 
 	logger := loggerPkg.GetNopLogger()
 	tracer := tracing.InitNoopTracer()
-	client := NewClient(*logger, "://", tracer)
+	client := NewClient(*logger, []string{"://"}, configPkg.ClientConfig{}, tracer)
 
-	err := client.Query(context.Background(), "/", nil)
+	_, err := client.Query(context.Background(), "/", nil)
 	require.Error(t, err)
 }
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestHttpClientFailover(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://primary.com/status",
+		httpmock.NewErrorResponder(errors.New("custom error")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://fallback.com/status",
+		httpmock.NewStringResponder(200, `{"status":"ok"}`),
+	)
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewClient(*logger, []string{"https://primary.com", "https://fallback.com"}, configPkg.ClientConfig{}, tracer)
+
+	var response map[string]string
+	endpoint, err := client.Query(context.Background(), "/status", &response)
+	require.NoError(t, err)
+	require.Equal(t, "https://fallback.com", endpoint)
+	require.Equal(t, "ok", response["status"])
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestHttpClientFailoverPartialDecode(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	// the type mismatch fails the decoding only after the stale field is set
+	httpmock.RegisterResponder(
+		"GET",
+		"https://primary.com/status",
+		httpmock.NewStringResponder(200, `{"stale":"yes","status":1}`),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://fallback.com/status",
+		httpmock.NewStringResponder(200, `{"status":"ok"}`),
+	)
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewClient(*logger, []string{"https://primary.com", "https://fallback.com"}, configPkg.ClientConfig{}, tracer)
+
+	var response map[string]string
+	endpoint, err := client.Query(context.Background(), "/status", &response)
+	require.NoError(t, err)
+	require.Equal(t, "https://fallback.com", endpoint)
+	require.Equal(t, map[string]string{"status": "ok"}, response)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestHttpClientHeadersAndBasicAuth(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/status",
+		func(request *http.Request) (*http.Response, error) {
+			username, password, ok := request.BasicAuth()
+			if !ok || username != "user" || password != "pass" {
+				return httpmock.NewStringResponse(401, "unauthorized"), nil
+			}
+
+			if request.Header.Get("X-Api-Key") != "key" {
+				return httpmock.NewStringResponse(403, "forbidden"), nil
+			}
+
+			return httpmock.NewStringResponse(200, `{"status":"ok"}`), nil
+		},
+	)
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewClient(*logger, []string{"https://example.com"}, configPkg.ClientConfig{
+		Headers:   map[string]string{"X-Api-Key": "key"},
+		BasicAuth: configPkg.BasicAuthConfig{Username: "user", Password: "pass"},
+	}, tracer)
+
+	var response map[string]string
+	_, err := client.Query(context.Background(), "/status", &response)
+	require.NoError(t, err)
+	require.Equal(t, "ok", response["status"])
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestHttpClientStatusCodeError(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/status",
+		httpmock.NewStringResponder(401, "unauthorized"),
+	)
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewClient(*logger, []string{"https://example.com"}, configPkg.ClientConfig{}, tracer)
+
+	_, err := client.Query(context.Background(), "/status", nil)
+	require.Error(t, err)
+	require.ErrorContains(t, err, "status code 401")
+}
+
+func TestHttpClientTLSConfigError(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	client := NewClient(*logger, []string{"https://example.com"}, configPkg.ClientConfig{
+		TLSCA: "not-found.pem",
+	}, tracer)
+
+	_, err := client.Query(context.Background(), "/status", nil)
+	require.Error(t, err)
+	require.ErrorContains(t, err, "could not read TLS CA")
+}
@@ -189,6 +189,640 @@ func NewManager() *Manager {
 			},
 			[]string{"node"},
 		),
+
+		MetricNameConsensusHeight: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "consensus_height",
+				Help: "Height the consensus is currently at",
+			},
+			[]string{"node"},
+		),
+
+		MetricNameConsensusRound: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "consensus_round",
+				Help: "Consensus round at the current height",
+			},
+			[]string{"node"},
+		),
+
+		MetricNameConsensusStep: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "consensus_step",
+				Help: "Consensus step (1 = NewHeight, 2 = NewRound, 3 = Propose, 4 = Prevote, " +
+					"5 = PrevoteWait, 6 = Precommit, 7 = PrecommitWait, 8 = Commit)",
+			},
+			[]string{"node"},
+		),
+
+		MetricNameConsensusPrevotesPercent: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "consensus_prevotes_percent",
+				Help: "Percent of voting power that prevoted in the current round",
+			},
+			[]string{"node"},
+		),
+
+		MetricNameConsensusPrecommitsPercent: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "consensus_precommits_percent",
+				Help: "Percent of voting power that precommitted in the current round",
+			},
+			[]string{"node"},
+		),
+
+		MetricNameSecondsSinceHeightChange: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "seconds_since_height_change",
+				Help: "Seconds since the consensus height has last changed, tracked across scrapes",
+			},
+			[]string{"node"},
+		),
+
+		MetricNameReferenceLatestBlockHeight: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "reference_latest_block_height",
+				Help: "Latest block height reported by a reference RPC node",
+			},
+			[]string{"node", "reference"},
+		),
+
+		MetricNameReferenceLatency: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "reference_latency_seconds",
+				Help: "Time it took to query a reference RPC node status, in seconds",
+			},
+			[]string{"node", "reference"},
+		),
+
+		MetricNameBlocksBehindReference: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "blocks_behind_reference",
+				Help: "Median latest block height of reference RPC nodes minus the node's latest block height",
+			},
+			[]string{"node"},
+		),
+
+		MetricNameWebsocketConnected: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "websocket_connected",
+				Help: "Is the websocket subscription to new blocks alive?",
+			},
+			[]string{"node"},
+		),
+
+		MetricNameWebsocketLatestBlockHeight: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "websocket_latest_block_height",
+				Help: "Height of the latest block received via websocket",
+			},
+			[]string{"node"},
+		),
+
+		MetricNameWebsocketLatestBlockTime: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "websocket_latest_block_time",
+				Help: "Unix timestamp of the time of the latest block received via websocket",
+			},
+			[]string{"node"},
+		),
+
+		MetricNameWebsocketSecondsSinceLastBlock: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "websocket_seconds_since_last_block",
+				Help: "Seconds since the latest block was received via websocket",
+			},
+			[]string{"node"},
+		),
+
+		MetricNameWebsocketAverageBlockTime: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "websocket_average_block_time",
+				Help: "Average block time in seconds, calculated from blocks received via websocket",
+			},
+			[]string{"node"},
+		),
+
+		MetricNameAverageBlockTime: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "average_block_time",
+				Help: "Average block time in seconds over the last N blocks",
+			},
+			[]string{"node", "window"},
+		),
+
+		MetricNameActiveSetSize: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "active_set_size",
+				Help: "Amount of validators in the active set",
+			},
+			[]string{"node"},
+		),
+
+		MetricNameValidatorInActiveSet: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "validator_in_active_set",
+				Help: "Whether the node's validator is in the active set",
+			},
+			[]string{"node"},
+		),
+
+		MetricNameValidatorRank: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "validator_rank",
+				Help: "Node's validator position in the active set, sorted by voting power, starting from 1",
+			},
+			[]string{"node"},
+		),
+
+		MetricNameValidatorProposerPriority: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "validator_proposer_priority",
+				Help: "Node's validator proposer priority",
+			},
+			[]string{"node"},
+		),
+
+		MetricNameVotingPowerGapToLastActive: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "voting_power_gap_to_last_active",
+				Help: "Difference between the node's validator voting power and the last active validator's one",
+			},
+			[]string{"node"},
+		),
+
+		MetricNameVotingPowerGapToFirstInactive: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "voting_power_gap_to_first_inactive",
+				Help: "Difference between the node's validator voting power and the first inactive validator's one",
+			},
+			[]string{"node"},
+		),
+
+		MetricNameSyncRate: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "sync_rate_blocks_per_second",
+				Help: "Blocks synced per second while the node is catching up, 0 if it's synced",
+			},
+			[]string{"node"},
+		),
+
+		MetricNameBlocksRemaining: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "blocks_remaining",
+				Help: "Blocks left to sync until the node catches up with the network, 0 if it's synced",
+			},
+			[]string{"node"},
+		),
+
+		MetricNameEstimatedSyncCompletion: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "estimated_sync_completion_timestamp",
+				Help: "Unix timestamp of the estimated time the node would catch up, 0 if it's synced",
+			},
+			[]string{"node"},
+		),
+
+		MetricNameEarliestBlockHeight: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "earliest_block_height",
+				Help: "Height of the earliest block the node has",
+			},
+			[]string{"node"},
+		),
+
+		MetricNameEarliestBlockTime: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "earliest_block_time",
+				Help: "Unix timestamp of the time of the earliest block the node has",
+			},
+			[]string{"node"},
+		),
+
+		MetricNameAppHash: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "app_hash",
+				Help: "App hash of the earliest block the node has, always 1",
+			},
+			[]string{"node", "earliest_app_hash"},
+		),
+
+		MetricNameLatestAppHash: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "latest_app_hash",
+				Help: "App hash of the latest block the node has, always 1. Only the current hash is exposed",
+			},
+			[]string{"node", "app_hash"},
+		),
+
+		MetricNameRetainedBlocks: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "retained_blocks",
+				Help: "Amount of blocks the node keeps, from its earliest to its latest block",
+			},
+			[]string{"node"},
+		),
+
+		MetricNameEstimatedRetention: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "estimated_retention_seconds",
+				Help: "Estimated time span covered by the blocks the node keeps, in seconds",
+			},
+			[]string{"node"},
+		),
+
+		MetricNameAbciLastBlockHeight: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "abci_last_block_height",
+				Help: "Latest block height committed by the application, as reported by ABCI info",
+			},
+			[]string{"node"},
+		),
+
+		MetricNameAbciAppVersion: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "abci_app_version",
+				Help: "Application protocol version, as reported by ABCI info",
+			},
+			[]string{"node"},
+		),
+
+		MetricNameAbciInfo: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "abci_info",
+				Help: "Application name and version, as reported by ABCI info, always 1",
+			},
+			[]string{"node", "name", "version"},
+		),
+
+		MetricNameAbciLastBlockAppHash: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "abci_last_block_app_hash",
+				Help: "App hash of the last block, as reported by ABCI info, always 1. Only the current hash is exposed",
+			},
+			[]string{"node", "app_hash"},
+		),
+
+		MetricNameAbciHeightLag: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "abci_height_lag",
+				Help: "Difference between the Tendermint/CometBFT latest height from /status, queried right after ABCI info, and the application's last block height, non-zero values may indicate a stuck application",
+			},
+			[]string{"node"},
+		),
+
+		MetricNameBlockTimeClockOffset: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "block_time_clock_offset_seconds",
+				Help: "Time between the latest block time and the local clock, minus the expected block interval. Large positive or negative values may indicate clock drift",
+			},
+			[]string{"node"},
+		),
+
+		MetricNameNtpClockOffset: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "ntp_clock_offset_seconds",
+				Help: "Offset between the NTP server's clock and the local clock, positive if the local clock is late",
+			},
+			[]string{"node"},
+		),
+
+		MetricNameHaltHeightEstimatedTime: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "halt_height_estimated_time",
+				Help: "Estimated time of the halt height, as a Unix timestamp",
+			},
+			[]string{"node"},
+		),
+
+		MetricNameHaltHeightInconsistent: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "halt_height_inconsistent",
+				Help: "Whether the halt height is misconfigured (1 if yes, 0 if no): already in the past, or after the upcoming governance upgrade",
+			},
+			[]string{"node", "reason"},
+		),
+
+		MetricNameUpgradeProposalHeight: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "upgrade_proposal_height",
+				Help: "Planned upgrade height of software upgrade proposals in deposit or voting period",
+			},
+			[]string{"node", "proposal_id", "name", "status"},
+		),
+
+		MetricNameUpgradeProposalVotingEndTime: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "upgrade_proposal_voting_end_time",
+				Help: "Voting end time of software upgrade proposals in voting period, as a Unix timestamp",
+			},
+			[]string{"node", "proposal_id", "name"},
+		),
+
+		MetricNameUpgradeProposalTally: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "upgrade_proposal_tally",
+				Help: "Current tally of software upgrade proposals in voting period, per vote option",
+			},
+			[]string{"node", "proposal_id", "name", "option"},
+		),
+
+		MetricNameUpgradeAppliedHeight: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "upgrade_applied_height",
+				Help: "Height the upgrade from the Cosmovisor upgrades folder was applied at, 0 if it was never applied " +
+					"(upgrades with an unknown name case are only reported once applied)",
+			},
+			[]string{"node", "name"},
+		),
+
+		MetricNameModuleConsensusVersion: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "module_consensus_version",
+				Help: "Consensus version of each module, as stored by the upgrade module",
+			},
+			[]string{"node", "module"},
+		),
+		MetricNameChainRegistryCompatibleVersion: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "chain_registry_compatible_version",
+				Help: "Versions declared as compatible with the current network version in chain-registry (always 1)",
+			},
+			[]string{"node", "version"},
+		),
+		MetricNameChainRegistryUpgradeHeight: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "chain_registry_upgrade_height",
+				Help: "Upgrade heights declared in chain-registry versions",
+			},
+			[]string{"node", "name", "recommended_version"},
+		),
+		MetricNameUpgradeBinaryChecksumMatch: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "upgrade_binary_checksum_match",
+				Help: "Whether the upgrade binary checksum matches the one declared in the upgrade plan",
+			},
+			[]string{"node", "name", "source"},
+		),
+		MetricNameUpgradeBinaryPlatformAvailable: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "upgrade_binary_platform_available",
+				Help: "Whether the upgrade plan declares a binary for the platform the exporter runs on",
+			},
+			[]string{"node", "name", "source", "platform"},
+		),
+		MetricNameCosmovisorCurrentUpgrade: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "cosmovisor_current_upgrade",
+				Help: "Upgrade the Cosmovisor current symlink points to, or genesis (always 1)",
+			},
+			[]string{"node", "name"},
+		),
+		MetricNameCosmovisorGenesisBinaryPresent: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "cosmovisor_genesis_binary_present",
+				Help: "Whether the Cosmovisor genesis binary is present",
+			},
+			[]string{"node"},
+		),
+		MetricNameCosmovisorUpgradeFolderBinaryPresent: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "cosmovisor_upgrade_folder_binary_present",
+				Help: "Whether the binary is present in each of the Cosmovisor upgrade folders",
+			},
+			[]string{"node", "name"},
+		),
+		MetricNameCosmovisorUpgradeFolderBinaryModifiedTime: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "cosmovisor_upgrade_folder_binary_modified_time",
+				Help: "Modification time of the binary in each of the Cosmovisor upgrade folders",
+			},
+			[]string{"node", "name"},
+		),
+		MetricNameUpgradeBinaryRunnable: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "upgrade_binary_runnable",
+				Help: "Whether the upgrade binary could be run with `version` (1 if yes, 0 if no)",
+			},
+			[]string{"node", "name"},
+		),
+		MetricNameUpgradeBinaryVersion: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "upgrade_binary_version",
+				Help: "Version reported by the upgrade binary, always 1",
+			},
+			[]string{"node", "name", "version"},
+		),
+		MetricNameCosmovisorSetting: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "cosmovisor_setting",
+				Help: "Settings from the Cosmovisor config.toml, by env variable name, always 1",
+			},
+			[]string{"node", "name", "value"},
+		),
+		MetricNameCosmovisorAllowDownloadBinaries: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "cosmovisor_allow_download_binaries",
+				Help: "Whether Cosmovisor is allowed to download upgrade binaries (DAEMON_ALLOW_DOWNLOAD_BINARIES)",
+			},
+			[]string{"node"},
+		),
+		MetricNameCosmovisorRestartAfterUpgrade: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "cosmovisor_restart_after_upgrade",
+				Help: "Whether Cosmovisor restarts the node after an upgrade (DAEMON_RESTART_AFTER_UPGRADE)",
+			},
+			[]string{"node"},
+		),
+		MetricNameCosmovisorUnsafeSkipBackup: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "cosmovisor_unsafe_skip_backup",
+				Help: "Whether Cosmovisor skips the data backup before an upgrade (UNSAFE_SKIP_BACKUP)",
+			},
+			[]string{"node"},
+		),
+		MetricNameCosmovisorPollIntervalSeconds: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "cosmovisor_poll_interval_seconds",
+				Help: "Interval Cosmovisor polls upgrade-info.json with, in seconds (DAEMON_POLL_INTERVAL)",
+			},
+			[]string{"node"},
+		),
+		MetricNameCosmovisorRestartDelaySeconds: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "cosmovisor_restart_delay_seconds",
+				Help: "Delay between the node halting for an upgrade and Cosmovisor restarting it, in seconds (DAEMON_RESTART_DELAY)",
+			},
+			[]string{"node"},
+		),
+		MetricNameDiskDirectorySize: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "disk_directory_size_bytes",
+				Help: "Size of the chain folder directory, in bytes. Directories are walked every 10 minutes at most.",
+			},
+			[]string{"node", "directory"},
+		),
+		MetricNameDiskFree: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "disk_free_bytes",
+				Help: "Free space on the filesystem holding the chain folder directory, in bytes",
+			},
+			[]string{"node", "directory"},
+		),
+		MetricNameDiskTotal: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "disk_total_bytes",
+				Help: "Total space on the filesystem holding the chain folder directory, in bytes",
+			},
+			[]string{"node", "directory"},
+		),
+		MetricNameDiskGrowthPerDay: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "disk_growth_bytes_per_day",
+				Help: "How much the chain folder directory grows per day, in bytes",
+			},
+			[]string{"node", "directory"},
+		),
+		MetricNameDiskEstimatedFull: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "disk_estimated_full_seconds",
+				Help: "Estimated time until the filesystem holding the chain folder directory is full, in seconds",
+			},
+			[]string{"node", "directory"},
+		),
+		MetricNameConfigPruning: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "config_pruning",
+				Help: "Pruning strategy from app.toml, always 1",
+			},
+			[]string{"node", "strategy"},
+		),
+		MetricNameConfigPruningKeepRecent: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "config_pruning_keep_recent",
+				Help: "Number of recent states kept with custom pruning, from app.toml",
+			},
+			[]string{"node"},
+		),
+		MetricNameConfigPruningInterval: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "config_pruning_interval",
+				Help: "Interval in blocks pruning runs at with custom pruning, from app.toml",
+			},
+			[]string{"node"},
+		),
+		MetricNameConfigMinRetainBlocks: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "config_min_retain_blocks",
+				Help: "Minimum number of blocks retained in the block store, from app.toml (0 means all blocks are kept)",
+			},
+			[]string{"node"},
+		),
+		MetricNameConfigSnapshotInterval: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "config_snapshot_interval",
+				Help: "Interval in blocks state sync snapshots are taken at, from app.toml (0 means disabled)",
+			},
+			[]string{"node"},
+		),
+		MetricNameConfigSnapshotKeepRecent: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "config_snapshot_keep_recent",
+				Help: "Number of recent state sync snapshots kept, from app.toml",
+			},
+			[]string{"node"},
+		),
+		MetricNameConfigServerEnabled: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "config_server_enabled",
+				Help: "Whether the API, gRPC, gRPC-web or RPC server is enabled, from app.toml and config.toml, with its listen address",
+			},
+			[]string{"node", "server", "address"},
+		),
+		MetricNameConfigTxIndexer: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "config_tx_indexer",
+				Help: "Transactions indexer from config.toml, always 1",
+			},
+			[]string{"node", "indexer"},
+		),
+		MetricNameConfigStateSyncEnabled: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "config_state_sync_enabled",
+				Help: "Whether the node is set to bootstrap via state sync, from config.toml",
+			},
+			[]string{"node"},
+		),
+		MetricNameConfigMempoolSize: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "config_mempool_size",
+				Help: "Maximum number of transactions in the mempool, from config.toml",
+			},
+			[]string{"node"},
+		),
+		MetricNameConfigMempoolMaxTxsBytes: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "config_mempool_max_txs_bytes",
+				Help: "Maximum total size of transactions in the mempool, in bytes, from config.toml",
+			},
+			[]string{"node"},
+		),
+		MetricNameConfigMempoolCacheSize: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "config_mempool_cache_size",
+				Help: "Size of the mempool cache, from config.toml",
+			},
+			[]string{"node"},
+		),
+		MetricNameConfigAppMempoolMaxTxs: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "config_app_mempool_max_txs",
+				Help: "Maximum number of transactions in the app-side mempool, from app.toml",
+			},
+			[]string{"node"},
+		),
+		MetricNameConfigLintViolation: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "config_lint_violation",
+				Help: "Whether the node config files have a risky setting checked by a lint rule (1 if they do, 0 if they don't)",
+			},
+			[]string{"node", "rule", "severity"},
+		),
+		MetricNameValidatorStateHeight: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "priv_validator_state_height",
+				Help: "Last height the validator key signed at, from priv_validator_state.json",
+			},
+			[]string{"node"},
+		),
+		MetricNameValidatorStateRound: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "priv_validator_state_round",
+				Help: "Last round the validator key signed at, from priv_validator_state.json",
+			},
+			[]string{"node"},
+		),
+		MetricNameValidatorStateStep: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "priv_validator_state_step",
+				Help: "Last step the validator key signed at, from priv_validator_state.json (1 - propose, 2 - prevote, 3 - precommit)",
+			},
+			[]string{"node"},
+		),
+		MetricNameValidatorStateLag: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "priv_validator_state_lag",
+				Help: "How many blocks the last signed height is behind the node latest height",
+			},
+			[]string{"node"},
+		),
+		MetricNameValidatorStateAheadOfChain: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "priv_validator_state_ahead_of_chain",
+				Help: "Whether the last signed height is ahead of the node latest height, like after restoring the node from a snapshot",
+			},
+			[]string{"node"},
+		),
 	}
 
 	globalCollectors := map[MetricName]*prometheus.GaugeVec{
@@ -208,6 +842,14 @@ func NewManager() *Manager {
 			[]string{"node", "querier", "module", "action"},
 		),
 
+		MetricNameQueryEndpoint: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: constants.MetricsPrefix + "query_endpoint",
+				Help: "Endpoint that served the query, always 1",
+			},
+			[]string{"node", "querier", "module", "action", "endpoint"},
+		),
+
 		MetricNameStartTime: prometheus.NewGaugeVec(
 			prometheus.GaugeOpts{
 				Name: constants.MetricsPrefix + "start_time",
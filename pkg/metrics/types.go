@@ -3,32 +3,117 @@ package metrics
 type MetricName string
 
 const (
-	MetricNameCosmovisorVersion     MetricName = "cosmovisor_version"
-	MetricNameCatchingUp            MetricName = "catching_up"
-	MetricNameLatestBlockHeight     MetricName = "latest_block_height"
-	MetricNameLatestBlockTime       MetricName = "latest_block_time"
-	MetricNameNodeInfo              MetricName = "node_info"
-	MetricNameTendermintVersion     MetricName = "tendermint_version"
-	MetricNameVotingPower           MetricName = "voting_power"
-	MetricNameRemoteVersion         MetricName = "remote_version"
-	MetricNameLocalVersion          MetricName = "local_version"
-	MetricNameIsLatest              MetricName = "is_latest"
-	MetricNameUpgradeComing         MetricName = "upgrade_coming"
-	MetricNameUpgradeInfo           MetricName = "upgrade_info"
-	MetricNameUpgradeHeight         MetricName = "upgrade_height"
-	MetricNameUpgradeEstimatedTime  MetricName = "upgrade_estimated_time"
-	MetricNameUpgradeBinaryPresent  MetricName = "upgrade_binary_present"
-	MetricNameAppVersion            MetricName = "version"
-	MetricNameQuerySuccessful       MetricName = "query_successful"
-	MetricNameQuerierEnabled        MetricName = "querier_enabled"
-	MetricNameStartTime             MetricName = "start_time"
-	MetricNameMinimumGasPricesCount MetricName = "minimum_gas_prices_count"
-	MetricNameMinimumGasPrice       MetricName = "minimum_gas_price"
-	MetricNameCosmosSdkVersion      MetricName = "cosmos_sdk_version"
-	MetricNameRunningAppVersion     MetricName = "running_app_version"
-	MetricNameGoVersion             MetricName = "go_version"
-	MetricNameHaltHeight            MetricName = "halt_height"
-	MetricNameNotExisting           MetricName = "not_existing" // for tests only
+	MetricNameCosmovisorVersion                         MetricName = "cosmovisor_version"
+	MetricNameCatchingUp                                MetricName = "catching_up"
+	MetricNameLatestBlockHeight                         MetricName = "latest_block_height"
+	MetricNameLatestBlockTime                           MetricName = "latest_block_time"
+	MetricNameNodeInfo                                  MetricName = "node_info"
+	MetricNameTendermintVersion                         MetricName = "tendermint_version"
+	MetricNameVotingPower                               MetricName = "voting_power"
+	MetricNameRemoteVersion                             MetricName = "remote_version"
+	MetricNameLocalVersion                              MetricName = "local_version"
+	MetricNameIsLatest                                  MetricName = "is_latest"
+	MetricNameUpgradeComing                             MetricName = "upgrade_coming"
+	MetricNameUpgradeInfo                               MetricName = "upgrade_info"
+	MetricNameUpgradeHeight                             MetricName = "upgrade_height"
+	MetricNameUpgradeEstimatedTime                      MetricName = "upgrade_estimated_time"
+	MetricNameUpgradeBinaryPresent                      MetricName = "upgrade_binary_present"
+	MetricNameAppVersion                                MetricName = "version"
+	MetricNameQuerySuccessful                           MetricName = "query_successful"
+	MetricNameQuerierEnabled                            MetricName = "querier_enabled"
+	MetricNameStartTime                                 MetricName = "start_time"
+	MetricNameMinimumGasPricesCount                     MetricName = "minimum_gas_prices_count"
+	MetricNameMinimumGasPrice                           MetricName = "minimum_gas_price"
+	MetricNameCosmosSdkVersion                          MetricName = "cosmos_sdk_version"
+	MetricNameRunningAppVersion                         MetricName = "running_app_version"
+	MetricNameGoVersion                                 MetricName = "go_version"
+	MetricNameHaltHeight                                MetricName = "halt_height"
+	MetricNameConsensusHeight                           MetricName = "consensus_height"
+	MetricNameConsensusRound                            MetricName = "consensus_round"
+	MetricNameConsensusStep                             MetricName = "consensus_step"
+	MetricNameConsensusPrevotesPercent                  MetricName = "consensus_prevotes_percent"
+	MetricNameConsensusPrecommitsPercent                MetricName = "consensus_precommits_percent"
+	MetricNameSecondsSinceHeightChange                  MetricName = "seconds_since_height_change"
+	MetricNameReferenceLatestBlockHeight                MetricName = "reference_latest_block_height"
+	MetricNameReferenceLatency                          MetricName = "reference_latency_seconds"
+	MetricNameBlocksBehindReference                     MetricName = "blocks_behind_reference"
+	MetricNameWebsocketConnected                        MetricName = "websocket_connected"
+	MetricNameWebsocketLatestBlockHeight                MetricName = "websocket_latest_block_height"
+	MetricNameWebsocketLatestBlockTime                  MetricName = "websocket_latest_block_time"
+	MetricNameWebsocketSecondsSinceLastBlock            MetricName = "websocket_seconds_since_last_block"
+	MetricNameWebsocketAverageBlockTime                 MetricName = "websocket_average_block_time"
+	MetricNameQueryEndpoint                             MetricName = "query_endpoint"
+	MetricNameAverageBlockTime                          MetricName = "average_block_time"
+	MetricNameActiveSetSize                             MetricName = "active_set_size"
+	MetricNameValidatorInActiveSet                      MetricName = "validator_in_active_set"
+	MetricNameValidatorRank                             MetricName = "validator_rank"
+	MetricNameValidatorProposerPriority                 MetricName = "validator_proposer_priority"
+	MetricNameVotingPowerGapToLastActive                MetricName = "voting_power_gap_to_last_active"
+	MetricNameVotingPowerGapToFirstInactive             MetricName = "voting_power_gap_to_first_inactive"
+	MetricNameSyncRate                                  MetricName = "sync_rate_blocks_per_second"
+	MetricNameBlocksRemaining                           MetricName = "blocks_remaining"
+	MetricNameEstimatedSyncCompletion                   MetricName = "estimated_sync_completion_timestamp"
+	MetricNameEarliestBlockHeight                       MetricName = "earliest_block_height"
+	MetricNameEarliestBlockTime                         MetricName = "earliest_block_time"
+	MetricNameAppHash                                   MetricName = "app_hash"
+	MetricNameLatestAppHash                             MetricName = "latest_app_hash"
+	MetricNameRetainedBlocks                            MetricName = "retained_blocks"
+	MetricNameEstimatedRetention                        MetricName = "estimated_retention_seconds"
+	MetricNameAbciLastBlockHeight                       MetricName = "abci_last_block_height"
+	MetricNameAbciAppVersion                            MetricName = "abci_app_version"
+	MetricNameAbciInfo                                  MetricName = "abci_info"
+	MetricNameAbciLastBlockAppHash                      MetricName = "abci_last_block_app_hash"
+	MetricNameAbciHeightLag                             MetricName = "abci_height_lag"
+	MetricNameBlockTimeClockOffset                      MetricName = "block_time_clock_offset_seconds"
+	MetricNameNtpClockOffset                            MetricName = "ntp_clock_offset_seconds"
+	MetricNameHaltHeightEstimatedTime                   MetricName = "halt_height_estimated_time"
+	MetricNameHaltHeightInconsistent                    MetricName = "halt_height_inconsistent"
+	MetricNameUpgradeProposalHeight                     MetricName = "upgrade_proposal_height"
+	MetricNameUpgradeProposalVotingEndTime              MetricName = "upgrade_proposal_voting_end_time"
+	MetricNameUpgradeProposalTally                      MetricName = "upgrade_proposal_tally"
+	MetricNameUpgradeAppliedHeight                      MetricName = "upgrade_applied_height"
+	MetricNameModuleConsensusVersion                    MetricName = "module_consensus_version"
+	MetricNameChainRegistryCompatibleVersion            MetricName = "chain_registry_compatible_version"
+	MetricNameChainRegistryUpgradeHeight                MetricName = "chain_registry_upgrade_height"
+	MetricNameUpgradeBinaryChecksumMatch                MetricName = "upgrade_binary_checksum_match"
+	MetricNameUpgradeBinaryPlatformAvailable            MetricName = "upgrade_binary_platform_available"
+	MetricNameCosmovisorCurrentUpgrade                  MetricName = "cosmovisor_current_upgrade"
+	MetricNameCosmovisorGenesisBinaryPresent            MetricName = "cosmovisor_genesis_binary_present"
+	MetricNameCosmovisorUpgradeFolderBinaryPresent      MetricName = "cosmovisor_upgrade_folder_binary_present"
+	MetricNameCosmovisorUpgradeFolderBinaryModifiedTime MetricName = "cosmovisor_upgrade_folder_binary_modified_time"
+	MetricNameUpgradeBinaryRunnable                     MetricName = "upgrade_binary_runnable"
+	MetricNameUpgradeBinaryVersion                      MetricName = "upgrade_binary_version"
+	MetricNameCosmovisorSetting                         MetricName = "cosmovisor_setting"
+	MetricNameCosmovisorAllowDownloadBinaries           MetricName = "cosmovisor_allow_download_binaries"
+	MetricNameCosmovisorRestartAfterUpgrade             MetricName = "cosmovisor_restart_after_upgrade"
+	MetricNameCosmovisorUnsafeSkipBackup                MetricName = "cosmovisor_unsafe_skip_backup"
+	MetricNameCosmovisorPollIntervalSeconds             MetricName = "cosmovisor_poll_interval_seconds"
+	MetricNameCosmovisorRestartDelaySeconds             MetricName = "cosmovisor_restart_delay_seconds"
+	MetricNameDiskDirectorySize                         MetricName = "disk_directory_size"
+	MetricNameDiskFree                                  MetricName = "disk_free"
+	MetricNameDiskTotal                                 MetricName = "disk_total"
+	MetricNameDiskGrowthPerDay                          MetricName = "disk_growth_per_day"
+	MetricNameDiskEstimatedFull                         MetricName = "disk_estimated_full"
+	MetricNameConfigPruning                             MetricName = "config_pruning"
+	MetricNameConfigPruningKeepRecent                   MetricName = "config_pruning_keep_recent"
+	MetricNameConfigPruningInterval                     MetricName = "config_pruning_interval"
+	MetricNameConfigMinRetainBlocks                     MetricName = "config_min_retain_blocks"
+	MetricNameConfigSnapshotInterval                    MetricName = "config_snapshot_interval"
+	MetricNameConfigSnapshotKeepRecent                  MetricName = "config_snapshot_keep_recent"
+	MetricNameConfigServerEnabled                       MetricName = "config_server_enabled"
+	MetricNameConfigTxIndexer                           MetricName = "config_tx_indexer"
+	MetricNameConfigStateSyncEnabled                    MetricName = "config_state_sync_enabled"
+	MetricNameConfigMempoolSize                         MetricName = "config_mempool_size"
+	MetricNameConfigMempoolMaxTxsBytes                  MetricName = "config_mempool_max_txs_bytes"
+	MetricNameConfigMempoolCacheSize                    MetricName = "config_mempool_cache_size"
+	MetricNameConfigAppMempoolMaxTxs                    MetricName = "config_app_mempool_max_txs"
+	MetricNameConfigLintViolation                       MetricName = "config_lint_violation"
+	MetricNameValidatorStateHeight                      MetricName = "priv_validator_state_height"
+	MetricNameValidatorStateRound                       MetricName = "priv_validator_state_round"
+	MetricNameValidatorStateStep                        MetricName = "priv_validator_state_step"
+	MetricNameValidatorStateLag                         MetricName = "priv_validator_state_lag"
+	MetricNameValidatorStateAheadOfChain                MetricName = "priv_validator_state_ahead_of_chain"
+	MetricNameNotExisting                               MetricName = "not_existing" // for tests only
 )
 
 type MetricInfo struct {
@@ -2,16 +2,24 @@ package pkg
 
 import (
 	"context"
+	binaryPkg "main/pkg/clients/binary"
+	"main/pkg/clients/chain_registry"
+	"main/pkg/clients/config_files"
 	cosmovisorPkg "main/pkg/clients/cosmovisor"
+	"main/pkg/clients/disk"
 	"main/pkg/clients/git"
 	grpcPkg "main/pkg/clients/grpc"
+	"main/pkg/clients/ntp"
 	"main/pkg/clients/tendermint"
+	"main/pkg/clients/validator_state"
 	configPkg "main/pkg/config"
 	fetchersPkg "main/pkg/fetchers"
 	generatorsPkg "main/pkg/generators"
 	metricsPkg "main/pkg/metrics"
 	"main/pkg/query_info"
 
+	"gopkg.in/guregu/null.v4"
+
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 
@@ -19,11 +27,12 @@ import (
 )
 
 type NodeHandler struct {
-	Logger     zerolog.Logger
-	Config     configPkg.NodeConfig
-	Tracer     trace.Tracer
-	Generators []generatorsPkg.Generator
-	Controller *fetchersPkg.Controller
+	Logger          zerolog.Logger
+	Config          configPkg.NodeConfig
+	Tracer          trace.Tracer
+	Generators      []generatorsPkg.Generator
+	Controller      *fetchersPkg.Controller
+	WebsocketClient *tendermint.WebsocketClient
 }
 
 func NewNodeHandler(
@@ -37,38 +46,121 @@ func NewNodeHandler(
 		Logger()
 
 	var tendermintRPC *tendermint.RPC
+	var referenceRPCs []*tendermint.RPC
+	var websocketClient *tendermint.WebsocketClient
 	var cosmovisor *cosmovisorPkg.Cosmovisor
+	var binary *binaryPkg.Binary
 	var grpc *grpcPkg.Client
 
 	if config.TendermintConfig.Enabled.Bool {
 		tendermintRPC = tendermint.NewRPC(config.TendermintConfig, appLogger, tracer)
+
+		referenceRPCs = make([]*tendermint.RPC, len(config.TendermintConfig.ReferenceAddresses))
+		for index, referenceAddress := range config.TendermintConfig.ReferenceAddresses {
+			referenceConfig := configPkg.TendermintConfig{
+				Enabled: null.BoolFrom(true),
+				Address: referenceAddress,
+			}
+			referenceRPCs[index] = tendermint.NewRPC(referenceConfig, appLogger, tracer)
+		}
+
+		if config.TendermintConfig.Websocket.Bool {
+			websocketClient = tendermint.NewWebsocketClient(config.TendermintConfig, appLogger)
+			websocketClient.Start()
+		}
 	}
 
 	if config.CosmovisorConfig.Enabled.Bool {
 		cosmovisor = cosmovisorPkg.NewCosmovisor(config.CosmovisorConfig, appLogger, tracer)
 	}
 
+	if config.BinaryConfig.Path != "" {
+		binary = binaryPkg.NewBinary(config.BinaryConfig, appLogger, tracer)
+	}
+
 	if config.GrpcConfig.Enabled.Bool {
 		grpc = grpcPkg.NewClient(config.GrpcConfig, appLogger, tracer)
 	}
 
 	gitClient := git.GetClient(config.GitConfig, appLogger, tracer)
 
+	var chainRegistry *chain_registry.Client
+	if config.ChainRegistryConfig.Path != "" {
+		chainRegistry = chain_registry.NewClient(config.ChainRegistryConfig, appLogger, tracer)
+	}
+
+	var diskClient *disk.Client
+	var configFilesClient *config_files.Client
+	var validatorStateClient *validator_state.Client
+	if chainFolder := config.GetChainFolder(); chainFolder != "" {
+		diskClient = disk.NewClient(chainFolder, appLogger, tracer)
+		configFilesClient = config_files.NewClient(chainFolder, appLogger, tracer)
+		validatorStateClient = validator_state.NewClient(chainFolder, appLogger, tracer)
+	}
+
+	var ntpClient *ntp.Client
+	if config.NtpConfig.Address != "" {
+		ntpClient = ntp.NewClient(config.NtpConfig.Address, appLogger, tracer)
+	}
+
 	fetchers := fetchersPkg.Fetchers{
 		fetchersPkg.NewNodeStatusFetcher(appLogger, tendermintRPC, tracer),
+		fetchersPkg.NewAbciInfoFetcher(appLogger, tendermintRPC, tracer),
 		fetchersPkg.NewCosmovisorVersionFetcher(appLogger, cosmovisor, tracer),
 		fetchersPkg.NewNodeConfigFetcher(appLogger, grpc, tracer),
 		fetchersPkg.NewNodeInfoFetcher(appLogger, grpc, tracer),
-		fetchersPkg.NewRemoteVersionFetcher(appLogger, gitClient, tracer),
-		fetchersPkg.NewLocalVersionFetcher(appLogger, cosmovisor, tracer),
+		fetchersPkg.NewChainRegistryFetcher(appLogger, chainRegistry, tracer),
+		fetchersPkg.NewRemoteVersionFetcher(appLogger, gitClient, chainRegistry, tracer),
+		fetchersPkg.NewLocalVersionFetcher(appLogger, cosmovisor, binary, tracer),
 		fetchersPkg.NewUpgradesFetcher(appLogger, tendermintRPC, config.TendermintConfig.QueryUpgrades.Bool, tracer),
-		fetchersPkg.NewBlockTimeFetcher(appLogger, tendermintRPC, tracer),
+		fetchersPkg.NewBlockTimeFetcher(
+			appLogger,
+			tendermintRPC,
+			config.TendermintConfig.BlockTimeWindows,
+			tracer,
+		),
 		fetchersPkg.NewCosmovisorUpgradesFetcher(appLogger, cosmovisor, tracer),
 		fetchersPkg.NewCosmovisorUpgradeInfoFetcher(appLogger, cosmovisor, tracer),
+		fetchersPkg.NewCosmovisorStateFetcher(appLogger, cosmovisor, tracer),
+		fetchersPkg.NewCosmovisorSettingsFetcher(appLogger, cosmovisor, tracer),
+		fetchersPkg.NewUpgradeBinariesFetcher(appLogger, cosmovisor, tracer),
+		fetchersPkg.NewUpgradeSmokeTestsFetcher(
+			appLogger,
+			cosmovisor,
+			config.CosmovisorConfig.SmokeTestUpgrades.Bool,
+			tracer,
+		),
+		fetchersPkg.NewConsensusStateFetcher(appLogger, tendermintRPC, tracer),
+		fetchersPkg.NewReferenceStatusFetcher(appLogger, referenceRPCs, tracer),
+		fetchersPkg.NewWebsocketBlocksFetcher(appLogger, websocketClient, tracer),
+		fetchersPkg.NewValidatorsFetcher(
+			appLogger,
+			tendermintRPC,
+			config.TendermintConfig.QueryValidators.Bool,
+			tracer,
+		),
+		fetchersPkg.NewSyncProgressFetcher(appLogger, tendermintRPC, tracer),
+		fetchersPkg.NewClockDriftFetcher(appLogger, tendermintRPC, ntpClient, tracer),
+		fetchersPkg.NewAppliedUpgradesFetcher(
+			appLogger,
+			tendermintRPC,
+			config.TendermintConfig.QueryUpgrades.Bool,
+			tracer,
+		),
+		fetchersPkg.NewDiskUsageFetcher(appLogger, diskClient, tracer),
+		fetchersPkg.NewConfigFilesFetcher(appLogger, configFilesClient, tracer),
+		fetchersPkg.NewValidatorStateFetcher(appLogger, validatorStateClient, tracer),
+		fetchersPkg.NewUpgradeProposalsFetcher(
+			appLogger,
+			tendermintRPC,
+			config.TendermintConfig.QueryUpgradeProposals.Bool,
+			tracer,
+		),
 	}
 
 	generators := []generatorsPkg.Generator{
 		generatorsPkg.NewNodeStatusGenerator(),
+		generatorsPkg.NewAbciInfoGenerator(),
 		generatorsPkg.NewCosmovisorVersionGenerator(),
 		generatorsPkg.NewNodeConfigGenerator(),
 		generatorsPkg.NewNodeInfoGenerator(),
@@ -76,8 +168,28 @@ func NewNodeHandler(
 		generatorsPkg.NewLocalVersionGenerator(),
 		generatorsPkg.NewIsLatestGenerator(appLogger),
 		generatorsPkg.NewUpgradesGenerator(),
-		generatorsPkg.NewTimeTillUpgradeGenerator(),
+		generatorsPkg.NewTimeTillUpgradeGenerator(config.TendermintConfig.GetUpgradeBlockTimeWindow()),
+		generatorsPkg.NewBlockTimeGenerator(),
+		generatorsPkg.NewRetentionGenerator(config.TendermintConfig.GetUpgradeBlockTimeWindow()),
+		generatorsPkg.NewHaltHeightGenerator(config.TendermintConfig.GetUpgradeBlockTimeWindow()),
 		generatorsPkg.NewCosmovisorUpgradesGenerator(),
+		generatorsPkg.NewCosmovisorStateGenerator(),
+		generatorsPkg.NewCosmovisorSettingsGenerator(),
+		generatorsPkg.NewUpgradeBinariesGenerator(),
+		generatorsPkg.NewUpgradeSmokeTestsGenerator(),
+		generatorsPkg.NewConsensusStateGenerator(),
+		generatorsPkg.NewReferenceStatusGenerator(),
+		generatorsPkg.NewWebsocketBlocksGenerator(),
+		generatorsPkg.NewValidatorsGenerator(),
+		generatorsPkg.NewSyncProgressGenerator(),
+		generatorsPkg.NewClockDriftGenerator(),
+		generatorsPkg.NewUpgradeProposalsGenerator(appLogger),
+		generatorsPkg.NewAppliedUpgradesGenerator(),
+		generatorsPkg.NewChainRegistryGenerator(),
+		generatorsPkg.NewDiskUsageGenerator(),
+		generatorsPkg.NewConfigFilesGenerator(),
+		generatorsPkg.NewConfigLintGenerator(config.Role),
+		generatorsPkg.NewValidatorStateGenerator(),
 	}
 
 	controller := fetchersPkg.NewController(fetchers, appLogger, config.Name)
@@ -91,11 +203,18 @@ func NewNodeHandler(
 	}
 
 	return &NodeHandler{
-		Logger:     appLogger,
-		Config:     config,
-		Tracer:     tracer,
-		Generators: generators,
-		Controller: controller,
+		Logger:          appLogger,
+		Config:          config,
+		Tracer:          tracer,
+		Generators:      generators,
+		Controller:      controller,
+		WebsocketClient: websocketClient,
+	}
+}
+
+func (a *NodeHandler) Stop() {
+	if a.WebsocketClient != nil {
+		a.WebsocketClient.Stop()
 	}
 }
 
@@ -24,11 +24,18 @@ func TestNodeHandler(t *testing.T) {
 		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("status.json")),
 	)
 
+	httpmock.RegisterResponder(
+		"GET",
+		"https://reference.com/status",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("status.json")),
+	)
+
 	config := configPkg.NodeConfig{
 		TendermintConfig: configPkg.TendermintConfig{
-			Enabled:       null.BoolFrom(true),
-			Address:       "https://example.com",
-			QueryUpgrades: null.BoolFrom(false),
+			Enabled:            null.BoolFrom(true),
+			Address:            "https://example.com",
+			QueryUpgrades:      null.BoolFrom(false),
+			ReferenceAddresses: []string{"https://reference.com"},
 		},
 		CosmovisorConfig: configPkg.CosmovisorConfig{
 			Enabled:         null.BoolFrom(true),
@@ -0,0 +1,517 @@
+package preparer
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"main/pkg/clients/git"
+	"main/pkg/clients/tendermint"
+	configPkg "main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/types"
+	"main/pkg/utils"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/rs/zerolog"
+)
+
+const (
+	SourcePlan          = "plan"
+	SourceGithubRelease = "github-release"
+)
+
+// BinarySource is where the upgrade binary is downloaded from and how it is verified.
+type BinarySource struct {
+	Url       string
+	Algorithm string
+	Checksum  string
+	Source    string
+}
+
+type UpgradePreparer struct {
+	Config        configPkg.NodeConfig
+	TendermintRPC *tendermint.RPC
+	Github        *git.Github
+	Platform      string
+	DryRun        bool
+	Logger        zerolog.Logger
+	Tracer        trace.Tracer
+}
+
+func NewUpgradePreparer(
+	config configPkg.NodeConfig,
+	logger zerolog.Logger,
+	tracer trace.Tracer,
+	dryRun bool,
+) *UpgradePreparer {
+	preparerLogger := logger.With().
+		Str("component", "upgrade_preparer").
+		Str("node", config.Name).
+		Logger()
+
+	var tendermintRPC *tendermint.RPC
+	if config.TendermintConfig.Enabled.Bool {
+		tendermintRPC = tendermint.NewRPC(config.TendermintConfig, preparerLogger, tracer)
+	}
+
+	// release assets are only looked up on GitHub, Gitopia releases do not have them
+	var github *git.Github
+	if constants.GithubRegexp.Match([]byte(config.GitConfig.Repository)) {
+		github = git.NewGithub(config.GitConfig, preparerLogger, tracer)
+	}
+
+	return &UpgradePreparer{
+		Config:        config,
+		TendermintRPC: tendermintRPC,
+		Github:        github,
+		Platform:      runtime.GOOS + "/" + runtime.GOARCH,
+		DryRun:        dryRun,
+		Logger:        preparerLogger,
+		Tracer:        tracer,
+	}
+}
+
+func (p *UpgradePreparer) Prepare(ctx context.Context) error {
+	childCtx, span := p.Tracer.Start(ctx, "Preparing upgrade")
+	defer span.End()
+
+	if p.TendermintRPC == nil {
+		return errors.New("Tendermint config is not enabled, cannot fetch the upgrade plan")
+	}
+
+	if !p.Config.CosmovisorConfig.Enabled.Bool {
+		return errors.New("Cosmovisor config is not enabled, cannot find the upgrades folder")
+	}
+
+	plan, _, err := p.TendermintRPC.GetUpgradePlan(childCtx)
+	if err != nil {
+		return fmt.Errorf("could not fetch upgrade plan: %s", err)
+	}
+
+	if plan == nil {
+		p.Logger.Info().Msg("No upgrade plan found, nothing to prepare")
+		return nil
+	}
+
+	targetFolder := fmt.Sprintf(
+		"%s/cosmovisor/upgrades/%s/bin",
+		p.Config.CosmovisorConfig.ChainFolder,
+		utils.NormalizeUpgradeName(plan.Name),
+	)
+	targetPath := targetFolder + "/" + p.Config.CosmovisorConfig.ChainBinaryName
+
+	if _, err := os.Stat(targetPath); err == nil {
+		p.Logger.Info().
+			Str("name", plan.Name).
+			Str("path", targetPath).
+			Msg("Upgrade binary is already present, nothing to prepare")
+		return nil
+	}
+
+	source, err := p.ResolveBinarySource(childCtx, plan.Name, plan.Info)
+	if err != nil {
+		return err
+	}
+
+	if p.DryRun {
+		p.Logger.Info().
+			Str("name", plan.Name).
+			Int64("height", plan.Height).
+			Str("source", source.Source).
+			Str("url", source.Url).
+			Str("checksum", source.Algorithm+":"+source.Checksum).
+			Str("path", targetPath).
+			Msg("Dry run: would download, verify and install the upgrade binary")
+		return nil
+	}
+
+	p.Logger.Info().
+		Str("name", plan.Name).
+		Str("source", source.Source).
+		Str("url", source.Url).
+		Msg("Downloading upgrade binary")
+
+	if err := p.Install(childCtx, source, targetFolder, targetPath); err != nil {
+		return err
+	}
+
+	p.Logger.Info().
+		Str("name", plan.Name).
+		Str("path", targetPath).
+		Msg("Upgrade binary is installed")
+
+	return nil
+}
+
+// ResolveBinarySource takes the binary URL with checksum from the plan info if it has one
+// for the current platform, falling back to the GitHub release matching the upgrade name.
+func (p *UpgradePreparer) ResolveBinarySource(
+	ctx context.Context,
+	upgradeName string,
+	planInfo string,
+) (*BinarySource, error) {
+	if binaries, ok := types.ParseUpgradeBinaries(planInfo); ok {
+		if binaryUrl, found := binaries.ForPlatform(p.Platform); found {
+			if algorithm, checksum, hasChecksum := types.ParseBinaryChecksum(binaryUrl); hasChecksum {
+				return &BinarySource{
+					Url:       stripChecksum(binaryUrl),
+					Algorithm: algorithm,
+					Checksum:  checksum,
+					Source:    SourcePlan,
+				}, nil
+			}
+
+			p.Logger.Warn().
+				Str("url", binaryUrl).
+				Msg("Plan binary has no checksum, trying GitHub release instead")
+		}
+	}
+
+	if p.Github == nil {
+		return nil, fmt.Errorf(
+			"plan has no binary with checksum for %s and no GitHub repository is configured",
+			p.Platform,
+		)
+	}
+
+	return p.ResolveGithubBinarySource(ctx, upgradeName)
+}
+
+func (p *UpgradePreparer) ResolveGithubBinarySource(ctx context.Context, upgradeName string) (*BinarySource, error) {
+	releases, _, err := p.Github.GetReleases(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch GitHub releases: %s", err)
+	}
+
+	release, found := findRelease(releases, upgradeName)
+	if !found {
+		return nil, fmt.Errorf("could not find GitHub release for upgrade %s", upgradeName)
+	}
+
+	binaryAsset, found := findPlatformAsset(release.Assets, p.Platform)
+	if !found {
+		return nil, fmt.Errorf("GitHub release %s has no asset for %s", release.TagName, p.Platform)
+	}
+
+	checksumsAsset, found := findChecksumsAsset(release.Assets)
+	if !found {
+		return nil, fmt.Errorf("GitHub release %s has no checksums file", release.TagName)
+	}
+
+	var checksums bytes.Buffer
+	if err := p.Download(ctx, checksumsAsset.BrowserDownloadUrl, &checksums); err != nil {
+		return nil, fmt.Errorf("could not download checksums file: %s", err)
+	}
+
+	checksum, found := findChecksum(checksums.String(), binaryAsset.Name)
+	if !found {
+		return nil, fmt.Errorf("checksums file has no checksum for %s", binaryAsset.Name)
+	}
+
+	return &BinarySource{
+		Url:       binaryAsset.BrowserDownloadUrl,
+		Algorithm: "sha256",
+		Checksum:  checksum,
+		Source:    SourceGithubRelease,
+	}, nil
+}
+
+func (p *UpgradePreparer) Download(ctx context.Context, downloadUrl string, writer io.Writer) error {
+	client := &http.Client{
+		Timeout:   constants.UpgradeBinaryDownloadTimeout,
+		Transport: otelhttp.NewTransport(http.DefaultTransport),
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, downloadUrl, nil)
+	if err != nil {
+		return err
+	}
+
+	p.Logger.Trace().Str("url", downloadUrl).Msg("Downloading file")
+
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("got unexpected status %d when downloading %s", res.StatusCode, downloadUrl)
+	}
+
+	_, err = io.Copy(writer, res.Body)
+	return err
+}
+
+// Install downloads the binary (or an archive with it), verifies its checksum
+// and puts the binary into the Cosmovisor upgrade folder.
+func (p *UpgradePreparer) Install(
+	ctx context.Context,
+	source *BinarySource,
+	targetFolder string,
+	targetPath string,
+) error {
+	hasher, err := utils.NewHasher(source.Algorithm)
+	if err != nil {
+		return err
+	}
+
+	downloaded, err := os.CreateTemp("", "cosmos-node-exporter-upgrade-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(downloaded.Name())
+	defer downloaded.Close()
+
+	if err := p.Download(ctx, source.Url, io.MultiWriter(downloaded, hasher)); err != nil {
+		return fmt.Errorf("could not download upgrade binary: %s", err)
+	}
+
+	if checksum := hex.EncodeToString(hasher.Sum(nil)); checksum != source.Checksum {
+		return fmt.Errorf("checksum mismatch: expected %s, got %s", source.Checksum, checksum)
+	}
+
+	if _, err := downloaded.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(targetFolder, 0o755); err != nil {
+		return err
+	}
+
+	// writing to a temporary file first, so Cosmovisor never sees a partially written binary
+	tempTargetPath := targetPath + ".download"
+	defer os.Remove(tempTargetPath)
+
+	target, err := os.OpenFile(tempTargetPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o755)
+	if err != nil {
+		return err
+	}
+
+	binaryName := p.Config.CosmovisorConfig.ChainBinaryName
+	archivePath := strings.ToLower(urlPath(source.Url))
+
+	switch {
+	case strings.HasSuffix(archivePath, ".tar.gz"), strings.HasSuffix(archivePath, ".tgz"):
+		err = extractFromTarGz(downloaded, binaryName, target)
+	case strings.HasSuffix(archivePath, ".zip"):
+		err = extractFromZip(downloaded, binaryName, target)
+	default:
+		_, err = io.Copy(target, downloaded)
+	}
+
+	if closeErr := target.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		return fmt.Errorf("could not unpack upgrade binary: %s", err)
+	}
+
+	return os.Rename(tempTargetPath, targetPath)
+}
+
+func extractFromTarGz(archive io.Reader, binaryName string, target io.Writer) error {
+	gzipReader, err := gzip.NewReader(archive)
+	if err != nil {
+		return err
+	}
+	defer gzipReader.Close()
+
+	tarReader := tar.NewReader(gzipReader)
+
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			return fmt.Errorf("binary %s not found in archive", binaryName)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if header.Typeflag == tar.TypeReg && path.Base(header.Name) == binaryName {
+			_, err = io.Copy(target, tarReader) //nolint:gosec // the size is limited by the verified checksum
+			return err
+		}
+	}
+}
+
+func extractFromZip(archive *os.File, binaryName string, target io.Writer) error {
+	stat, err := archive.Stat()
+	if err != nil {
+		return err
+	}
+
+	zipReader, err := zip.NewReader(archive, stat.Size())
+	if err != nil {
+		return err
+	}
+
+	for _, file := range zipReader.File {
+		if file.FileInfo().IsDir() || path.Base(file.Name) != binaryName {
+			continue
+		}
+
+		reader, err := file.Open()
+		if err != nil {
+			return err
+		}
+		defer reader.Close()
+
+		_, err = io.Copy(target, reader) //nolint:gosec // the size is limited by the verified checksum
+		return err
+	}
+
+	return fmt.Errorf("binary %s not found in archive", binaryName)
+}
+
+// findRelease returns the release with a tag equal to the upgrade name, or the newest
+// stable release of this version, like v17.2.0 for v17.
+func findRelease(releases []git.GithubReleaseInfo, upgradeName string) (git.GithubReleaseInfo, bool) {
+	for _, release := range releases {
+		if release.TagName == upgradeName && !release.Draft {
+			return release, true
+		}
+	}
+
+	for _, release := range releases {
+		if release.Draft || release.Prerelease {
+			continue
+		}
+
+		if strings.HasPrefix(release.TagName, upgradeName+".") {
+			return release, true
+		}
+	}
+
+	return git.GithubReleaseInfo{}, false
+}
+
+func isChecksumsAsset(name string) bool {
+	lowercaseName := strings.ToLower(name)
+	return strings.Contains(lowercaseName, "sha256sum") || strings.Contains(lowercaseName, "checksums")
+}
+
+// other names release assets commonly use for Go architectures
+var archAliases = map[string][]string{
+	"amd64": {"x86_64", "x64"},
+	"arm64": {"aarch64"},
+	"386":   {"i386", "i686"},
+}
+
+func findPlatformAsset(assets []git.GithubReleaseAsset, platform string) (git.GithubReleaseAsset, bool) {
+	goos, goarch, _ := strings.Cut(platform, "/")
+	archNames := append([]string{goarch}, archAliases[goarch]...)
+
+	for _, asset := range assets {
+		name := strings.ToLower(asset.Name)
+		if isChecksumsAsset(name) || filepath.Ext(name) == ".sig" || filepath.Ext(name) == ".asc" {
+			continue
+		}
+
+		if !hasNameSegment(name, goos) {
+			continue
+		}
+
+		for _, archName := range archNames {
+			if hasNameSegment(name, archName) {
+				return asset, true
+			}
+		}
+	}
+
+	return git.GithubReleaseAsset{}, false
+}
+
+// hasNameSegment checks whether the segment is present in the asset name as a whole,
+// delimited by '-', '_', '.' or the name boundaries, so "arm" does not match "arm64".
+func hasNameSegment(name string, segment string) bool {
+	isSeparator := func(char byte) bool {
+		return char == '-' || char == '_' || char == '.'
+	}
+
+	for start := 0; start < len(name); {
+		index := strings.Index(name[start:], segment)
+		if index < 0 {
+			return false
+		}
+
+		index += start
+		end := index + len(segment)
+
+		if (index == 0 || isSeparator(name[index-1])) && (end == len(name) || isSeparator(name[end])) {
+			return true
+		}
+
+		start = index + 1
+	}
+
+	return false
+}
+
+func findChecksumsAsset(assets []git.GithubReleaseAsset) (git.GithubReleaseAsset, bool) {
+	for _, asset := range assets {
+		if isChecksumsAsset(asset.Name) {
+			return asset, true
+		}
+	}
+
+	return git.GithubReleaseAsset{}, false
+}
+
+// findChecksum looks up a file in the sha256sum output, with lines like "<hex>  <file name>".
+func findChecksum(checksums string, fileName string) (string, bool) {
+	scanner := bufio.NewScanner(strings.NewReader(checksums))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			continue
+		}
+
+		if strings.TrimPrefix(fields[1], "*") == fileName {
+			return strings.ToLower(fields[0]), true
+		}
+	}
+
+	return "", false
+}
+
+func urlPath(rawUrl string) string {
+	parsedUrl, err := url.Parse(rawUrl)
+	if err != nil {
+		return rawUrl
+	}
+
+	return parsedUrl.Path
+}
+
+// stripChecksum removes the checksum parameter, as it is only meant for the downloader.
+func stripChecksum(rawUrl string) string {
+	parsedUrl, err := url.Parse(rawUrl)
+	if err != nil {
+		return rawUrl
+	}
+
+	query := parsedUrl.Query()
+	query.Del("checksum")
+	parsedUrl.RawQuery = query.Encode()
+
+	return parsedUrl.String()
+}
@@ -0,0 +1,525 @@
+package preparer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"main/assets"
+	"main/pkg/clients/git"
+	configPkg "main/pkg/config"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/tracing"
+	"os"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+const (
+	upgradePlanUrl    = "https://example.com/abci_query?path=%22%2Fcosmos.upgrade.v1beta1.Query%2FCurrentPlan%22&data=0x"
+	githubReleasesUrl = "https://api.github.com/repos/cosmos/gaia/releases?per_page=30"
+	binaryContent     = "not a real gaiad binary, used in tests\n"
+)
+
+func getTestPreparer(t *testing.T, dryRun bool) (*UpgradePreparer, string) {
+	t.Helper()
+
+	chainFolder := t.TempDir()
+
+	config := configPkg.NodeConfig{
+		Name: "node",
+		TendermintConfig: configPkg.TendermintConfig{
+			Enabled: null.BoolFrom(true),
+			Address: "https://example.com",
+		},
+		CosmovisorConfig: configPkg.CosmovisorConfig{
+			Enabled:         null.BoolFrom(true),
+			ChainBinaryName: "gaiad",
+			ChainFolder:     chainFolder,
+			CosmovisorPath:  "/home/validator/go/bin/cosmovisor",
+		},
+		GitConfig: configPkg.GitConfig{Repository: "https://github.com/cosmos/gaia"},
+	}
+
+	logger := loggerPkg.GetNopLogger()
+	tracer := tracing.InitNoopTracer()
+	preparer := NewUpgradePreparer(config, *logger, tracer, dryRun)
+	preparer.Platform = "linux/amd64"
+
+	return preparer, chainFolder
+}
+
+func TestPrepareUpgradeTendermintDisabled(t *testing.T) {
+	t.Parallel()
+
+	preparer, _ := getTestPreparer(t, false)
+	preparer.TendermintRPC = nil
+
+	err := preparer.Prepare(context.Background())
+	require.Error(t, err)
+	require.ErrorContains(t, err, "Tendermint config is not enabled")
+}
+
+func TestPrepareUpgradeCosmovisorDisabled(t *testing.T) {
+	t.Parallel()
+
+	preparer, _ := getTestPreparer(t, false)
+	preparer.Config.CosmovisorConfig.Enabled = null.BoolFrom(false)
+
+	err := preparer.Prepare(context.Background())
+	require.Error(t, err)
+	require.ErrorContains(t, err, "Cosmovisor config is not enabled")
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestPrepareUpgradePlanQueryError(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", upgradePlanUrl, httpmock.NewErrorResponder(errors.New("custom error")))
+
+	preparer, _ := getTestPreparer(t, false)
+	err := preparer.Prepare(context.Background())
+	require.Error(t, err)
+	require.ErrorContains(t, err, "custom error")
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestPrepareUpgradeNoPlan(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		upgradePlanUrl,
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("upgrade-plan-empty.json")),
+	)
+
+	preparer, _ := getTestPreparer(t, false)
+	err := preparer.Prepare(context.Background())
+	require.NoError(t, err)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestPrepareUpgradeAlreadyPresent(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		upgradePlanUrl,
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("upgrade-plan-binaries.json")),
+	)
+
+	preparer, chainFolder := getTestPreparer(t, false)
+	require.NoError(t, os.MkdirAll(chainFolder+"/cosmovisor/upgrades/v15/bin", 0o755))
+	require.NoError(t, os.WriteFile(chainFolder+"/cosmovisor/upgrades/v15/bin/gaiad", []byte("existing"), 0o600))
+
+	err := preparer.Prepare(context.Background())
+	require.NoError(t, err)
+
+	content, err := os.ReadFile(chainFolder + "/cosmovisor/upgrades/v15/bin/gaiad")
+	require.NoError(t, err)
+	assert.Equal(t, "existing", string(content))
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestPrepareUpgradeDryRun(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		upgradePlanUrl,
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("upgrade-plan-binaries.json")),
+	)
+
+	preparer, chainFolder := getTestPreparer(t, true)
+	err := preparer.Prepare(context.Background())
+	require.NoError(t, err)
+
+	_, err = os.Stat(chainFolder + "/cosmovisor/upgrades/v15")
+	require.ErrorIs(t, err, os.ErrNotExist)
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestPrepareUpgradeFromPlanTarGz(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		upgradePlanUrl,
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("upgrade-plan-binaries.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/gaiad-v15-linux-amd64.tar.gz",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("upgrade-binary.tar.gz")),
+	)
+
+	preparer, chainFolder := getTestPreparer(t, false)
+	err := preparer.Prepare(context.Background())
+	require.NoError(t, err)
+
+	binaryPath := chainFolder + "/cosmovisor/upgrades/v15/bin/gaiad"
+	content, err := os.ReadFile(binaryPath)
+	require.NoError(t, err)
+	assert.Equal(t, binaryContent, string(content))
+
+	stat, err := os.Stat(binaryPath)
+	require.NoError(t, err)
+	assert.NotZero(t, stat.Mode()&0o100)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestPrepareUpgradeFromPlanZip(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		upgradePlanUrl,
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("upgrade-plan-binaries.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/gaiad-v15-linux-arm64.zip",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("upgrade-binary.zip")),
+	)
+
+	preparer, chainFolder := getTestPreparer(t, false)
+	preparer.Platform = "linux/arm64"
+
+	err := preparer.Prepare(context.Background())
+	require.NoError(t, err)
+
+	content, err := os.ReadFile(chainFolder + "/cosmovisor/upgrades/v15/bin/gaiad")
+	require.NoError(t, err)
+	assert.Equal(t, binaryContent, string(content))
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestPrepareUpgradeChecksumMismatch(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		upgradePlanUrl,
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("upgrade-plan-binaries.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/gaiad-v15-linux-amd64.tar.gz",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("upgrade-binary.zip")),
+	)
+
+	preparer, chainFolder := getTestPreparer(t, false)
+	err := preparer.Prepare(context.Background())
+	require.Error(t, err)
+	require.ErrorContains(t, err, "checksum mismatch")
+
+	_, err = os.Stat(chainFolder + "/cosmovisor/upgrades/v15/bin/gaiad")
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestPrepareUpgradeDownloadError(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		upgradePlanUrl,
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("upgrade-plan-binaries.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://example.com/gaiad-v15-linux-amd64.tar.gz",
+		httpmock.NewStringResponder(404, "Not Found"),
+	)
+
+	preparer, _ := getTestPreparer(t, false)
+	err := preparer.Prepare(context.Background())
+	require.Error(t, err)
+	require.ErrorContains(t, err, "unexpected status 404")
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestPrepareUpgradeNoSourceWithoutGithub(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		upgradePlanUrl,
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("upgrade-plan-binaries.json")),
+	)
+
+	preparer, _ := getTestPreparer(t, false)
+	preparer.Platform = "darwin/arm64"
+	preparer.Github = nil
+
+	err := preparer.Prepare(context.Background())
+	require.Error(t, err)
+	require.ErrorContains(t, err, "no GitHub repository is configured")
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestPrepareUpgradeFromGithubRelease(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	// this plan has binaries, but without checksums
+	httpmock.RegisterResponder(
+		"GET",
+		upgradePlanUrl,
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("upgrade-plan.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		githubReleasesUrl,
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("github-releases.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://github.com/cosmos/gaia/releases/download/v1.5.0/SHA256SUMS-v1.5.0.txt",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("github-release-checksums.txt")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://github.com/cosmos/gaia/releases/download/v1.5.0/gaiad-v1.5.0-linux-amd64",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("cosmovisor-binaries/v15/bin/gaiad")),
+	)
+
+	preparer, chainFolder := getTestPreparer(t, false)
+	err := preparer.Prepare(context.Background())
+	require.NoError(t, err)
+
+	content, err := os.ReadFile(chainFolder + "/cosmovisor/upgrades/v1.5.0/bin/gaiad")
+	require.NoError(t, err)
+	assert.Equal(t, binaryContent, string(content))
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestPrepareUpgradeGithubReleasesError(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		upgradePlanUrl,
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("upgrade-plan.json")),
+	)
+	httpmock.RegisterResponder("GET", githubReleasesUrl, httpmock.NewErrorResponder(errors.New("custom error")))
+
+	preparer, _ := getTestPreparer(t, false)
+	err := preparer.Prepare(context.Background())
+	require.Error(t, err)
+	require.ErrorContains(t, err, "could not fetch GitHub releases")
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestPrepareUpgradeGithubNoPlatformAsset(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		upgradePlanUrl,
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("upgrade-plan.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		githubReleasesUrl,
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("github-releases.json")),
+	)
+
+	preparer, _ := getTestPreparer(t, false)
+	preparer.Platform = "windows/amd64"
+
+	err := preparer.Prepare(context.Background())
+	require.Error(t, err)
+	require.ErrorContains(t, err, "has no asset for windows/amd64")
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestPrepareUpgradeGithubChecksumsError(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		upgradePlanUrl,
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("upgrade-plan.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		githubReleasesUrl,
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("github-releases.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://github.com/cosmos/gaia/releases/download/v1.5.0/SHA256SUMS-v1.5.0.txt",
+		httpmock.NewErrorResponder(errors.New("custom error")),
+	)
+
+	preparer, _ := getTestPreparer(t, false)
+	err := preparer.Prepare(context.Background())
+	require.Error(t, err)
+	require.ErrorContains(t, err, "could not download checksums file")
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestPrepareUpgradeGithubNoChecksumForAsset(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		upgradePlanUrl,
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("upgrade-plan.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		githubReleasesUrl,
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("github-releases.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://github.com/cosmos/gaia/releases/download/v1.5.0/SHA256SUMS-v1.5.0.txt",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("github-release-checksums.txt")),
+	)
+
+	preparer, _ := getTestPreparer(t, false)
+	preparer.Platform = "darwin/arm64"
+
+	err := preparer.Prepare(context.Background())
+	require.Error(t, err)
+	require.ErrorContains(t, err, "checksums file has no checksum for gaiad-v1.5.0-darwin-arm64")
+}
+
+func TestFindRelease(t *testing.T) {
+	t.Parallel()
+
+	releases := []git.GithubReleaseInfo{
+		{TagName: "v18.0.0-rc1", Prerelease: true},
+		{TagName: "v17.2.0"},
+		{TagName: "v17.1.0"},
+		{TagName: "v17", Draft: true},
+	}
+
+	release, found := findRelease(releases, "v17")
+	require.True(t, found)
+	assert.Equal(t, "v17.2.0", release.TagName)
+
+	release, found = findRelease(releases, "v18.0.0-rc1")
+	require.True(t, found)
+	assert.Equal(t, "v18.0.0-rc1", release.TagName)
+
+	_, found = findRelease(releases, "v18")
+	assert.False(t, found)
+}
+
+func TestFindPlatformAsset(t *testing.T) {
+	t.Parallel()
+
+	assets := []git.GithubReleaseAsset{
+		{Name: "gaiad-v17.2.0-linux-arm64.tar.gz"},
+		{Name: "gaiad-v17.2.0-linux-arm64.tar.gz.sig"},
+		{Name: "gaiad-v17.2.0-Linux_x86_64.tar.gz"},
+		{Name: "gaiad-v17.2.0-darwin-aarch64"},
+		{Name: "gaiad-v17.2.0-linux-arm"},
+	}
+
+	asset, found := findPlatformAsset(assets, "linux/arm64")
+	require.True(t, found)
+	assert.Equal(t, "gaiad-v17.2.0-linux-arm64.tar.gz", asset.Name)
+
+	asset, found = findPlatformAsset(assets, "linux/amd64")
+	require.True(t, found)
+	assert.Equal(t, "gaiad-v17.2.0-Linux_x86_64.tar.gz", asset.Name)
+
+	asset, found = findPlatformAsset(assets, "darwin/arm64")
+	require.True(t, found)
+	assert.Equal(t, "gaiad-v17.2.0-darwin-aarch64", asset.Name)
+
+	// arm should not match arm64
+	asset, found = findPlatformAsset(assets, "linux/arm")
+	require.True(t, found)
+	assert.Equal(t, "gaiad-v17.2.0-linux-arm", asset.Name)
+
+	_, found = findPlatformAsset(assets[:4], "linux/arm")
+	assert.False(t, found)
+
+	_, found = findPlatformAsset(assets, "darwin/amd64")
+	assert.False(t, found)
+}
+
+func TestFindChecksumsAsset(t *testing.T) {
+	t.Parallel()
+
+	_, found := findChecksumsAsset([]git.GithubReleaseAsset{{Name: "gaiad-linux-amd64"}})
+	assert.False(t, found)
+
+	asset, found := findChecksumsAsset([]git.GithubReleaseAsset{
+		{Name: "gaiad-linux-amd64"},
+		{Name: "checksums.txt"},
+	})
+	assert.True(t, found)
+	assert.Equal(t, "checksums.txt", asset.Name)
+}
+
+func TestFindChecksum(t *testing.T) {
+	t.Parallel()
+
+	checksums := "invalid line\nABCDEF *gaiad-linux-amd64\n123456  gaiad-linux-arm64\n"
+
+	checksum, found := findChecksum(checksums, "gaiad-linux-amd64")
+	assert.True(t, found)
+	assert.Equal(t, "abcdef", checksum)
+
+	_, found = findChecksum(checksums, "gaiad-darwin-arm64")
+	assert.False(t, found)
+}
+
+func TestStripChecksum(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(
+		t,
+		"https://example.com/gaiad?foo=bar",
+		stripChecksum("https://example.com/gaiad?checksum=sha256:abc&foo=bar"),
+	)
+	assert.Equal(t, "://", stripChecksum("://"))
+	assert.Equal(t, "://", urlPath("://"))
+}
+
+func TestExtractBinaryNotFound(t *testing.T) {
+	t.Parallel()
+
+	var output bytes.Buffer
+
+	err := extractFromTarGz(bytes.NewReader(assets.GetBytesOrPanic("upgrade-binary.tar.gz")), "osmosisd", &output)
+	require.Error(t, err)
+	require.ErrorContains(t, err, "binary osmosisd not found in archive")
+
+	err = extractFromTarGz(bytes.NewReader([]byte("not an archive")), "gaiad", &output)
+	require.Error(t, err)
+
+	archivePath := t.TempDir() + "/upgrade-binary.zip"
+	require.NoError(t, os.WriteFile(archivePath, assets.GetBytesOrPanic("upgrade-binary.zip"), 0o600))
+
+	archive, err := os.Open(archivePath)
+	require.NoError(t, err)
+	defer archive.Close()
+
+	err = extractFromZip(archive, "osmosisd", &output)
+	require.Error(t, err)
+	require.ErrorContains(t, err, "binary osmosisd not found in archive")
+}
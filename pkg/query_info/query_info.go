@@ -7,9 +7,10 @@ import (
 )
 
 type QueryInfo struct {
-	Module  constants.Module
-	Action  constants.Action
-	Success bool
+	Module   constants.Module
+	Action   constants.Action
+	Success  bool
+	Endpoint string
 }
 
 func GetQueryInfoMetrics(allQueries map[string]map[string][]QueryInfo) []metrics.MetricInfo {
@@ -28,6 +29,20 @@ func GetQueryInfoMetrics(allQueries map[string]map[string][]QueryInfo) []metrics
 					},
 					Value: utils.BoolToFloat64(queryInfo.Success),
 				})
+
+				if queryInfo.Endpoint != "" {
+					metricsInfos = append(metricsInfos, metrics.MetricInfo{
+						MetricName: metrics.MetricNameQueryEndpoint,
+						Labels: map[string]string{
+							"node":     node,
+							"querier":  name,
+							"module":   string(queryInfo.Module),
+							"action":   string(queryInfo.Action),
+							"endpoint": queryInfo.Endpoint,
+						},
+						Value: 1,
+					})
+				}
 			}
 		}
 	}
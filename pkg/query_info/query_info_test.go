@@ -34,3 +34,31 @@ func TestQueryInfo(t *testing.T) {
 	}, metric.Labels)
 	assert.InDelta(t, 1, metric.Value, 0.01)
 }
+
+func TestQueryInfoWithEndpoint(t *testing.T) {
+	t.Parallel()
+
+	input := map[string]map[string][]QueryInfo{
+		"node": {
+			"querier": []QueryInfo{{
+				Module:   constants.ModuleTendermint,
+				Action:   constants.ActionTendermintGetNodeStatus,
+				Success:  true,
+				Endpoint: "http://localhost:26657",
+			}},
+		},
+	}
+	metrics := GetQueryInfoMetrics(input)
+	assert.Len(t, metrics, 2)
+
+	metric := metrics[1]
+	assert.Equal(t, metricsPkg.MetricNameQueryEndpoint, metric.MetricName)
+	assert.Equal(t, map[string]string{
+		"node":     "node",
+		"querier":  "querier",
+		"module":   "tendermint",
+		"action":   "get_node_status",
+		"endpoint": "http://localhost:26657",
+	}, metric.Labels)
+	assert.InDelta(t, 1, metric.Value, 0.01)
+}
@@ -1,12 +1,81 @@
 package types
 
+import (
+	"encoding/json"
+	"main/pkg/clients/tendermint"
+	"main/pkg/utils"
+	"math"
+	"math/big"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	sdkMath "cosmossdk.io/math"
+)
+
 type VersionInfo struct {
 	Name    string `json:"name"`
 	Version string `json:"version"`
 }
 
+// ParseVersionInfo parses the `<binary> version --long --output json` output,
+// returning the JSON part of it as well for logging.
+func ParseVersionInfo(out []byte) (VersionInfo, string, error) {
+	jsonOutput := utils.GetJsonString(string(out))
+
+	var versionInfo VersionInfo
+	err := json.Unmarshal([]byte(jsonOutput), &versionInfo)
+	return versionInfo, jsonOutput, err
+}
+
 type UpgradesPresent map[string]bool
 
+// UpgradeNameFromFolder returns the upgrade name a Cosmovisor upgrade folder was created for.
+// Folder names are lowercase and URI-encoded, so the case of the original upgrade name is lost.
+func UpgradeNameFromFolder(folder string) string {
+	name, err := url.QueryUnescape(folder)
+	if err != nil {
+		return folder
+	}
+
+	return name
+}
+
+// Names returns the upgrade names the folders were created for.
+func (u UpgradesPresent) Names() []string {
+	names := make([]string, 0, len(u))
+
+	for folder := range u {
+		names = append(names, UpgradeNameFromFolder(folder))
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// ResolveNames matches the upgrade folders with the upgrade names known from other sources,
+// returning the original names for the matched folders and the folder names for the rest.
+func (u UpgradesPresent) ResolveNames(knownNames []string) ([]string, []string) {
+	originalNames := make(map[string]string, len(knownNames))
+	for _, knownName := range knownNames {
+		originalNames[strings.ToLower(knownName)] = knownName
+	}
+
+	resolved := []string{}
+	unresolved := []string{}
+
+	for _, name := range u.Names() {
+		if originalName, ok := originalNames[name]; ok {
+			resolved = append(resolved, originalName)
+		} else {
+			unresolved = append(unresolved, name)
+		}
+	}
+
+	return resolved, unresolved
+}
+
 func (u UpgradesPresent) HasUpgrade(upgrade string) bool {
 	value, ok := u[upgrade]
 	if !ok {
@@ -15,3 +84,333 @@ func (u UpgradesPresent) HasUpgrade(upgrade string) bool {
 
 	return value
 }
+
+type ConsensusStateInfo struct {
+	State                 *tendermint.ConsensusState
+	TimeSinceHeightChange time.Duration
+}
+
+type ReferenceStatus struct {
+	Address string
+	Height  int64
+	Latency time.Duration
+	Success bool
+}
+
+type ReferenceStatuses []ReferenceStatus
+
+func (r ReferenceStatuses) MedianHeight() (int64, bool) {
+	heights := []int64{}
+
+	for _, status := range r {
+		if status.Success {
+			heights = append(heights, status.Height)
+		}
+	}
+
+	return utils.MedianInt64(heights)
+}
+
+type ValidatorSetInfo struct {
+	InActiveSet              bool
+	Rank                     int
+	VotingPower              int64
+	ProposerPriority         int64
+	ActiveSetSize            int
+	LastActiveVotingPower    int64
+	FirstInactiveVotingPower int64
+	HasInactive              bool
+}
+
+// NewValidatorSetInfo finds the validator's position in the active set,
+// inactiveVotingPowers are the voting powers of validators outside of it.
+func NewValidatorSetInfo(
+	address string,
+	validators []tendermint.Validator,
+	inactiveVotingPowers []int64,
+) ValidatorSetInfo {
+	sorted := make([]tendermint.Validator, len(validators))
+	copy(sorted, validators)
+
+	// same order as the one the validators set is sorted by in Tendermint
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].VotingPower != sorted[j].VotingPower {
+			return sorted[i].VotingPower > sorted[j].VotingPower
+		}
+
+		return sorted[i].Address < sorted[j].Address
+	})
+
+	info := ValidatorSetInfo{ActiveSetSize: len(sorted)}
+
+	if len(sorted) > 0 {
+		info.LastActiveVotingPower = sorted[len(sorted)-1].VotingPower
+	}
+
+	for index, validator := range sorted {
+		if validator.Address == address {
+			info.InActiveSet = true
+			info.Rank = index + 1
+			info.VotingPower = validator.VotingPower
+			info.ProposerPriority = validator.ProposerPriority
+			break
+		}
+	}
+
+	for _, votingPower := range inactiveVotingPowers {
+		if !info.HasInactive || votingPower > info.FirstInactiveVotingPower {
+			info.FirstInactiveVotingPower = votingPower
+			info.HasInactive = true
+		}
+	}
+
+	return info
+}
+
+// EstimatePowerReduction calculates how many tokens make one unit of voting power on this chain,
+// as it is 10^6 on most chains, but is 10^18 on the ones with 18-decimal tokens. The power of each
+// validator is truncated, so the ratio of bonded tokens to the active set voting power is rounded
+// to the nearest power of 10.
+func EstimatePowerReduction(bondedTokens sdkMath.Int, validators []tendermint.Validator) (sdkMath.Int, bool) {
+	var totalVotingPower int64
+	for _, validator := range validators {
+		totalVotingPower += validator.VotingPower
+	}
+
+	if totalVotingPower <= 0 || bondedTokens.IsNil() || !bondedTokens.IsPositive() {
+		return sdkMath.Int{}, false
+	}
+
+	ratio, _ := new(big.Float).SetInt(bondedTokens.QuoRaw(totalVotingPower).BigInt()).Float64()
+	if ratio < 1 {
+		return sdkMath.Int{}, false
+	}
+
+	exponent := int64(math.Round(math.Log10(ratio)))
+	return sdkMath.NewIntFromBigInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(exponent), nil)), true
+}
+
+type HeightSample struct {
+	Height int64
+	Time   time.Time
+}
+
+type SyncProgress struct {
+	CatchingUp          bool
+	Rate                float64
+	HasRate             bool
+	BlocksRemaining     int64
+	HasReferenceHeight  bool
+	EstimatedCompletion time.Time
+}
+
+// NewSyncProgress calculates the sync rate out of the height samples, ordered from the oldest
+// to the newest one, and the time left to catch up with the reference height, if it's known.
+func NewSyncProgress(samples []HeightSample, referenceHeight int64, hasReferenceHeight bool) SyncProgress {
+	progress := SyncProgress{CatchingUp: true}
+
+	if len(samples) == 0 {
+		return progress
+	}
+
+	oldest := samples[0]
+	latest := samples[len(samples)-1]
+
+	if elapsed := latest.Time.Sub(oldest.Time).Seconds(); elapsed > 0 {
+		progress.Rate = float64(latest.Height-oldest.Height) / elapsed
+		progress.HasRate = true
+	}
+
+	if !hasReferenceHeight {
+		return progress
+	}
+
+	progress.HasReferenceHeight = true
+	progress.BlocksRemaining = max(referenceHeight-latest.Height, 0)
+
+	if progress.HasRate && progress.Rate > 0 {
+		secondsRemaining := float64(progress.BlocksRemaining) / progress.Rate
+		progress.EstimatedCompletion = latest.Time.Add(time.Duration(secondsRemaining * float64(time.Second)))
+	}
+
+	return progress
+}
+
+type DirectoryUsage struct {
+	// folder name relative to the chain folder, like "data"
+	Name    string
+	Present bool
+	// directories are walked rarely in the background, so the size is taken from the last
+	// completed walk, and is missing until the first one finishes
+	Size     int64
+	WalkedAt time.Time
+	HasSize  bool
+	// device and space of the filesystem holding the directory, not available on every platform
+	Device             uint64
+	FreeBytes          uint64
+	TotalBytes         uint64
+	HasFilesystemStats bool
+
+	GrowthPerDay float64
+	HasGrowth    bool
+	// float, as a slowly growing directory might take longer than time.Duration can hold
+	SecondsTillFull    float64
+	HasSecondsTillFull bool
+}
+
+type SizeSample struct {
+	Size int64
+	Time time.Time
+}
+
+// NewGrowthPerDay calculates how much the size grows per day out of the size samples,
+// ordered from the oldest to the newest one.
+func NewGrowthPerDay(samples []SizeSample) (float64, bool) {
+	if len(samples) < 2 {
+		return 0, false
+	}
+
+	oldest := samples[0]
+	latest := samples[len(samples)-1]
+
+	elapsed := latest.Time.Sub(oldest.Time)
+	if elapsed <= 0 {
+		return 0, false
+	}
+
+	return float64(latest.Size-oldest.Size) / elapsed.Hours() * 24, true
+}
+
+// EstimateTimeTillFull sets the time until the filesystem holding each directory gets full,
+// summing up the growth of all directories located on the same filesystem.
+func EstimateTimeTillFull(directories []DirectoryUsage) {
+	growthPerDevice := map[uint64]float64{}
+
+	for _, directory := range directories {
+		if directory.Present && directory.HasGrowth && directory.GrowthPerDay > 0 {
+			growthPerDevice[directory.Device] += directory.GrowthPerDay
+		}
+	}
+
+	for index, directory := range directories {
+		growth, ok := growthPerDevice[directory.Device]
+		if !directory.Present || !directory.HasFilesystemStats || !ok {
+			continue
+		}
+
+		daysTillFull := float64(directory.FreeBytes) / growth
+		directories[index].SecondsTillFull = daysTillFull * (24 * time.Hour).Seconds()
+		directories[index].HasSecondsTillFull = true
+	}
+}
+
+type ClockDrift struct {
+	// local clock compared to the latest block time, minus the expected block interval
+	BlockTimeOffset    time.Duration
+	HasBlockTimeOffset bool
+	// local clock compared to the NTP server's clock, positive if the local clock is late
+	NtpOffset    time.Duration
+	HasNtpOffset bool
+}
+
+type AbciInfo struct {
+	Info tendermint.AbciInfo
+	// the /status latest height queried right after ABCI info, so both come from the same node
+	// at almost the same moment, and the application can only be behind, not ahead
+	LatestBlockHeight    int64
+	HasLatestBlockHeight bool
+}
+
+type AppliedUpgrades struct {
+	// upgrade name -> height it was applied at, 0 if it was never applied.
+	// Upgrades with an unknown name case are only present if they were applied.
+	AppliedPlans map[string]int64
+	// module name -> consensus version
+	ModuleVersions map[string]uint64
+}
+
+// UpgradeBinaries is the platform -> download URL map Cosmovisor reads from the
+// upgrade plan info, like {"binaries":{"linux/amd64":"https://...?checksum=sha256:..."}}.
+type UpgradeBinaries map[string]string
+
+func ParseUpgradeBinaries(info string) (UpgradeBinaries, bool) {
+	var planInfo struct {
+		Binaries UpgradeBinaries `json:"binaries"`
+	}
+
+	if err := json.Unmarshal([]byte(info), &planInfo); err != nil || len(planInfo.Binaries) == 0 {
+		return nil, false
+	}
+
+	return planInfo.Binaries, true
+}
+
+// ForPlatform returns the binary URL for a platform, falling back to the
+// platform-independent "any" one, same as Cosmovisor does.
+func (b UpgradeBinaries) ForPlatform(platform string) (string, bool) {
+	if binaryUrl, ok := b[platform]; ok {
+		return binaryUrl, true
+	}
+
+	binaryUrl, ok := b["any"]
+	return binaryUrl, ok
+}
+
+// ParseBinaryChecksum extracts the algorithm and the checksum from the binary URL
+// checksum parameter, like "?checksum=sha256:<hex>".
+func ParseBinaryChecksum(binaryUrl string) (string, string, bool) {
+	parsedUrl, err := url.Parse(binaryUrl)
+	if err != nil {
+		return "", "", false
+	}
+
+	algorithm, checksum, found := strings.Cut(parsedUrl.Query().Get("checksum"), ":")
+	if !found || algorithm == "" || checksum == "" {
+		return "", "", false
+	}
+
+	return strings.ToLower(algorithm), strings.ToLower(checksum), true
+}
+
+type UpgradeBinaryInfo struct {
+	Name              string
+	Source            string
+	Platform          string
+	PlatformAvailable bool
+	HasChecksumMatch  bool
+	ChecksumMatch     bool
+}
+
+type UpgradeSmokeTestInfo struct {
+	Name     string
+	Runnable bool
+	// only set if the binary is runnable and returned a parseable version
+	Version string
+}
+
+type CosmovisorUpgradeFolder struct {
+	// upgrade name, URI-decoded from the folder name
+	Name             string
+	BinaryPresent    bool
+	BinaryModTime    time.Time
+	HasBinaryModTime bool
+}
+
+type CosmovisorState struct {
+	// "genesis" or the upgrade name the current symlink points to, empty if it is missing
+	CurrentUpgrade       string
+	GenesisBinaryPresent bool
+	Upgrades             []CosmovisorUpgradeFolder
+}
+
+// CosmovisorSettings is what Cosmovisor reads from cosmovisor/config.toml,
+// with Cosmovisor's own defaults for the settings that are omitted.
+type CosmovisorSettings struct {
+	AllowDownloadBinaries bool
+	RestartAfterUpgrade   bool
+	UnsafeSkipBackup      bool
+	PollInterval          time.Duration
+	RestartDelay          time.Duration
+	// all settings from the file, keyed by their env variable name, like DAEMON_POLL_INTERVAL
+	Values map[string]string
+}
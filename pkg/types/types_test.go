@@ -1,9 +1,13 @@
 package types
 
 import (
+	"main/pkg/clients/tendermint"
 	"testing"
+	"time"
 
+	"cosmossdk.io/math"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestHasUpgrade(t *testing.T) {
@@ -14,3 +18,280 @@ func TestHasUpgrade(t *testing.T) {
 	assert.False(t, upgrades.HasUpgrade("second"))
 	assert.False(t, upgrades.HasUpgrade("third"))
 }
+
+func TestUpgradesPresentNames(t *testing.T) {
+	t.Parallel()
+
+	upgrades := UpgradesPresent{"v2": true, "v1.5.0": false, "v3%2Frc1": true}
+	assert.Equal(t, []string{"v1.5.0", "v2", "v3/rc1"}, upgrades.Names())
+}
+
+func TestUpgradesPresentResolveNames(t *testing.T) {
+	t.Parallel()
+
+	upgrades := UpgradesPresent{"v17": true, "v18": false, "v19": false}
+	resolved, unresolved := upgrades.ResolveNames([]string{"V17", "v19", "v20"})
+	assert.Equal(t, []string{"V17", "v19"}, resolved)
+	assert.Equal(t, []string{"v18"}, unresolved)
+}
+
+func TestReferenceStatusesMedianHeightEmpty(t *testing.T) {
+	t.Parallel()
+
+	statuses := ReferenceStatuses{{Height: 100, Success: false}}
+	_, found := statuses.MedianHeight()
+	assert.False(t, found)
+}
+
+func TestReferenceStatusesMedianHeightOdd(t *testing.T) {
+	t.Parallel()
+
+	statuses := ReferenceStatuses{
+		{Height: 103, Success: true},
+		{Height: 100, Success: true},
+		{Height: 500, Success: false},
+		{Height: 101, Success: true},
+	}
+	height, found := statuses.MedianHeight()
+	assert.True(t, found)
+	assert.Equal(t, int64(101), height)
+}
+
+func TestReferenceStatusesMedianHeightEven(t *testing.T) {
+	t.Parallel()
+
+	statuses := ReferenceStatuses{
+		{Height: 104, Success: true},
+		{Height: 100, Success: true},
+	}
+	height, found := statuses.MedianHeight()
+	assert.True(t, found)
+	assert.Equal(t, int64(102), height)
+}
+
+func TestNewValidatorSetInfoNotInSet(t *testing.T) {
+	t.Parallel()
+
+	info := NewValidatorSetInfo("address", []tendermint.Validator{
+		{Address: "first", VotingPower: 100},
+		{Address: "second", VotingPower: 50},
+	}, []int64{})
+	assert.False(t, info.InActiveSet)
+	assert.Zero(t, info.Rank)
+	assert.Equal(t, 2, info.ActiveSetSize)
+	assert.Equal(t, int64(50), info.LastActiveVotingPower)
+	assert.False(t, info.HasInactive)
+}
+
+func TestNewValidatorSetInfoInSet(t *testing.T) {
+	t.Parallel()
+
+	info := NewValidatorSetInfo("address", []tendermint.Validator{
+		{Address: "last", VotingPower: 30},
+		{Address: "first", VotingPower: 100},
+		{Address: "address", VotingPower: 50, ProposerPriority: -20},
+	}, []int64{10, 25, 5})
+	assert.True(t, info.InActiveSet)
+	assert.Equal(t, 2, info.Rank)
+	assert.Equal(t, int64(50), info.VotingPower)
+	assert.Equal(t, int64(-20), info.ProposerPriority)
+	assert.Equal(t, 3, info.ActiveSetSize)
+	assert.Equal(t, int64(30), info.LastActiveVotingPower)
+	assert.True(t, info.HasInactive)
+	assert.Equal(t, int64(25), info.FirstInactiveVotingPower)
+}
+
+func TestEstimatePowerReductionInvalid(t *testing.T) {
+	t.Parallel()
+
+	_, ok := EstimatePowerReduction(math.NewInt(1000), []tendermint.Validator{})
+	assert.False(t, ok)
+
+	_, ok = EstimatePowerReduction(math.ZeroInt(), []tendermint.Validator{{VotingPower: 100}})
+	assert.False(t, ok)
+
+	_, ok = EstimatePowerReduction(math.NewInt(10), []tendermint.Validator{{VotingPower: 100}})
+	assert.False(t, ok)
+}
+
+func TestEstimatePowerReductionOk(t *testing.T) {
+	t.Parallel()
+
+	// 6 decimals, with voting power of each validator truncated
+	powerReduction, ok := EstimatePowerReduction(math.NewInt(38000500000), []tendermint.Validator{
+		{VotingPower: 20000},
+		{VotingPower: 10000},
+		{VotingPower: 8000},
+	})
+	require.True(t, ok)
+	assert.Equal(t, "1000000", powerReduction.String())
+
+	// 18 decimals
+	bondedTokens, ok := math.NewIntFromString("150000000000000000000123456")
+	require.True(t, ok)
+
+	powerReduction, ok = EstimatePowerReduction(bondedTokens, []tendermint.Validator{
+		{VotingPower: 100000000},
+		{VotingPower: 50000000},
+	})
+	require.True(t, ok)
+	assert.Equal(t, "1000000000000000000", powerReduction.String())
+}
+
+func TestNewSyncProgressNoSamples(t *testing.T) {
+	t.Parallel()
+
+	progress := NewSyncProgress([]HeightSample{}, 1000, true)
+	assert.True(t, progress.CatchingUp)
+	assert.False(t, progress.HasRate)
+	assert.False(t, progress.HasReferenceHeight)
+}
+
+func TestNewSyncProgressSingleSample(t *testing.T) {
+	t.Parallel()
+
+	progress := NewSyncProgress([]HeightSample{{Height: 100, Time: time.Now()}}, 1000, true)
+	assert.False(t, progress.HasRate)
+	assert.True(t, progress.HasReferenceHeight)
+	assert.Equal(t, int64(900), progress.BlocksRemaining)
+	assert.True(t, progress.EstimatedCompletion.IsZero())
+}
+
+func TestNewSyncProgressNoReference(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, 6, 29, 0, 0, 0, 0, time.UTC)
+	progress := NewSyncProgress([]HeightSample{
+		{Height: 100, Time: start},
+		{Height: 400, Time: start.Add(30 * time.Second)},
+	}, 0, false)
+	assert.True(t, progress.HasRate)
+	assert.InDelta(t, 10, progress.Rate, 0.01)
+	assert.False(t, progress.HasReferenceHeight)
+	assert.True(t, progress.EstimatedCompletion.IsZero())
+}
+
+func TestNewSyncProgressOk(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, 6, 29, 0, 0, 0, 0, time.UTC)
+	progress := NewSyncProgress([]HeightSample{
+		{Height: 100, Time: start},
+		{Height: 250, Time: start.Add(15 * time.Second)},
+		{Height: 400, Time: start.Add(30 * time.Second)},
+	}, 1400, true)
+	assert.InDelta(t, 10, progress.Rate, 0.01)
+	assert.Equal(t, int64(1000), progress.BlocksRemaining)
+	assert.Equal(t, start.Add(130*time.Second), progress.EstimatedCompletion)
+}
+
+func TestNewSyncProgressAheadOfReference(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, 6, 29, 0, 0, 0, 0, time.UTC)
+	progress := NewSyncProgress([]HeightSample{
+		{Height: 100, Time: start},
+		{Height: 400, Time: start.Add(30 * time.Second)},
+	}, 300, true)
+	assert.Zero(t, progress.BlocksRemaining)
+	assert.Equal(t, start.Add(30*time.Second), progress.EstimatedCompletion)
+}
+
+func TestParseUpgradeBinariesInvalid(t *testing.T) {
+	t.Parallel()
+
+	_, ok := ParseUpgradeBinaries("https://example.com/upgrade-info.json")
+	assert.False(t, ok)
+
+	_, ok = ParseUpgradeBinaries(`{"binaries":{}}`)
+	assert.False(t, ok)
+}
+
+func TestParseUpgradeBinariesOk(t *testing.T) {
+	t.Parallel()
+
+	binaries, ok := ParseUpgradeBinaries(`{"binaries":{"linux/amd64":"https://example.com/gaiad-amd64","any":"https://example.com/gaiad"}}`)
+	require.True(t, ok)
+
+	binaryUrl, found := binaries.ForPlatform("linux/amd64")
+	assert.True(t, found)
+	assert.Equal(t, "https://example.com/gaiad-amd64", binaryUrl)
+
+	binaryUrl, found = binaries.ForPlatform("darwin/arm64")
+	assert.True(t, found)
+	assert.Equal(t, "https://example.com/gaiad", binaryUrl)
+
+	_, found = UpgradeBinaries{"linux/amd64": "https://example.com/gaiad"}.ForPlatform("linux/arm64")
+	assert.False(t, found)
+}
+
+func TestParseBinaryChecksum(t *testing.T) {
+	t.Parallel()
+
+	_, _, ok := ParseBinaryChecksum("https://example.com/gaiad")
+	assert.False(t, ok)
+
+	_, _, ok = ParseBinaryChecksum("https://example.com/gaiad?checksum=abc")
+	assert.False(t, ok)
+
+	_, _, ok = ParseBinaryChecksum("://")
+	assert.False(t, ok)
+
+	algorithm, checksum, ok := ParseBinaryChecksum("https://example.com/gaiad?checksum=SHA256:ABCDEF")
+	assert.True(t, ok)
+	assert.Equal(t, "sha256", algorithm)
+	assert.Equal(t, "abcdef", checksum)
+}
+
+func TestParseVersionInfo(t *testing.T) {
+	t.Parallel()
+
+	_, jsonOutput, err := ParseVersionInfo([]byte("gaiad v15.0.0"))
+	require.Error(t, err)
+	assert.Equal(t, "gaiad v15.0.0", jsonOutput)
+
+	versionInfo, _, err := ParseVersionInfo([]byte("some output\n{\"name\":\"gaia\",\"version\":\"15.0.0\"}"))
+	require.NoError(t, err)
+	assert.Equal(t, VersionInfo{Name: "gaia", Version: "15.0.0"}, versionInfo)
+}
+
+func TestNewGrowthPerDay(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	_, found := NewGrowthPerDay([]SizeSample{{Size: 100, Time: now}})
+	assert.False(t, found)
+
+	_, found = NewGrowthPerDay([]SizeSample{{Size: 100, Time: now}, {Size: 200, Time: now}})
+	assert.False(t, found)
+
+	growth, found := NewGrowthPerDay([]SizeSample{
+		{Size: 100, Time: now.Add(-12 * time.Hour)},
+		{Size: 150, Time: now.Add(-6 * time.Hour)},
+		{Size: 200, Time: now},
+	})
+	assert.True(t, found)
+	assert.InDelta(t, 200, growth, 0.01)
+}
+
+func TestEstimateTimeTillFull(t *testing.T) {
+	t.Parallel()
+
+	directories := []DirectoryUsage{
+		{Name: "data", Present: true, Device: 1, FreeBytes: 3000, HasFilesystemStats: true, GrowthPerDay: 200, HasGrowth: true},
+		{Name: "wasm", Present: true, Device: 1, FreeBytes: 3000, HasFilesystemStats: true, GrowthPerDay: 100, HasGrowth: true},
+		{Name: "cosmovisor", Present: true, Device: 2, FreeBytes: 3000, HasFilesystemStats: true, GrowthPerDay: -100, HasGrowth: true},
+		{Name: "snapshots", Present: false},
+	}
+
+	EstimateTimeTillFull(directories)
+
+	// data and wasm share the filesystem, so both of them fill it up
+	assert.True(t, directories[0].HasSecondsTillFull)
+	assert.InDelta(t, 10*24*3600, directories[0].SecondsTillFull, 0.01)
+	assert.True(t, directories[1].HasSecondsTillFull)
+	assert.InDelta(t, 10*24*3600, directories[1].SecondsTillFull, 0.01)
+	assert.False(t, directories[2].HasSecondsTillFull)
+	assert.False(t, directories[3].HasSecondsTillFull)
+}
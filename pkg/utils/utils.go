@@ -1,8 +1,15 @@
 package utils
 
 import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"fmt"
+	"hash"
 	"main/pkg/constants"
+	"net/url"
+	"sort"
 	"strconv"
+	"strings"
 )
 
 func BoolToFloat64(value bool) float64 {
@@ -24,3 +31,58 @@ func StringToInt64(value string) (int64, error) {
 func DecolorifyString(value string) string {
 	return constants.ColorsRegexp.ReplaceAllString(value, "")
 }
+
+// NormalizeUpgradeName returns the folder name Cosmovisor uses for an upgrade.
+// From cosmovisor docs: the name variable in upgrades/<name> is the lowercase
+// URI-encoded name of the upgrade as specified in the upgrade module plan.
+func NormalizeUpgradeName(name string) string {
+	return url.QueryEscape(strings.ToLower(name))
+}
+
+// NewHasher returns a hasher for a checksum algorithm from the upgrade plan
+// binaries URLs, like "sha256" in "?checksum=sha256:<hex>".
+func NewHasher(algorithm string) (hash.Hash, error) {
+	switch algorithm {
+	case "sha256":
+		return sha256.New(), nil
+	case "sha512":
+		return sha512.New(), nil
+	default:
+		return nil, fmt.Errorf("unsupported checksum algorithm: %s", algorithm)
+	}
+}
+
+func MedianInt64(values []int64) (int64, bool) {
+	if len(values) == 0 {
+		return 0, false
+	}
+
+	sorted := make([]int64, len(values))
+	copy(sorted, values)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+
+	middle := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[middle-1] + sorted[middle]) / 2, true
+	}
+
+	return sorted[middle], true
+}
+
+// GetJsonString returns the first line in a multiline string starting with { and ending with }.
+// It's a workaround for cosmovisor and some chain binaries adding some extra output,
+// causing it to not be valid JSON.
+func GetJsonString(input string) string {
+	split := strings.Split(input, "\n")
+	for _, line := range split {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "{") && strings.HasSuffix(trimmed, "}") {
+			return trimmed
+		}
+	}
+
+	// return the whole line, there's no valid JSON there
+	return input
+}
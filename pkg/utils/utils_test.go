@@ -44,3 +44,47 @@ func TestDecolorityString(t *testing.T) {
 
 	assert.Equal(t, expectedStr, DecolorifyString(str))
 }
+
+func TestNormalizeUpgradeName(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "v17", NormalizeUpgradeName("v17"))
+	assert.Equal(t, "v1.2.0%2Fhotfix", NormalizeUpgradeName("V1.2.0/Hotfix"))
+}
+
+func TestNewHasher(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewHasher("md4")
+	require.Error(t, err)
+
+	sha256Hasher, err := NewHasher("sha256")
+	require.NoError(t, err)
+	assert.Equal(t, 32, sha256Hasher.Size())
+
+	sha512Hasher, err := NewHasher("sha512")
+	require.NoError(t, err)
+	assert.Equal(t, 64, sha512Hasher.Size())
+}
+
+func TestMedianInt64(t *testing.T) {
+	t.Parallel()
+
+	_, found := MedianInt64([]int64{})
+	assert.False(t, found)
+
+	median, found := MedianInt64([]int64{30, 10, 20})
+	assert.True(t, found)
+	assert.Equal(t, int64(20), median)
+
+	median, found = MedianInt64([]int64{40, 10, 20, 30})
+	assert.True(t, found)
+	assert.Equal(t, int64(25), median)
+}
+
+func TestGetJsonString(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "not json", GetJsonString("not json"))
+	assert.Equal(t, `{"version":"1.0.0"}`, GetJsonString("some output\n  {\"version\":\"1.0.0\"}  \nmore output"))
+}